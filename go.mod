@@ -11,6 +11,7 @@ require (
 	github.com/stretchr/testify v1.6.1
 	github.com/tj/assert v0.0.3
 	go.uber.org/ratelimit v0.2.0
+	golang.org/x/time v0.5.0
 	gopkg.in/ini.v1 v1.51.1
 )
 
@@ -3,6 +3,7 @@ package botman
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
@@ -67,3 +68,11 @@ func Client(sess session.Session, opts ...Option) BotMan {
 	}
 	return p
 }
+
+// WithDefaultHeaders adds the headers to every request made by the client, e.g. a static team identifier.
+// Headers set by the client methods and with session.WithContextHeaders take precedence, see session.WithDefaultHeaders.
+func WithDefaultHeaders(h http.Header) Option {
+	return func(c *botman) {
+		c.Session = session.WithDefaultHeaders(c.Session, h)
+	}
+}
@@ -5,6 +5,7 @@ package clientlists
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
@@ -43,3 +44,11 @@ func Client(sess session.Session, opts ...Option) ClientLists {
 	}
 	return p
 }
+
+// WithDefaultHeaders adds the headers to every request made by the client, e.g. a static team identifier.
+// Headers set by the client methods and with session.WithContextHeaders take precedence, see session.WithDefaultHeaders.
+func WithDefaultHeaders(h http.Header) Option {
+	return func(c *clientlists) {
+		c.Session = session.WithDefaultHeaders(c.Session, h)
+	}
+}
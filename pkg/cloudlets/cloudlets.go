@@ -3,6 +3,7 @@ package cloudlets
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
@@ -46,3 +47,11 @@ func Client(sess session.Session, opts ...Option) Cloudlets {
 	}
 	return c
 }
+
+// WithDefaultHeaders adds the headers to every request made by the client, e.g. a static team identifier.
+// Headers set by the client methods and with session.WithContextHeaders take precedence, see session.WithDefaultHeaders.
+func WithDefaultHeaders(h http.Header) Option {
+	return func(c *cloudlets) {
+		c.Session = session.WithDefaultHeaders(c.Session, h)
+	}
+}
@@ -189,6 +189,22 @@ func (m *Mock) GetPolicyProperties(ctx context.Context, req GetPolicyPropertiesR
 	return args.Get(0).(map[string]PolicyProperty), args.Error(1)
 }
 
+func (m *Mock) GetPolicyPropertiesPage(ctx context.Context, req GetPolicyPropertiesRequest, opts PageOptions) (map[string]PolicyProperty, *PageInfo, error) {
+	args := m.Called(ctx, req, opts)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(map[string]PolicyProperty), args.Get(1).(*PageInfo), args.Error(2)
+}
+
+func (m *Mock) GetAllPolicyProperties(ctx context.Context, req GetAllPolicyPropertiesRequest) (map[int64]PolicyPropertiesResult, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[int64]PolicyPropertiesResult), args.Error(1)
+}
+
 func (m *Mock) ListLoadBalancerVersions(ctx context.Context, req ListLoadBalancerVersionsRequest) ([]LoadBalancerVersion, error) {
 	args := m.Called(ctx, req)
 	if args.Get(0) == nil {
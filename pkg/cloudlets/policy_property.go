@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/edgegriderr"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/internal/workerpool"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
@@ -16,10 +18,23 @@ type (
 	// PolicyProperties interface is a cloudlets API interface for policy associated properties.
 	PolicyProperties interface {
 		// GetPolicyProperties gets all the associated properties by the policyID.
+		// Properties are fetched page by page, see GetPolicyPropertiesPage.
 		//
 		// See: https://techdocs.akamai.com/cloudlets/v2/reference/get-policy-properties
 		GetPolicyProperties(context.Context, GetPolicyPropertiesRequest) (map[string]PolicyProperty, error)
 
+		// GetPolicyPropertiesPage gets a single page of the associated properties by the policyID.
+		// The returned PageInfo tells whether there are more pages and the offset of the next one.
+		//
+		// See: https://techdocs.akamai.com/cloudlets/v2/reference/get-policy-properties
+		GetPolicyPropertiesPage(context.Context, GetPolicyPropertiesRequest, PageOptions) (map[string]PolicyProperty, *PageInfo, error)
+
+		// GetAllPolicyProperties gets associated properties of multiple policies, fetching them concurrently.
+		// Failure of a single policy does not stop fetching properties of the other ones, it is reported in its result.
+		//
+		// See: https://techdocs.akamai.com/cloudlets/v2/reference/get-policy-properties
+		GetAllPolicyProperties(context.Context, GetAllPolicyPropertiesRequest) (map[int64]PolicyPropertiesResult, error)
+
 		// DeletePolicyProperty removes a property from a policy activation associated_properties list.
 		DeletePolicyProperty(context.Context, DeletePolicyPropertyRequest) error
 	}
@@ -29,6 +44,37 @@ type (
 		PolicyID int64
 	}
 
+	// PageOptions contains pagination parameters of GetPolicyPropertiesPage
+	PageOptions struct {
+		// Offset is the index of the first returned item
+		Offset int
+		// PageSize is the maximum number of returned items. DefaultPageSize is used if it is not set.
+		PageSize int
+	}
+
+	// PageInfo describes a page returned by GetPolicyPropertiesPage
+	PageInfo struct {
+		Offset   int
+		PageSize int
+		// HasMore is true if the page is full, so the next page may contain more items
+		HasMore bool
+		// NextOffset is the offset to be used to fetch the next page
+		NextOffset int
+	}
+
+	// GetAllPolicyPropertiesRequest contains request parameters for GetAllPolicyProperties
+	GetAllPolicyPropertiesRequest struct {
+		PolicyIDs []int64
+		// Concurrency is the maximum number of requests sent at the same time
+		Concurrency int
+	}
+
+	// PolicyPropertiesResult contains properties associated with a single policy or an error if fetching them failed
+	PolicyPropertiesResult struct {
+		Properties map[string]PolicyProperty
+		Err        error
+	}
+
 	// PolicyProperty contains the response data for a single property
 	PolicyProperty struct {
 		GroupID       int64         `json:"groupId"`
@@ -66,13 +112,36 @@ type (
 	}
 )
 
+// DefaultPageSize is the page size used by GetPolicyPropertiesPage if none is given
+const DefaultPageSize = 100
+
 var (
 	// ErrGetPolicyProperties is returned when GetPolicyProperties fails
 	ErrGetPolicyProperties = errors.New("get policy properties")
+	// ErrGetAllPolicyProperties is returned when GetAllPolicyProperties fails
+	ErrGetAllPolicyProperties = errors.New("get all policy properties")
 	// ErrDeletePolicyProperty is returned when DeletePolicyProperty fails
 	ErrDeletePolicyProperty = errors.New("delete policy property")
 )
 
+// Validate validates GetAllPolicyPropertiesRequest
+func (r GetAllPolicyPropertiesRequest) Validate() error {
+	errs := validation.Errors{
+		"PolicyIDs":   validation.Validate(r.PolicyIDs, validation.Required),
+		"Concurrency": validation.Validate(r.Concurrency, validation.Required, validation.Min(1)),
+	}
+	return edgegriderr.ParseValidationErrors(errs)
+}
+
+// Validate validates PageOptions
+func (o PageOptions) Validate() error {
+	errs := validation.Errors{
+		"Offset":   validation.Validate(o.Offset, validation.Min(0)),
+		"PageSize": validation.Validate(o.PageSize, validation.Min(0)),
+	}
+	return edgegriderr.ParseValidationErrors(errs)
+}
+
 // Validate validates DeletePolicyPropertyRequest
 func (r DeletePolicyPropertyRequest) Validate() error {
 	errs := validation.Errors{
@@ -86,27 +155,111 @@ func (c *cloudlets) GetPolicyProperties(ctx context.Context, params GetPolicyPro
 	logger := c.Log(ctx)
 	logger.Debug("GetPolicyProperties")
 
+	result := make(map[string]PolicyProperty)
+	opts := PageOptions{PageSize: DefaultPageSize}
+	for {
+		page, info, err := c.GetPolicyPropertiesPage(ctx, params, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		var added int
+		for name, property := range page {
+			if _, ok := result[name]; !ok {
+				added++
+			}
+			result[name] = property
+		}
+		// stop if the page brought nothing new, e.g. when the API does not support pagination for the policy
+		if !info.HasMore || added == 0 {
+			return result, nil
+		}
+		opts.Offset = info.NextOffset
+	}
+}
+
+func (c *cloudlets) GetPolicyPropertiesPage(ctx context.Context, params GetPolicyPropertiesRequest, opts PageOptions) (map[string]PolicyProperty, *PageInfo, error) {
+	logger := c.Log(ctx)
+	logger.Debug("GetPolicyPropertiesPage")
+
+	if err := opts.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w:\n%s", ErrGetPolicyProperties, ErrStructValidation, err)
+	}
+	if opts.PageSize == 0 {
+		opts.PageSize = DefaultPageSize
+	}
+
 	uri, err := url.Parse(fmt.Sprintf("/cloudlets/api/v2/policies/%d/properties", params.PolicyID))
 	if err != nil {
-		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetPolicyProperties, err)
+		return nil, nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetPolicyProperties, err)
 	}
 
+	q := uri.Query()
+	q.Add("offset", strconv.Itoa(opts.Offset))
+	q.Add("pageSize", strconv.Itoa(opts.PageSize))
+	uri.RawQuery = q.Encode()
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
 	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetPolicyProperties, err)
+		return nil, nil, fmt.Errorf("%w: failed to create request: %s", ErrGetPolicyProperties, err)
 	}
 
 	var result map[string]PolicyProperty
 	resp, err := c.Exec(req, &result)
 	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrGetPolicyProperties, err)
+		return nil, nil, fmt.Errorf("%w: request failed: %s", ErrGetPolicyProperties, err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrGetPolicyProperties, c.Error(resp))
+		return nil, nil, fmt.Errorf("%s: %w", ErrGetPolicyProperties, c.Error(resp))
+	}
+
+	info := PageInfo{
+		Offset:     opts.Offset,
+		PageSize:   opts.PageSize,
+		HasMore:    len(result) >= opts.PageSize,
+		NextOffset: opts.Offset + len(result),
+	}
+
+	return result, &info, nil
+}
+
+func (c *cloudlets) GetAllPolicyProperties(ctx context.Context, params GetAllPolicyPropertiesRequest) (map[int64]PolicyPropertiesResult, error) {
+	logger := c.Log(ctx)
+	logger.Debug("GetAllPolicyProperties")
+
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w:\n%s", ErrGetAllPolicyProperties, ErrStructValidation, err)
+	}
+
+	policyIDs := make([]int64, 0, len(params.PolicyIDs))
+	seen := make(map[int64]struct{}, len(params.PolicyIDs))
+	for _, policyID := range params.PolicyIDs {
+		// duplicated IDs are fetched only once
+		if _, ok := seen[policyID]; ok {
+			continue
+		}
+		seen[policyID] = struct{}{}
+		policyIDs = append(policyIDs, policyID)
+	}
+
+	properties := make([]map[string]PolicyProperty, len(policyIDs))
+	errs := workerpool.Run(ctx, len(policyIDs), workerpool.Options{Concurrency: params.Concurrency}, func(ctx context.Context, i int) error {
+		var err error
+		properties[i], err = c.GetPolicyProperties(ctx, GetPolicyPropertiesRequest{PolicyID: policyIDs[i]})
+		return err
+	})
+
+	results := make(map[int64]PolicyPropertiesResult, len(policyIDs))
+	for i, policyID := range policyIDs {
+		err := errs[i]
+		if errors.Is(err, workerpool.ErrNotStarted) {
+			err = fmt.Errorf("%s: request not sent: %w", ErrGetPolicyProperties, ctx.Err())
+		}
+		results[policyID] = PolicyPropertiesResult{Properties: properties[i], Err: err}
 	}
 
-	return result, nil
+	return results, nil
 }
 
 func (c *cloudlets) DeletePolicyProperty(ctx context.Context, params DeletePolicyPropertyRequest) error {
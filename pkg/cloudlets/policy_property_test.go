@@ -2,10 +2,15 @@ package cloudlets
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -98,7 +103,7 @@ func TestGetPolicyProperties(t *testing.T) {
 					}
 				}
 			`,
-			expectedPath: "/cloudlets/api/v2/policies/11754/properties",
+			expectedPath: "/cloudlets/api/v2/policies/11754/properties?offset=0&pageSize=100",
 			expectedResponse: map[string]PolicyProperty{
 				"www.myproperty.com": {
 					GroupID: 40498,
@@ -184,7 +189,7 @@ func TestGetPolicyProperties(t *testing.T) {
 					"status": 500
 				}
 			`,
-			expectedPath: "/cloudlets/api/v2/policies/11754/properties",
+			expectedPath: "/cloudlets/api/v2/policies/11754/properties?offset=0&pageSize=100",
 			withError: func(t *testing.T, err error) {
 				want := &Error{
 					Type:       "internal_error",
@@ -218,6 +223,120 @@ func TestGetPolicyProperties(t *testing.T) {
 	}
 }
 
+func TestGetAllPolicyProperties(t *testing.T) {
+	tests := map[string]struct {
+		params           GetAllPolicyPropertiesRequest
+		cancelled        bool
+		expectedRequests int32
+		expectedResponse map[int64]PolicyPropertiesResult
+		withError        func(*testing.T, map[int64]PolicyPropertiesResult, error)
+	}{
+		"multiple policies, one failing": {
+			params: GetAllPolicyPropertiesRequest{
+				PolicyIDs:   []int64{1, 2, 3, 2},
+				Concurrency: 2,
+			},
+			expectedRequests: 3,
+			withError: func(t *testing.T, result map[int64]PolicyPropertiesResult, err error) {
+				require.NoError(t, err)
+				require.Len(t, result, 3)
+				assert.NoError(t, result[1].Err)
+				assert.Equal(t, map[string]PolicyProperty{"www.property-1.com": {GroupID: 40498, ID: 1, Name: "www.property-1.com"}}, result[1].Properties)
+				assert.NoError(t, result[3].Err)
+				assert.Equal(t, map[string]PolicyProperty{"www.property-3.com": {GroupID: 40498, ID: 3, Name: "www.property-3.com"}}, result[3].Properties)
+				want := &Error{
+					Type:       "internal_error",
+					Title:      "Internal Server Error",
+					Detail:     "Error making request",
+					StatusCode: http.StatusInternalServerError,
+				}
+				assert.True(t, errors.Is(result[2].Err, want), "want: %s; got: %s", want, result[2].Err)
+				assert.Nil(t, result[2].Properties)
+			},
+		},
+		"cancelled context": {
+			params: GetAllPolicyPropertiesRequest{
+				PolicyIDs:   []int64{1, 3},
+				Concurrency: 1,
+			},
+			cancelled: true,
+			withError: func(t *testing.T, result map[int64]PolicyPropertiesResult, err error) {
+				require.NoError(t, err)
+				require.Len(t, result, 2)
+				for _, res := range result {
+					assert.True(t, errors.Is(res.Err, context.Canceled), "want: %s; got: %s", context.Canceled, res.Err)
+					assert.Contains(t, res.Err.Error(), ErrGetPolicyProperties.Error())
+				}
+			},
+		},
+		"missing policy IDs": {
+			params: GetAllPolicyPropertiesRequest{
+				Concurrency: 1,
+			},
+			withError: func(t *testing.T, _ map[int64]PolicyPropertiesResult, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+			},
+		},
+		"missing concurrency": {
+			params: GetAllPolicyPropertiesRequest{
+				PolicyIDs: []int64{1},
+			},
+			withError: func(t *testing.T, _ map[int64]PolicyPropertiesResult, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests, inFlight, maxInFlight int32
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+				current := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				for {
+					max := atomic.LoadInt32(&maxInFlight)
+					if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+
+				assert.Equal(t, http.MethodGet, r.Method)
+				var status int
+				var body string
+				switch r.URL.Path {
+				case "/cloudlets/api/v2/policies/1/properties":
+					status, body = http.StatusOK, `{"www.property-1.com": {"groupId": 40498, "id": 1, "name": "www.property-1.com"}}`
+				case "/cloudlets/api/v2/policies/3/properties":
+					status, body = http.StatusOK, `{"www.property-3.com": {"groupId": 40498, "id": 3, "name": "www.property-3.com"}}`
+				default:
+					status, body = http.StatusInternalServerError, `
+{
+	"type": "internal_error",
+	"title": "Internal Server Error",
+	"detail": "Error making request",
+	"status": 500
+}`
+				}
+				w.WriteHeader(status)
+				_, err := w.Write([]byte(body))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if test.cancelled {
+				cancel()
+			}
+			result, err := client.GetAllPolicyProperties(ctx, test.params)
+			test.withError(t, result, err)
+			assert.Equal(t, test.expectedRequests, atomic.LoadInt32(&requests))
+			assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(test.params.Concurrency))
+		})
+	}
+}
+
 func TestCloudlets_DeletePolicyProperty(t *testing.T) {
 	tests := map[string]struct {
 		params         DeletePolicyPropertyRequest
@@ -297,3 +416,133 @@ func TestCloudlets_DeletePolicyProperty(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPolicyPropertiesPage(t *testing.T) {
+	tests := map[string]struct {
+		opts             PageOptions
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedResponse map[string]PolicyProperty
+		expectedPageInfo *PageInfo
+		withError        func(*testing.T, error)
+	}{
+		"200 OK, full page": {
+			opts:           PageOptions{Offset: 2, PageSize: 2},
+			responseStatus: http.StatusOK,
+			responseBody: `
+				{
+					"www.property-3.com": {"groupId": 40498, "id": 3, "name": "www.property-3.com"},
+					"www.property-4.com": {"groupId": 40498, "id": 4, "name": "www.property-4.com"}
+				}
+			`,
+			expectedPath: "/cloudlets/api/v2/policies/11754/properties?offset=2&pageSize=2",
+			expectedResponse: map[string]PolicyProperty{
+				"www.property-3.com": {GroupID: 40498, ID: 3, Name: "www.property-3.com"},
+				"www.property-4.com": {GroupID: 40498, ID: 4, Name: "www.property-4.com"},
+			},
+			expectedPageInfo: &PageInfo{Offset: 2, PageSize: 2, HasMore: true, NextOffset: 4},
+		},
+		"200 OK, last page with default page size": {
+			responseStatus: http.StatusOK,
+			responseBody: `
+				{
+					"www.property-1.com": {"groupId": 40498, "id": 1, "name": "www.property-1.com"}
+				}
+			`,
+			expectedPath: "/cloudlets/api/v2/policies/11754/properties?offset=0&pageSize=100",
+			expectedResponse: map[string]PolicyProperty{
+				"www.property-1.com": {GroupID: 40498, ID: 1, Name: "www.property-1.com"},
+			},
+			expectedPageInfo: &PageInfo{Offset: 0, PageSize: 100, HasMore: false, NextOffset: 1},
+		},
+		"500 internal server error": {
+			responseStatus: http.StatusInternalServerError,
+			responseBody: `
+				{
+					"type": "internal_error",
+					"title": "Internal Server Error",
+					"detail": "Error making request",
+					"status": 500
+				}
+			`,
+			expectedPath: "/cloudlets/api/v2/policies/11754/properties?offset=0&pageSize=100",
+			withError: func(t *testing.T, err error) {
+				want := &Error{
+					Type:       "internal_error",
+					Title:      "Internal Server Error",
+					Detail:     "Error making request",
+					StatusCode: http.StatusInternalServerError,
+				}
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+		"negative offset": {
+			opts: PageOptions{Offset: -1},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				assert.Contains(t, err.Error(), "Offset")
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, info, err := client.GetPolicyPropertiesPage(context.Background(), GetPolicyPropertiesRequest{PolicyID: 11754}, test.opts)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+			assert.Equal(t, test.expectedPageInfo, info)
+		})
+	}
+}
+
+func TestGetPolicyPropertiesPagination(t *testing.T) {
+	property := func(id int) (string, PolicyProperty) {
+		name := fmt.Sprintf("www.property-%d.com", id)
+		return name, PolicyProperty{GroupID: 40498, ID: int64(id), Name: name}
+	}
+	// the first page is full, so the second one has to be fetched
+	pages := map[string]map[string]PolicyProperty{
+		"0":                           {},
+		strconv.Itoa(DefaultPageSize): {},
+	}
+	expected := make(map[string]PolicyProperty)
+	for id := 1; id <= DefaultPageSize+1; id++ {
+		name, prop := property(id)
+		offset := (id - 1) / DefaultPageSize * DefaultPageSize
+		pages[strconv.Itoa(offset)][name] = prop
+		expected[name] = prop
+	}
+
+	var requests []string
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.String())
+		assert.Equal(t, "/cloudlets/api/v2/policies/11754/properties", r.URL.Path)
+		assert.Equal(t, strconv.Itoa(DefaultPageSize), r.URL.Query().Get("pageSize"))
+		page, ok := pages[r.URL.Query().Get("offset")]
+		require.True(t, ok)
+		w.WriteHeader(http.StatusOK)
+		assert.NoError(t, json.NewEncoder(w).Encode(page))
+	}))
+	client := mockAPIClient(t, mockServer)
+
+	result, err := client.GetPolicyProperties(context.Background(), GetPolicyPropertiesRequest{PolicyID: 11754})
+	require.NoError(t, err)
+	assert.Equal(t, expected, result)
+	assert.Equal(t, []string{
+		"/cloudlets/api/v2/policies/11754/properties?offset=0&pageSize=100",
+		"/cloudlets/api/v2/policies/11754/properties?offset=100&pageSize=100",
+	}, requests)
+}
@@ -79,9 +79,13 @@ type (
 	}
 
 	// CreatePolicyVersionRequest describes the body of the create policy request
+	//
+	// When CloneFromVersion is set, the new version is created as a copy of the given version of the policy.
+	// It is the way to edit a version which was already activated, as such versions cannot be modified in place.
 	CreatePolicyVersionRequest struct {
 		CreatePolicyVersion
-		PolicyID int64
+		PolicyID         int64
+		CloneFromVersion *int64
 	}
 
 	// CreatePolicyVersion describes the body of the create policy request
@@ -128,7 +132,8 @@ func (c CreatePolicyVersionRequest) Validate() error {
 		"Description": validation.Validate(c.Description, validation.Length(0, 255)),
 		"MatchRuleFormat": validation.Validate(c.MatchRuleFormat, validation.In(MatchRuleFormat10).Error(
 			fmt.Sprintf("value '%s' is invalid. Must be one of: '1.0' or '' (empty)", (&c).MatchRuleFormat))),
-		"MatchRules": validation.Validate(c.MatchRules, validation.Length(0, 5000)),
+		"MatchRules":       validation.Validate(c.MatchRules, validation.Length(0, 5000)),
+		"CloneFromVersion": validation.Validate(c.CloneFromVersion, validation.When(c.CloneFromVersion != nil, validation.Required, validation.Min(int64(1)))),
 	}
 	return edgegriderr.ParseValidationErrors(errs)
 }
@@ -246,6 +251,12 @@ func (c *cloudlets) CreatePolicyVersion(ctx context.Context, params CreatePolicy
 		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrCreatePolicyVersion, err)
 	}
 
+	if params.CloneFromVersion != nil {
+		q := uri.Query()
+		q.Add("cloneVersion", strconv.FormatInt(*params.CloneFromVersion, 10))
+		uri.RawQuery = q.Encode()
+	}
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreatePolicyVersion, err)
@@ -9,7 +9,7 @@ import (
 	"net/url"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/edgegriderr"
-
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/tools"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -99,10 +99,10 @@ func (r ActivatePolicyVersionRequest) Validate() error {
 func (n *PolicyActivationNetwork) UnmarshalJSON(data []byte) error {
 	d := bytes.Trim(data, "\"")
 
-	switch string(d) {
-	case "STAGING", "staging":
+	switch network := tools.ActivationNetwork(d); {
+	case tools.IsStaging(network):
 		*n = PolicyActivationNetworkStaging
-	case "PRODUCTION", "production", "prod":
+	case tools.IsProduction(network):
 		*n = PolicyActivationNetworkProduction
 	default:
 		return fmt.Errorf("cannot unmarshall PolicyActivationNetwork: %q", d)
@@ -4049,6 +4049,77 @@ func TestCreatePolicyVersion(t *testing.T) {
 			expectedPath: "/cloudlets/api/v2/policies/0/versions",
 			withError:    ErrStructValidation,
 		},
+		"201 created, clone from version": {
+			request: CreatePolicyVersionRequest{
+				CreatePolicyVersion: CreatePolicyVersion{
+					Description: "Cloned version",
+				},
+				PolicyID:         276858,
+				CloneFromVersion: tools.Int64Ptr(1),
+			},
+			responseStatus: http.StatusCreated,
+			responseBody: `
+{
+    "activations": [],
+    "createDate": 1629812554924,
+    "createdBy": "jsmith",
+    "deleted": false,
+    "description": "Cloned version",
+    "lastModifiedBy": "jsmith",
+    "lastModifiedDate": 1629812554924,
+    "location": "/cloudlets/api/v2/policies/276858/versions/3",
+    "matchRuleFormat": "1.0",
+    "matchRules": [
+        {
+            "type": "erMatchRule",
+            "end": 0,
+            "id": 0,
+            "name": "rul1",
+            "start": 0,
+            "redirectURL": "/abc/sss",
+            "statusCode": 301,
+            "useIncomingQueryString": false,
+            "useRelativeUrl": "copy_scheme_hostname"
+        }
+    ],
+    "policyId": 276858,
+    "revisionId": 4814869,
+    "rulesLocked": false,
+    "version": 3
+}`,
+			expectedPath: "/cloudlets/api/v2/policies/276858/versions?cloneVersion=1",
+			expectedResponse: &PolicyVersion{
+				Activations:      []PolicyActivation{},
+				CreateDate:       1629812554924,
+				CreatedBy:        "jsmith",
+				Description:      "Cloned version",
+				LastModifiedBy:   "jsmith",
+				LastModifiedDate: 1629812554924,
+				Location:         "/cloudlets/api/v2/policies/276858/versions/3",
+				MatchRuleFormat:  "1.0",
+				MatchRules: MatchRules{
+					&MatchRuleER{
+						Type:                   "erMatchRule",
+						Name:                   "rul1",
+						RedirectURL:            "/abc/sss",
+						StatusCode:             301,
+						UseRelativeURL:         "copy_scheme_hostname",
+						UseIncomingQueryString: false,
+					},
+				},
+				PolicyID:    276858,
+				RevisionID:  4814869,
+				RulesLocked: false,
+				Version:     3,
+			},
+		},
+		"validation error, invalid clone from version": {
+			request: CreatePolicyVersionRequest{
+				PolicyID:         276858,
+				CloneFromVersion: tools.Int64Ptr(0),
+			},
+			withError: ErrStructValidation,
+		},
 	}
 
 	for name, test := range tests {
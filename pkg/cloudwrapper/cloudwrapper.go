@@ -3,6 +3,7 @@ package cloudwrapper
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
@@ -41,3 +42,11 @@ func Client(sess session.Session, opts ...Option) CloudWrapper {
 	}
 	return c
 }
+
+// WithDefaultHeaders adds the headers to every request made by the client, e.g. a static team identifier.
+// Headers set by the client methods and with session.WithContextHeaders take precedence, see session.WithDefaultHeaders.
+func WithDefaultHeaders(h http.Header) Option {
+	return func(c *cloudwrapper) {
+		c.Session = session.WithDefaultHeaders(c.Session, h)
+	}
+}
@@ -76,6 +76,15 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("API error: \n%s", msg)
 }
 
+// LogString returns the error as compact, single-line JSON, suitable for structured log aggregators
+func (e *Error) LogString() string {
+	msg, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Sprintf("error marshaling API error: %s", err)
+	}
+	return string(msg)
+}
+
 // Is handles error comparisons
 func (e *Error) Is(target error) bool {
 	if errors.Is(target, ErrConfigurationNotFound) {
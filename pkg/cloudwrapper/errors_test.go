@@ -1,6 +1,7 @@
 package cloudwrapper
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -142,3 +143,23 @@ func TestIs(t *testing.T) {
 		})
 	}
 }
+
+func TestLogString(t *testing.T) {
+	e := Error{
+		Type:   "/cloud-wrapper/error-types/not-found",
+		Title:  "Not found",
+		Status: 404,
+		Detail: "Configuration not found\nfor the given id",
+		Errors: []ErrorItem{
+			{Type: "error-type", Title: "item title", IllegalParameter: "configId"},
+		},
+	}
+
+	res := e.LogString()
+	assert.NotContains(t, res, "\n")
+	assert.True(t, json.Valid([]byte(res)))
+
+	var unmarshaled Error
+	require.NoError(t, json.Unmarshal([]byte(res), &unmarshaled))
+	assert.Equal(t, e, unmarshaled)
+}
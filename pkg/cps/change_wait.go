@@ -0,0 +1,177 @@
+package cps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type (
+	// WaitChangeRequest contains params required to perform WaitForChangeStatus
+	WaitChangeRequest struct {
+		EnrollmentID int
+		ChangeID     int
+		// TargetStatus is the status of the change to wait for, e.g. "wait-review-pre-verification-safety-checks".
+		// If it is not set, WaitForChangeStatus waits until the change is complete.
+		TargetStatus string
+	}
+
+	// WaitOptions configures polling of WaitForChangeStatus
+	WaitOptions struct {
+		// Interval is the time between consecutive status checks. DefaultWaitInterval is used if it is not set.
+		Interval time.Duration
+		// StopOnRequiredInput returns the change as soon as it awaits an input required to proceed,
+		// e.g. an acknowledgement of pre-verification warnings or completion of DV challenges
+		StopOnRequiredInput bool
+	}
+
+	// ChangeStatusError is returned by WaitForChangeStatus when the change is cancelled or fails.
+	// It matches ErrChangeCancelled or ErrChangeFailed with errors.Is.
+	ChangeStatusError struct {
+		EnrollmentID int
+		ChangeID     int
+		State        string
+		Status       string
+		// StatusError is the error reported for the change, if any
+		StatusError *StatusInfoError
+	}
+)
+
+const (
+	// ChangeStateAwaitingInput is the state of a change which needs an input, listed in its AllowedInput, to proceed
+	ChangeStateAwaitingInput = "awaiting-input"
+	// ChangeStateRunning is the state of a change which is being processed
+	ChangeStateRunning = "running"
+	// ChangeStateError is the state of a failed change
+	ChangeStateError = "error"
+	// ChangeStatusComplete is the status of a completed change
+	ChangeStatusComplete = "complete"
+	// ChangeStatusCancelled is the status of a cancelled change
+	ChangeStatusCancelled = "cancelled"
+
+	// DefaultWaitInterval is the interval used by WaitForChangeStatus if none is given
+	DefaultWaitInterval = 30 * time.Second
+)
+
+var (
+	// ErrChangeCancelled is returned when a change is cancelled while it is waited for
+	ErrChangeCancelled = errors.New("change cancelled")
+	// ErrChangeFailed is returned when a change fails while it is waited for
+	ErrChangeFailed = errors.New("change failed")
+	// ErrWaitForChangeStatus is returned when WaitForChangeStatus fails
+	ErrWaitForChangeStatus = errors.New("waiting for change status")
+)
+
+// Validate validates WaitChangeRequest
+func (c WaitChangeRequest) Validate() error {
+	return validation.Errors{
+		"EnrollmentID": validation.Validate(c.EnrollmentID, validation.Required),
+		"ChangeID":     validation.Validate(c.ChangeID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates WaitOptions
+func (o WaitOptions) Validate() error {
+	return validation.Errors{
+		"Interval": validation.Validate(o.Interval, validation.Min(time.Duration(0))),
+	}.Filter()
+}
+
+// Error returns the error message
+func (e *ChangeStatusError) Error() string {
+	msg := fmt.Sprintf("change %d of enrollment %d ended with state %q and status %q", e.ChangeID, e.EnrollmentID, e.State, e.Status)
+	if e.StatusError != nil {
+		msg += fmt.Sprintf(": %s: %s", e.StatusError.Code, e.StatusError.Description)
+	}
+	return msg
+}
+
+// Is allows the error to be matched with ErrChangeCancelled or ErrChangeFailed
+func (e *ChangeStatusError) Is(target error) bool {
+	if e.cancelled() {
+		return target == ErrChangeCancelled
+	}
+	return target == ErrChangeFailed
+}
+
+func (e *ChangeStatusError) cancelled() bool {
+	return strings.EqualFold(e.Status, ChangeStatusCancelled) || strings.EqualFold(e.State, ChangeStatusCancelled)
+}
+
+// RequiredInput returns the allowed inputs which are required for the change to proceed, e.g. to tell
+// whether an action like DV validation is needed
+func (c *Change) RequiredInput() []AllowedInput {
+	var inputs []AllowedInput
+	for _, input := range c.AllowedInput {
+		if input.RequiredToProceed {
+			inputs = append(inputs, input)
+		}
+	}
+	return inputs
+}
+
+func (c *cps) WaitForChangeStatus(ctx context.Context, params WaitChangeRequest, opts WaitOptions) (*Change, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrWaitForChangeStatus, ErrStructValidation, err)
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrWaitForChangeStatus, ErrStructValidation, err)
+	}
+
+	logger := c.Log(ctx)
+	logger.Debug("WaitForChangeStatus")
+
+	interval := opts.Interval
+	if interval == 0 {
+		interval = DefaultWaitInterval
+	}
+	target := params.TargetStatus
+	if target == "" {
+		target = ChangeStatusComplete
+	}
+
+	for {
+		change, err := c.GetChangeStatus(ctx, GetChangeStatusRequest{
+			EnrollmentID: params.EnrollmentID,
+			ChangeID:     params.ChangeID,
+		})
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				// GetChangeStatus does not wrap the error of the request, so the expired context is returned instead
+				return nil, fmt.Errorf("%s: %w", ErrWaitForChangeStatus, ctxErr)
+			}
+			return nil, fmt.Errorf("%s: %w", ErrWaitForChangeStatus, err)
+		}
+
+		if info := change.StatusInfo; info != nil {
+			logger.Debugf("change %d of enrollment %d has state %q and status %q", params.ChangeID, params.EnrollmentID, info.State, info.Status)
+			statusErr := &ChangeStatusError{
+				EnrollmentID: params.EnrollmentID,
+				ChangeID:     params.ChangeID,
+				State:        info.State,
+				Status:       info.Status,
+				StatusError:  info.Error,
+			}
+			switch {
+			case strings.EqualFold(info.Status, target):
+				return change, nil
+			case statusErr.cancelled(), strings.EqualFold(info.State, ChangeStateError):
+				return nil, fmt.Errorf("%s: %w", ErrWaitForChangeStatus, statusErr)
+			case strings.EqualFold(info.Status, ChangeStatusComplete):
+				// the target status was skipped or already passed, the change cannot reach it anymore
+				return change, nil
+			case opts.StopOnRequiredInput && strings.EqualFold(info.State, ChangeStateAwaitingInput) && len(change.RequiredInput()) > 0:
+				return change, nil
+			}
+		}
+
+		if err := session.Wait(ctx, interval); err != nil {
+			return nil, fmt.Errorf("%s: %w", ErrWaitForChangeStatus, err)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package cps
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForChangeStatus(t *testing.T) {
+	const (
+		running       = `{"allowedInput": [], "statusInfo": {"state": "running", "status": "coordinate-domain-validation", "description": "Validating domains"}}`
+		preVerify     = `{"allowedInput": [{"info": "/cps/v2/enrollments/1/changes/10/input/info/pre-verification-warnings", "requiredToProceed": true, "type": "pre-verification-warnings-acknowledgement", "update": "/cps/v2/enrollments/1/changes/10/input/update/pre-verification-warnings-ack"}], "statusInfo": {"state": "awaiting-input", "status": "wait-review-pre-verification-safety-checks", "description": "Waiting for you to review warnings"}}`
+		awaitingInput = `{"allowedInput": [{"info": "/cps/v2/enrollments/1/changes/10/input/info/lets-encrypt-challenges", "requiredToProceed": true, "type": "lets-encrypt-challenges", "update": "/cps/v2/enrollments/1/changes/10/input/update/lets-encrypt-challenges-completed"}], "statusInfo": {"state": "awaiting-input", "status": "coordinate-domain-validation", "description": "Waiting for domain validation"}}`
+		cancelled     = `{"allowedInput": [], "statusInfo": {"state": "cancelled", "status": "cancelled", "description": "Change was cancelled"}}`
+		failed        = `{"allowedInput": [], "statusInfo": {"state": "error", "status": "coordinate-domain-validation", "description": "Domain validation failed", "error": {"code": "DV_FAILED", "description": "validation of example.com failed", "timestamp": "2023-09-21T14:38:05Z"}}}`
+		complete      = `{"allowedInput": [], "statusInfo": {"state": "running", "status": "complete", "description": "Change is complete"}}`
+		// hang is not answered until the request is cancelled
+		hang = ""
+	)
+
+	tests := map[string]struct {
+		params           WaitChangeRequest
+		opts             WaitOptions
+		timeout          time.Duration
+		responses        []string
+		expectedRequests int
+		expectedStatus   string
+		expectedInput    []AllowedInput
+		withError        []error
+	}{
+		"target status reached": {
+			params:           WaitChangeRequest{EnrollmentID: 1, ChangeID: 10, TargetStatus: "wait-review-pre-verification-safety-checks"},
+			responses:        []string{running, running, preVerify},
+			expectedRequests: 3,
+			expectedStatus:   "wait-review-pre-verification-safety-checks",
+			expectedInput: []AllowedInput{{
+				Info:              "/cps/v2/enrollments/1/changes/10/input/info/pre-verification-warnings",
+				RequiredToProceed: true,
+				Type:              "pre-verification-warnings-acknowledgement",
+				Update:            "/cps/v2/enrollments/1/changes/10/input/update/pre-verification-warnings-ack",
+			}},
+		},
+		"complete by default": {
+			params:           WaitChangeRequest{EnrollmentID: 1, ChangeID: 10},
+			responses:        []string{running, complete},
+			expectedRequests: 2,
+			expectedStatus:   "complete",
+		},
+		"change completed without reaching target status": {
+			params:           WaitChangeRequest{EnrollmentID: 1, ChangeID: 10, TargetStatus: "wait-upload-third-party"},
+			responses:        []string{running, complete},
+			expectedRequests: 2,
+			expectedStatus:   "complete",
+		},
+		"stop on required input": {
+			params:           WaitChangeRequest{EnrollmentID: 1, ChangeID: 10},
+			opts:             WaitOptions{StopOnRequiredInput: true},
+			responses:        []string{running, awaitingInput},
+			expectedRequests: 2,
+			expectedStatus:   "coordinate-domain-validation",
+			expectedInput: []AllowedInput{{
+				Info:              "/cps/v2/enrollments/1/changes/10/input/info/lets-encrypt-challenges",
+				RequiredToProceed: true,
+				Type:              "lets-encrypt-challenges",
+				Update:            "/cps/v2/enrollments/1/changes/10/input/update/lets-encrypt-challenges-completed",
+			}},
+		},
+		"required input is waited for by default": {
+			params:           WaitChangeRequest{EnrollmentID: 1, ChangeID: 10},
+			responses:        []string{awaitingInput, complete},
+			expectedRequests: 2,
+			expectedStatus:   "complete",
+		},
+		"change cancelled": {
+			params:           WaitChangeRequest{EnrollmentID: 1, ChangeID: 10},
+			responses:        []string{running, cancelled},
+			expectedRequests: 2,
+			withError:        []error{ErrChangeCancelled},
+		},
+		"change failed": {
+			params:           WaitChangeRequest{EnrollmentID: 1, ChangeID: 10},
+			responses:        []string{failed},
+			expectedRequests: 1,
+			withError:        []error{ErrChangeFailed},
+		},
+		"context deadline exceeded": {
+			params:    WaitChangeRequest{EnrollmentID: 1, ChangeID: 10},
+			timeout:   50 * time.Millisecond,
+			responses: []string{running},
+			withError: []error{context.DeadlineExceeded},
+		},
+		"context deadline exceeded during request": {
+			params:    WaitChangeRequest{EnrollmentID: 1, ChangeID: 10},
+			timeout:   50 * time.Millisecond,
+			responses: []string{running, hang},
+			withError: []error{context.DeadlineExceeded},
+		},
+		"validation error": {
+			params:    WaitChangeRequest{EnrollmentID: 1},
+			withError: []error{ErrStructValidation},
+		},
+		"negative interval": {
+			params:    WaitChangeRequest{EnrollmentID: 1, ChangeID: 10},
+			opts:      WaitOptions{Interval: -time.Second},
+			withError: []error{ErrStructValidation},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests int
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/cps/v2/enrollments/1/changes/10", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				response := test.responses[len(test.responses)-1]
+				if requests < len(test.responses) {
+					response = test.responses[requests]
+				}
+				requests++
+				if response == hang {
+					<-r.Context().Done()
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(response))
+				assert.NoError(t, err)
+			}))
+			defer mockServer.Close()
+			client := mockAPIClient(t, mockServer)
+
+			ctx := context.Background()
+			if test.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.timeout)
+				defer cancel()
+			}
+			opts := test.opts
+			if opts.Interval == 0 {
+				opts.Interval = time.Millisecond
+			}
+			result, err := client.WaitForChangeStatus(ctx, test.params, opts)
+			if test.withError != nil {
+				for _, want := range test.withError {
+					assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+				}
+				if test.expectedRequests > 0 {
+					assert.Equal(t, test.expectedRequests, requests)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedRequests, requests)
+			assert.Equal(t, test.expectedStatus, result.StatusInfo.Status)
+			assert.Equal(t, test.expectedInput, result.RequiredInput())
+		})
+	}
+}
+
+func TestChangeStatusError(t *testing.T) {
+	failed := &ChangeStatusError{
+		EnrollmentID: 1,
+		ChangeID:     10,
+		State:        ChangeStateError,
+		Status:       "coordinate-domain-validation",
+		StatusError:  &StatusInfoError{Code: "DV_FAILED", Description: "validation of example.com failed"},
+	}
+	assert.True(t, errors.Is(failed, ErrChangeFailed))
+	assert.False(t, errors.Is(failed, ErrChangeCancelled))
+	assert.Equal(t, `change 10 of enrollment 1 ended with state "error" and status "coordinate-domain-validation": DV_FAILED: validation of example.com failed`, failed.Error())
+
+	cancelled := &ChangeStatusError{EnrollmentID: 1, ChangeID: 10, State: "cancelled", Status: ChangeStatusCancelled}
+	assert.True(t, errors.Is(cancelled, ErrChangeCancelled))
+	assert.False(t, errors.Is(cancelled, ErrChangeFailed))
+}
@@ -31,6 +31,14 @@ type (
 		//
 		// See: https://techdocs.akamai.com/cps/reference/post-change-allowed-input-param
 		UpdateChange(context.Context, UpdateChangeRequest) (*UpdateChangeResponse, error)
+
+		// WaitForChangeStatus polls the change every WaitOptions.Interval until it reaches the target status
+		// or completes, or until the context is done. With WaitOptions.StopOnRequiredInput, it also returns
+		// when the change awaits an input required to proceed, see Change.RequiredInput.
+		// It returns *ChangeStatusError if the change is cancelled or fails.
+		//
+		// See: https://techdocs.akamai.com/cps/reference/get-enrollment-change
+		WaitForChangeStatus(context.Context, WaitChangeRequest, WaitOptions) (*Change, error)
 	}
 
 	// Change contains change status information
@@ -3,6 +3,7 @@ package cps
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
@@ -10,6 +11,9 @@ import (
 var (
 	// ErrStructValidation is returned returned when given struct validation failed
 	ErrStructValidation = errors.New("struct validation")
+
+	// ErrNotFound is matched by errors returned when the requested resource, e.g. an enrollment or its deployment, does not exist
+	ErrNotFound = errors.New("resource not found")
 )
 
 type (
@@ -49,3 +53,11 @@ func Client(sess session.Session, opts ...Option) CPS {
 	}
 	return c
 }
+
+// WithDefaultHeaders adds the headers to every request made by the client, e.g. a static team identifier.
+// Headers set by the client methods and with session.WithContextHeaders take precedence, see session.WithDefaultHeaders.
+func WithDefaultHeaders(h http.Header) Option {
+	return func(c *cps) {
+		c.Session = session.WithDefaultHeaders(c.Session, h)
+	}
+}
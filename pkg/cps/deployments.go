@@ -2,9 +2,13 @@ package cps
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
@@ -103,8 +107,68 @@ var (
 	ErrGetProductionDeployment = errors.New("get production deployment")
 	// ErrGetStagingDeployment is returned when GetStagingDeployment fails
 	ErrGetStagingDeployment = errors.New("get staging deployment")
+	// ErrCertificateExpiry is returned when the expiry date of a deployed certificate is missing or cannot be parsed
+	ErrCertificateExpiry = errors.New("certificate expiry")
 )
 
+// Expiry returns the earliest expiry date of the certificates in the deployment, i.e. the primary certificate
+// and multi-stacked certificates. The expiry is taken from the Expiry field or, when it is empty, from the
+// notAfter date of the PEM encoded certificate.
+func (d *Deployment) Expiry() (time.Time, error) {
+	expiry, err := d.PrimaryCertificate.expiry()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: primary certificate: %s", ErrCertificateExpiry, err)
+	}
+	for i, cert := range d.MultiStackedCertificates {
+		stackedExpiry, err := cert.expiry()
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: multi-stacked certificate %d: %s", ErrCertificateExpiry, i, err)
+		}
+		if stackedExpiry.Before(expiry) {
+			expiry = stackedExpiry
+		}
+	}
+	return expiry, nil
+}
+
+// DaysUntilExpiry returns the number of whole days left until the deployment expires, see Expiry.
+// The result is negative if the deployment has already expired.
+func (d *Deployment) DaysUntilExpiry(now time.Time) (int, error) {
+	expiry, err := d.Expiry()
+	if err != nil {
+		return 0, err
+	}
+	return int(math.Floor(expiry.Sub(now).Hours() / 24)), nil
+}
+
+// IsExpiringSoon reports whether the deployment expires within threshold from now, see Expiry.
+// Deployments which have already expired are reported as expiring soon.
+func (d *Deployment) IsExpiringSoon(threshold time.Duration) (bool, error) {
+	expiry, err := d.Expiry()
+	if err != nil {
+		return false, err
+	}
+	return time.Until(expiry) < threshold, nil
+}
+
+func (c DeploymentCertificate) expiry() (time.Time, error) {
+	if c.Expiry != "" {
+		return time.Parse(time.RFC3339, c.Expiry)
+	}
+	if c.Certificate == "" {
+		return time.Time{}, errors.New("neither expiry nor certificate is provided")
+	}
+	block, _ := pem.Decode([]byte(c.Certificate))
+	if block == nil {
+		return time.Time{}, errors.New("certificate is not PEM encoded")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cert.NotAfter, nil
+}
+
 func (c *cps) ListDeployments(ctx context.Context, params ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
 	logger := c.Log(ctx)
 	logger.Debug("ListDeployments")
@@ -2,10 +2,18 @@ package cps
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/tools"
 	"github.com/stretchr/testify/assert"
@@ -198,6 +206,24 @@ func TestListDeployments(t *testing.T) {
 				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
 			},
 		},
+		"404 not found": {
+			params:         ListDeploymentsRequest{EnrollmentID: 404},
+			responseStatus: http.StatusNotFound,
+			responseBody: `
+{
+   "type": "not-found",
+   "title": "Not Found",
+   "detail": "Enrollment not found",
+   "status": 404
+}`,
+			expectedPath: "/cps/v2/enrollments/404/deployments",
+			expectedHeaders: map[string]string{
+				"Accept": "application/vnd.akamai.cps.deployments.v7+json",
+			},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrNotFound), "want: %s; got: %s", ErrNotFound, err)
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -336,6 +362,24 @@ func TestGetProductionDeployment(t *testing.T) {
 				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
 			},
 		},
+		"404 not found": {
+			params:         GetDeploymentRequest{EnrollmentID: 404},
+			responseStatus: http.StatusNotFound,
+			responseBody: `
+{
+   "type": "not-found",
+   "title": "Not Found",
+   "detail": "Enrollment not found",
+   "status": 404
+}`,
+			expectedPath: "/cps/v2/enrollments/404/deployments/production",
+			expectedHeaders: map[string]string{
+				"Accept": "application/vnd.akamai.cps.deployment.v7+json",
+			},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrNotFound), "want: %s; got: %s", ErrNotFound, err)
+			},
+		},
 		"validation error": {
 			params: GetDeploymentRequest{},
 			withError: func(t *testing.T, err error) {
@@ -481,6 +525,24 @@ func TestGetStagingDeployment(t *testing.T) {
 				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
 			},
 		},
+		"404 not found": {
+			params:         GetDeploymentRequest{EnrollmentID: 404},
+			responseStatus: http.StatusNotFound,
+			responseBody: `
+{
+   "type": "not-found",
+   "title": "Not Found",
+   "detail": "Enrollment not found",
+   "status": 404
+}`,
+			expectedPath: "/cps/v2/enrollments/404/deployments/staging",
+			expectedHeaders: map[string]string{
+				"Accept": "application/vnd.akamai.cps.deployment.v7+json",
+			},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrNotFound), "want: %s; got: %s", ErrNotFound, err)
+			},
+		},
 		"validation error": {
 			params: GetDeploymentRequest{},
 			withError: func(t *testing.T, err error) {
@@ -512,3 +574,138 @@ func TestGetStagingDeployment(t *testing.T) {
 		})
 	}
 }
+
+func TestDeployment_Expiry(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	pemCertificate := newTestCertificate(t, now.Add(45*24*time.Hour))
+
+	tests := map[string]struct {
+		deployment   Deployment
+		expectedDays int
+		withError    error
+	}{
+		"healthy": {
+			deployment: Deployment{
+				PrimaryCertificate: DeploymentCertificate{Expiry: "2024-01-01T12:00:00Z"},
+			},
+			expectedDays: 92,
+		},
+		"expiring soon": {
+			deployment: Deployment{
+				PrimaryCertificate: DeploymentCertificate{Expiry: "2023-10-08T11:00:00Z"},
+			},
+			expectedDays: 6,
+		},
+		"expired": {
+			deployment: Deployment{
+				PrimaryCertificate: DeploymentCertificate{Expiry: "2023-09-30T12:00:00Z"},
+			},
+			expectedDays: -1,
+		},
+		"multi-stacked certificate expires first": {
+			deployment: Deployment{
+				PrimaryCertificate:       DeploymentCertificate{Expiry: "2024-01-01T12:00:00Z"},
+				MultiStackedCertificates: []DeploymentCertificate{{Expiry: "2023-10-11T12:00:00Z"}},
+			},
+			expectedDays: 10,
+		},
+		"expiry taken from certificate": {
+			deployment: Deployment{
+				PrimaryCertificate: DeploymentCertificate{Certificate: pemCertificate},
+			},
+			expectedDays: 45,
+		},
+		"missing expiry": {
+			deployment: Deployment{},
+			withError:  ErrCertificateExpiry,
+		},
+		"invalid expiry": {
+			deployment: Deployment{
+				PrimaryCertificate: DeploymentCertificate{Expiry: "05/02/2022"},
+			},
+			withError: ErrCertificateExpiry,
+		},
+		"invalid certificate": {
+			deployment: Deployment{
+				PrimaryCertificate: DeploymentCertificate{Certificate: "-----BEGIN CERTIFICATE-----\nMIID <sample - removed for readability> .... 93Nvw==\n-----END CERTIFICATE-----"},
+			},
+			withError: ErrCertificateExpiry,
+		},
+		"invalid multi-stacked certificate expiry": {
+			deployment: Deployment{
+				PrimaryCertificate:       DeploymentCertificate{Expiry: "2024-01-01T12:00:00Z"},
+				MultiStackedCertificates: []DeploymentCertificate{{Expiry: "soon"}},
+			},
+			withError: ErrCertificateExpiry,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			days, err := test.deployment.DaysUntilExpiry(now)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedDays, days)
+		})
+	}
+}
+
+func TestDeployment_IsExpiringSoon(t *testing.T) {
+	expiringIn := func(d time.Duration) Deployment {
+		return Deployment{
+			PrimaryCertificate: DeploymentCertificate{Expiry: time.Now().Add(d).UTC().Format(time.RFC3339)},
+		}
+	}
+
+	tests := map[string]struct {
+		deployment Deployment
+		expected   bool
+		withError  error
+	}{
+		"healthy": {
+			deployment: expiringIn(90 * 24 * time.Hour),
+			expected:   false,
+		},
+		"expiring soon": {
+			deployment: expiringIn(7 * 24 * time.Hour),
+			expected:   true,
+		},
+		"expired": {
+			deployment: expiringIn(-24 * time.Hour),
+			expected:   true,
+		},
+		"missing expiry": {
+			deployment: Deployment{},
+			withError:  ErrCertificateExpiry,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expiring, err := test.deployment.IsExpiringSoon(30 * 24 * time.Hour)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, expiring)
+		})
+	}
+}
+
+func newTestCertificate(t *testing.T, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "www.example.com"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
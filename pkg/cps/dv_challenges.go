@@ -16,7 +16,9 @@ type (
 		// See: https://techdocs.akamai.com/cps/reference/get-change-allowed-input-param
 		GetChangeLetsEncryptChallenges(context.Context, GetChangeRequest) (*DVArray, error)
 
-		// AcknowledgeDVChallenges sends acknowledgement request to CPS informing that the validation is completed
+		// AcknowledgeDVChallenges sends acknowledgement request to CPS informing that the validation is completed.
+		// The change is checked first and the error matches ErrChangeNotAwaitingDVChallenges if it does not await
+		// the DV challenges to be completed.
 		//
 		// See: https://techdocs.akamai.com/cps/reference/post-change-allowed-input-param
 		AcknowledgeDVChallenges(context.Context, AcknowledgementRequest) error
@@ -63,13 +65,40 @@ type (
 	}
 )
 
+const (
+	// ChallengeTypeDNS is the type of challenge validated with a DNS TXT record
+	ChallengeTypeDNS = "dns-01"
+	// ChallengeTypeHTTP is the type of challenge validated with a token served over HTTP
+	ChallengeTypeHTTP = "http-01"
+
+	// dvChallengesInputType is the type of the allowed input of a change awaiting DV challenges
+	dvChallengesInputType = "lets-encrypt-challenges"
+)
+
 var (
+	// ErrChangeNotAwaitingDVChallenges is returned when DV challenges are acknowledged for a change which does not await them
+	ErrChangeNotAwaitingDVChallenges = errors.New("change does not await DV challenges")
 	// ErrGetChangeLetsEncryptChallenges is returned when GetChangeLetsEncryptChallenges fails
 	ErrGetChangeLetsEncryptChallenges = errors.New("fetching change for lets-encrypt-challenges")
 	// ErrAcknowledgeLetsEncryptChallenges when AcknowledgeDVChallenges fails
 	ErrAcknowledgeLetsEncryptChallenges = errors.New("acknowledging lets-encrypt-challenges")
 )
 
+// ChallengesOfType returns the challenge of the given type, e.g. ChallengeTypeHTTP, for each domain which has one.
+// Its FullPath, ResponseBody and Token describe what has to be published to complete the validation.
+func (d DVArray) ChallengesOfType(challengeType string) map[string]Challenge {
+	challenges := make(map[string]Challenge)
+	for _, dv := range d.DV {
+		for _, challenge := range dv.Challenges {
+			if challenge.Type == challengeType {
+				challenges[dv.Domain] = challenge
+				break
+			}
+		}
+	}
+	return challenges
+}
+
 func (c *cps) GetChangeLetsEncryptChallenges(ctx context.Context, params GetChangeRequest) (*DVArray, error) {
 	if err := params.Validate(); err != nil {
 		return nil, fmt.Errorf("%s: %w: %s", ErrGetChangeLetsEncryptChallenges, ErrStructValidation, err)
@@ -113,7 +142,19 @@ func (c *cps) AcknowledgeDVChallenges(ctx context.Context, params Acknowledgemen
 	}
 
 	logger := c.Log(ctx)
-	logger.Debug("AcknowledgeDVVhallenges")
+	logger.Debug("AcknowledgeDVChallenges")
+
+	change, err := c.GetChangeStatus(ctx, GetChangeStatusRequest{EnrollmentID: params.EnrollmentID, ChangeID: params.ChangeID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrAcknowledgeLetsEncryptChallenges, err)
+	}
+	if !awaitsDVChallenges(change) {
+		var status string
+		if change.StatusInfo != nil {
+			status = change.StatusInfo.Status
+		}
+		return fmt.Errorf("%s: %w: change status is %q", ErrAcknowledgeLetsEncryptChallenges, ErrChangeNotAwaitingDVChallenges, status)
+	}
 
 	uri, err := url.Parse(fmt.Sprintf(
 		"/cps/v2/enrollments/%d/changes/%d/input/update/lets-encrypt-challenges-completed",
@@ -140,3 +181,13 @@ func (c *cps) AcknowledgeDVChallenges(ctx context.Context, params Acknowledgemen
 
 	return nil
 }
+
+// awaitsDVChallenges reports whether the DV challenges are one of the inputs the change accepts
+func awaitsDVChallenges(change *Change) bool {
+	for _, input := range change.AllowedInput {
+		if input.Type == dvChallengesInputType {
+			return true
+		}
+	}
+	return false
+}
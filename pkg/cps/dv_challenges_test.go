@@ -3,6 +3,7 @@ package cps
 import (
 	"context"
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -129,13 +130,49 @@ func TestGetChangeLetsEncryptChallenges(t *testing.T) {
 }
 
 func TestAcknowledgeDVChallenges(t *testing.T) {
+	const (
+		awaitingDV = `
+{
+  "allowedInput": [
+    {
+      "info": "/cps/v2/enrollments/1/changes/2/input/info/lets-encrypt-challenges",
+      "requiredToProceed": true,
+      "type": "lets-encrypt-challenges",
+      "update": "/cps/v2/enrollments/1/changes/2/input/update/lets-encrypt-challenges-completed"
+    }
+  ],
+  "statusInfo": {
+    "state": "awaiting-input",
+    "status": "coordinate-domain-validation",
+    "description": "Waiting for domain validation"
+  }
+}`
+		awaitingReview = `
+{
+  "allowedInput": [
+    {
+      "info": "/cps/v2/enrollments/1/changes/2/input/info/pre-verification-warnings",
+      "requiredToProceed": true,
+      "type": "pre-verification-warnings-acknowledgement",
+      "update": "/cps/v2/enrollments/1/changes/2/input/update/pre-verification-warnings-ack"
+    }
+  ],
+  "statusInfo": {
+    "state": "awaiting-input",
+    "status": "wait-review-pre-verification-safety-checks",
+    "description": "Waiting for you to review warnings"
+  }
+}`
+	)
+
 	tests := map[string]struct {
-		params           AcknowledgementRequest
-		responseStatus   int
-		responseBody     string
-		expectedPath     string
-		expectedResponse *DVArray
-		withError        func(*testing.T, error)
+		params         AcknowledgementRequest
+		changeStatus   string
+		responseStatus int
+		responseBody   string
+		expectedPath   string
+		expectedBody   string
+		withError      func(*testing.T, error)
 	}{
 		"204 no content": {
 			params: AcknowledgementRequest{
@@ -143,8 +180,22 @@ func TestAcknowledgeDVChallenges(t *testing.T) {
 				ChangeID:        2,
 				Acknowledgement: Acknowledgement{"acknowledge"},
 			},
+			changeStatus:   awaitingDV,
 			responseStatus: http.StatusNoContent,
 			expectedPath:   "/cps/v2/enrollments/1/changes/2/input/update/lets-encrypt-challenges-completed",
+			expectedBody:   `{"acknowledgement":"acknowledge"}`,
+		},
+		"change does not await DV challenges": {
+			params: AcknowledgementRequest{
+				EnrollmentID:    1,
+				ChangeID:        2,
+				Acknowledgement: Acknowledgement{"acknowledge"},
+			},
+			changeStatus: awaitingReview,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrChangeNotAwaitingDVChallenges), "want: %s; got: %s", ErrChangeNotAwaitingDVChallenges, err)
+				assert.Contains(t, err.Error(), "wait-review-pre-verification-safety-checks")
+			},
 		},
 		"500 internal server error": {
 			params: AcknowledgementRequest{
@@ -152,8 +203,10 @@ func TestAcknowledgeDVChallenges(t *testing.T) {
 				ChangeID:        2,
 				Acknowledgement: Acknowledgement{"acknowledge"},
 			},
+			changeStatus:   awaitingDV,
 			responseStatus: http.StatusInternalServerError,
 			expectedPath:   "/cps/v2/enrollments/1/changes/2/input/update/lets-encrypt-challenges-completed",
+			expectedBody:   `{"acknowledgement":"acknowledge"}`,
 			responseBody: `
 {
   "type": "internal_error",
@@ -180,17 +233,30 @@ func TestAcknowledgeDVChallenges(t *testing.T) {
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
+			var acknowledged bool
 			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					assert.Equal(t, "/cps/v2/enrollments/1/changes/2", r.URL.String())
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(test.changeStatus))
+					require.NoError(t, err)
+					return
+				}
+				acknowledged = true
 				assert.Equal(t, test.expectedPath, r.URL.String())
 				assert.Equal(t, http.MethodPost, r.Method)
 				assert.Equal(t, "application/vnd.akamai.cps.change-id.v1+json", r.Header.Get("Accept"))
 				assert.Equal(t, "application/vnd.akamai.cps.acknowledgement.v1+json; charset=utf-8", r.Header.Get("Content-Type"))
+				body, err := ioutil.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.JSONEq(t, test.expectedBody, string(body))
 				w.WriteHeader(test.responseStatus)
-				_, err := w.Write([]byte(test.responseBody))
+				_, err = w.Write([]byte(test.responseBody))
 				require.NoError(t, err)
 			}))
 			client := mockAPIClient(t, mockServer)
 			err := client.AcknowledgeDVChallenges(context.Background(), test.params)
+			assert.Equal(t, test.expectedPath != "", acknowledged)
 			if test.withError != nil {
 				test.withError(t, err)
 				return
@@ -199,3 +265,24 @@ func TestAcknowledgeDVChallenges(t *testing.T) {
 		})
 	}
 }
+
+func TestDVArray_ChallengesOfType(t *testing.T) {
+	dvs := DVArray{DV: []DV{
+		{
+			Domain: "example.com",
+			Challenges: []Challenge{
+				{Type: ChallengeTypeDNS, FullPath: "_acme-challenge.example.com.", ResponseBody: "dns-token", Token: "token1"},
+				{Type: ChallengeTypeHTTP, FullPath: "http://example.com/.well-known/acme-challenge/token1", ResponseBody: "http-token", Token: "token1"},
+			},
+		},
+		{
+			Domain:     "www.example.com",
+			Challenges: []Challenge{{Type: ChallengeTypeDNS, FullPath: "_acme-challenge.www.example.com.", ResponseBody: "dns-token2", Token: "token2"}},
+		},
+	}}
+
+	assert.Equal(t, map[string]Challenge{
+		"example.com": {Type: ChallengeTypeHTTP, FullPath: "http://example.com/.well-known/acme-challenge/token1", ResponseBody: "http-token", Token: "token1"},
+	}, dvs.ChallengesOfType(ChallengeTypeHTTP))
+	assert.Len(t, dvs.ChallengesOfType(ChallengeTypeDNS), 2)
+}
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
 )
 
 type (
@@ -216,6 +219,13 @@ type (
 
 	// OCSPStapling is used to enable OCSP stapling for an enrollment
 	OCSPStapling string
+
+	// EnrollmentValidationError is returned when an enrollment request fails client-side validation.
+	// It contains errors of all invalid fields and matches ErrStructValidation.
+	EnrollmentValidationError struct {
+		// Fields maps paths of invalid fields, e.g. "enrollment.csr.sans[1]", to their errors
+		Fields map[string]error
+	}
 )
 
 const (
@@ -225,6 +235,23 @@ const (
 	OCSPStaplingOff OCSPStapling = "off"
 	// OCSPStaplingNotSet parameter value
 	OCSPStaplingNotSet OCSPStapling = "not-set"
+
+	// ValidationTypeDV is the domain validation type
+	ValidationTypeDV = "dv"
+	// ValidationTypeOV is the organization validation type
+	ValidationTypeOV = "ov"
+	// ValidationTypeEV is the extended validation type
+	ValidationTypeEV = "ev"
+	// ValidationTypeThirdParty is the validation type of third-party certificates
+	ValidationTypeThirdParty = "third-party"
+
+	// SignatureAlgorithmSHA1 is the SHA-1 signature algorithm
+	SignatureAlgorithmSHA1 = "SHA-1"
+	// SignatureAlgorithmSHA256 is the SHA-256 signature algorithm
+	SignatureAlgorithmSHA256 = "SHA-256"
+
+	// maxCNLength is the maximum length of the common name allowed by X.509
+	maxCNLength = 64
 )
 
 // Validate performs validation on Enrollment
@@ -236,14 +263,15 @@ func (e Enrollment) Validate() error {
 		"networkConfiguration": validation.Validate(e.NetworkConfiguration, validation.Required),
 		"org":                  validation.Validate(e.Org, validation.Required),
 		"ra":                   validation.Validate(e.RA, validation.Required),
+		"signatureAlgorithm":   validation.Validate(e.SignatureAlgorithm, validation.In(SignatureAlgorithmSHA1, SignatureAlgorithmSHA256)),
 		"techContact":          validation.Validate(e.TechContact, validation.Required),
-		"validationType":       validation.Validate(e.ValidationType, validation.Required),
+		"validationType":       validation.Validate(e.ValidationType, validation.Required, validation.In(ValidationTypeDV, ValidationTypeOV, ValidationTypeEV, ValidationTypeThirdParty)),
 		"thirdParty":           validation.Validate(e.ThirdParty),
 	}
 
 	if e.CSR != nil {
 		errs["csr.preferredTrustChain"] = validation.Validate(e.CSR.PreferredTrustChain,
-			validation.When(e.ValidationType != "dv", validation.Empty.Error("must be blank when 'validationType' is not 'dv'")))
+			validation.When(e.ValidationType != ValidationTypeDV, validation.Empty.Error("must be blank when 'validationType' is not 'dv'")))
 	}
 
 	return errs.Filter()
@@ -252,7 +280,8 @@ func (e Enrollment) Validate() error {
 // Validate performs validation on Enrollment
 func (c CSR) Validate() error {
 	return validation.Errors{
-		"cn": validation.Validate(c.CN, validation.Required),
+		"cn":   validation.Validate(c.CN, validation.Required, validation.Length(0, maxCNLength), validation.By(certificateHostname)),
+		"sans": validation.Validate(c.SANS, validation.Each(validation.By(certificateHostname))),
 	}.Filter()
 }
 
@@ -375,7 +404,7 @@ func (c *cps) GetEnrollment(ctx context.Context, params GetEnrollmentRequest) (*
 
 func (c *cps) CreateEnrollment(ctx context.Context, params CreateEnrollmentRequest) (*CreateEnrollmentResponse, error) {
 	if err := params.Validate(); err != nil {
-		return nil, fmt.Errorf("%s: %w: %s", ErrCreateEnrollment, ErrStructValidation, err)
+		return nil, fmt.Errorf("%s: %w", ErrCreateEnrollment, newEnrollmentValidationError(err))
 	}
 
 	logger := c.Log(ctx)
@@ -424,7 +453,7 @@ func (c *cps) CreateEnrollment(ctx context.Context, params CreateEnrollmentReque
 
 func (c *cps) UpdateEnrollment(ctx context.Context, params UpdateEnrollmentRequest) (*UpdateEnrollmentResponse, error) {
 	if err := params.Validate(); err != nil {
-		return nil, fmt.Errorf("%s: %w: %s", ErrCreateEnrollment, ErrStructValidation, err)
+		return nil, fmt.Errorf("%s: %w", ErrCreateEnrollment, newEnrollmentValidationError(err))
 	}
 
 	logger := c.Log(ctx)
@@ -524,3 +553,61 @@ func (c *cps) RemoveEnrollment(ctx context.Context, params RemoveEnrollmentReque
 
 	return &result, nil
 }
+
+// certificateHostname checks that the value is a hostname which can be used in a certificate, optionally with a wildcard
+func certificateHostname(value interface{}) error {
+	name, ok := value.(string)
+	if !ok || name == "" {
+		return nil
+	}
+	if err := is.DNSName.Validate(strings.TrimPrefix(name, "*.")); err != nil {
+		return validation.NewError("validation_is_certificate_hostname", "must be a valid hostname, optionally prefixed with '*.'")
+	}
+	return nil
+}
+
+func newEnrollmentValidationError(err error) *EnrollmentValidationError {
+	e := &EnrollmentValidationError{Fields: make(map[string]error)}
+	e.addFields("", err)
+	return e
+}
+
+func (e *EnrollmentValidationError) addFields(path string, err error) {
+	var errs validation.Errors
+	if !errors.As(err, &errs) {
+		e.Fields[path] = err
+		return
+	}
+	for key, fieldErr := range errs {
+		if fieldErr == nil {
+			continue
+		}
+		fieldPath := key
+		if _, convErr := strconv.Atoi(key); convErr == nil {
+			fieldPath = fmt.Sprintf("%s[%s]", path, key)
+		} else if path != "" {
+			fieldPath = fmt.Sprintf("%s.%s", path, key)
+		}
+		e.addFields(fieldPath, fieldErr)
+	}
+}
+
+// Error returns errors of all invalid fields, sorted by the field path
+func (e *EnrollmentValidationError) Error() string {
+	paths := make([]string, 0, len(e.Fields))
+	for path := range e.Fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	msgs := make([]string, 0, len(paths))
+	for _, path := range paths {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", path, e.Fields[path]))
+	}
+	return fmt.Sprintf("%s: %s", ErrStructValidation, strings.Join(msgs, "; "))
+}
+
+// Is allows the error to be matched with ErrStructValidation
+func (e *EnrollmentValidationError) Is(target error) bool {
+	return target == ErrStructValidation
+}
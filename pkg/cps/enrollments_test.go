@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -1229,6 +1230,114 @@ func TestCreateEnrollment(t *testing.T) {
 	}
 }
 
+func TestCreateEnrollment_FieldValidation(t *testing.T) {
+	validRequest := func() CreateEnrollmentRequest {
+		return CreateEnrollmentRequest{
+			Enrollment: Enrollment{
+				AdminContact:    &Contact{Email: "r1d1@akamai.com"},
+				CertificateType: "san",
+				CSR: &CSR{
+					CN:   "www.example.com",
+					SANS: []string{"www.example.com", "*.example.com"},
+				},
+				NetworkConfiguration: &NetworkConfiguration{},
+				Org:                  &Org{Name: "Akamai"},
+				RA:                   "lets-encrypt",
+				SignatureAlgorithm:   SignatureAlgorithmSHA256,
+				TechContact:          &Contact{Email: "r2d2@akamai.com"},
+				ValidationType:       ValidationTypeDV,
+			},
+			ContractID: "ctr-1",
+		}
+	}
+
+	tests := map[string]struct {
+		request        func() CreateEnrollmentRequest
+		expectedFields []string
+	}{
+		"valid request": {
+			request: validRequest,
+		},
+		"bad CN": {
+			request: func() CreateEnrollmentRequest {
+				r := validRequest()
+				r.CSR.CN = "www.exa mple.com"
+				return r
+			},
+			expectedFields: []string{"enrollment.csr.cn"},
+		},
+		"too long CN": {
+			request: func() CreateEnrollmentRequest {
+				r := validRequest()
+				r.CSR.CN = strings.Repeat("a", 60) + ".example.com"
+				return r
+			},
+			expectedFields: []string{"enrollment.csr.cn"},
+		},
+		"bad SANs": {
+			request: func() CreateEnrollmentRequest {
+				r := validRequest()
+				r.CSR.SANS = []string{"www.example.com", "-bad.example.com", "*.*.example.com"}
+				return r
+			},
+			expectedFields: []string{"enrollment.csr.sans[1]", "enrollment.csr.sans[2]"},
+		},
+		"unsupported signature algorithm": {
+			request: func() CreateEnrollmentRequest {
+				r := validRequest()
+				r.SignatureAlgorithm = "MD5"
+				return r
+			},
+			expectedFields: []string{"enrollment.signatureAlgorithm"},
+		},
+		"unsupported validation type": {
+			request: func() CreateEnrollmentRequest {
+				r := validRequest()
+				r.ValidationType = "xv"
+				r.CSR.PreferredTrustChain = ""
+				return r
+			},
+			expectedFields: []string{"enrollment.validationType"},
+		},
+		"multiple invalid fields": {
+			request: func() CreateEnrollmentRequest {
+				r := validRequest()
+				r.ContractID = ""
+				r.CSR.CN = "bad_cn!"
+				r.SignatureAlgorithm = "SHA-512"
+				return r
+			},
+			expectedFields: []string{"contractId", "enrollment.csr.cn", "enrollment.signatureAlgorithm"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := test.request()
+			err := request.Validate()
+			if test.expectedFields == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Fatalf("unexpected request: %s", r.URL)
+			}))
+			client := mockAPIClient(t, mockServer)
+			_, err = client.CreateEnrollment(context.Background(), request)
+			assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+
+			var validationErr *EnrollmentValidationError
+			require.True(t, errors.As(err, &validationErr))
+			fields := make([]string, 0, len(validationErr.Fields))
+			for field := range validationErr.Fields {
+				fields = append(fields, field)
+			}
+			assert.ElementsMatch(t, test.expectedFields, fields)
+		})
+	}
+}
+
 func TestUpdateEnrollment(t *testing.T) {
 	tests := map[string]struct {
 		request          UpdateEnrollmentRequest
@@ -58,6 +58,10 @@ func (e *Error) Error() string {
 
 // Is handles error comparisons
 func (e *Error) Is(target error) bool {
+	if target == ErrNotFound {
+		return e.StatusCode == http.StatusNotFound
+	}
+
 	var t *Error
 	if !errors.As(target, &t) {
 		return false
@@ -74,6 +74,16 @@ func (m *Mock) GetChangeStatus(ctx context.Context, r GetChangeStatusRequest) (*
 	return args.Get(0).(*Change), args.Error(1)
 }
 
+func (m *Mock) WaitForChangeStatus(ctx context.Context, r WaitChangeRequest, opts WaitOptions) (*Change, error) {
+	args := m.Called(ctx, r, opts)
+
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*Change), args.Error(1)
+}
+
 func (m *Mock) CancelChange(ctx context.Context, r CancelChangeRequest) (*CancelChangeResponse, error) {
 	args := m.Called(ctx, r)
 
@@ -262,6 +262,8 @@ func (c *SplunkConnector) Validate() error {
 		"Endpoint":            validation.Validate(c.Endpoint, validation.Required),
 		"CustomHeaderName":    validation.Validate(c.CustomHeaderName, validation.Required.When(c.CustomHeaderValue != ""), validation.When(c.CustomHeaderName != "", validation.Match(customHeaderNameRegexp))),
 		"CustomHeaderValue":   validation.Validate(c.CustomHeaderValue, validation.Required.When(c.CustomHeaderName != "")),
+		"ClientCert":          validation.Validate(c.ClientCert, validation.Required.When(c.ClientKey != "").Error("required when ClientKey is provided")),
+		"ClientKey":           validation.Validate(c.ClientKey, validation.Required.When(c.ClientCert != "").Error("required when ClientCert is provided")),
 	}.Filter()
 }
 
@@ -298,6 +300,8 @@ func (c *CustomHTTPSConnector) Validate() error {
 		"Password":           validation.Validate(c.Password, validation.Required.When(c.AuthenticationType == AuthenticationTypeBasic)),
 		"CustomHeaderName":   validation.Validate(c.CustomHeaderName, validation.Required.When(c.CustomHeaderValue != ""), validation.When(c.CustomHeaderName != "", validation.Match(customHeaderNameRegexp))),
 		"CustomHeaderValue":  validation.Validate(c.CustomHeaderValue, validation.Required.When(c.CustomHeaderName != "")),
+		"ClientCert":         validation.Validate(c.ClientCert, validation.Required.When(c.ClientKey != "").Error("required when ClientKey is provided")),
+		"ClientKey":          validation.Validate(c.ClientKey, validation.Required.When(c.ClientCert != "").Error("required when ClientCert is provided")),
 	}.Filter()
 }
 
@@ -387,5 +391,7 @@ func (c *ElasticsearchConnector) Validate() error {
 		"IndexName":         validation.Validate(c.IndexName, validation.Required),
 		"CustomHeaderName":  validation.Validate(c.CustomHeaderName, validation.Required.When(c.CustomHeaderValue != ""), validation.When(c.CustomHeaderName != "", validation.Match(customHeaderNameRegexp))),
 		"CustomHeaderValue": validation.Validate(c.CustomHeaderValue, validation.Required.When(c.CustomHeaderName != "")),
+		"ClientCert":        validation.Validate(c.ClientCert, validation.Required.When(c.ClientKey != "").Error("required when ClientKey is provided")),
+		"ClientKey":         validation.Validate(c.ClientKey, validation.Required.When(c.ClientCert != "").Error("required when ClientCert is provided")),
 	}.Filter()
 }
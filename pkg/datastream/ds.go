@@ -5,6 +5,7 @@ package datastream
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
@@ -45,6 +46,14 @@ func Client(sess session.Session, opts ...Option) DS {
 	return c
 }
 
+// WithDefaultHeaders adds the headers to every request made by the client, e.g. a static team identifier.
+// Headers set by the client methods and with session.WithContextHeaders take precedence, see session.WithDefaultHeaders.
+func WithDefaultHeaders(h http.Header) Option {
+	return func(c *ds) {
+		c.Session = session.WithDefaultHeaders(c.Session, h)
+	}
+}
+
 // DelimiterTypePtr returns the address of the DelimiterType
 func DelimiterTypePtr(d DelimiterType) *DelimiterType {
 	return &d
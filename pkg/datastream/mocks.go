@@ -74,6 +74,16 @@ func (m *Mock) ActivateStream(ctx context.Context, r ActivateStreamRequest) (*De
 	return args.Get(0).(*DetailedStreamVersion), args.Error(1)
 }
 
+func (m *Mock) WaitForStreamStatus(ctx context.Context, r WaitForStreamStatusRequest, opts WaitOptions) (*DetailedStreamVersion, error) {
+	args := m.Called(ctx, r, opts)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*DetailedStreamVersion), args.Error(1)
+}
+
 func (m *Mock) DeactivateStream(ctx context.Context, r DeactivateStreamRequest) (*DetailedStreamVersion, error) {
 	args := m.Called(ctx, r)
 
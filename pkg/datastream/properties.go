@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
@@ -19,6 +21,7 @@ type (
 		GetProperties(context.Context, GetPropertiesRequest) (*PropertiesDetails, error)
 
 		// GetDatasetFields returns groups of data set fields available in the template.
+		// See DataSets.GroupedFields and DataSets.ValidateFieldIDs for checking fields of a stream configuration.
 		//
 		// See: https://techdocs.akamai.com/datastream2/v2/reference/get-dataset-fields
 		GetDatasetFields(context.Context, GetDatasetFieldsRequest) (*DataSets, error)
@@ -63,8 +66,39 @@ var (
 	ErrGetProperties = errors.New("list properties")
 	// ErrGetDatasetFields is returned when GetDatasetFields fails
 	ErrGetDatasetFields = errors.New("list data set fields")
+	// ErrUnknownDatasetFields is returned by DataSets.ValidateFieldIDs when some of the fields are not available
+	ErrUnknownDatasetFields = errors.New("unknown data set fields")
 )
 
+// GroupedFields returns the data set fields by their group, e.g. "Log information", in the order they were returned
+func (d DataSets) GroupedFields() map[string][]DataSetField {
+	groups := make(map[string][]DataSetField)
+	for _, field := range d.DataSetFields {
+		groups[field.DatasetFieldGroup] = append(groups[field.DatasetFieldGroup], field)
+	}
+	return groups
+}
+
+// ValidateFieldIDs checks that all fields, e.g. of StreamConfiguration.DatasetFields, are among the data set fields.
+// The returned error matches ErrUnknownDatasetFields and lists IDs of the unknown fields.
+func (d DataSets) ValidateFieldIDs(fields []DatasetFieldID) error {
+	known := make(map[int]struct{}, len(d.DataSetFields))
+	for _, field := range d.DataSetFields {
+		known[field.DatasetFieldID] = struct{}{}
+	}
+
+	var unknown []string
+	for _, field := range fields {
+		if _, ok := known[field.DatasetFieldID]; !ok {
+			unknown = append(unknown, strconv.Itoa(field.DatasetFieldID))
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("%w: %s", ErrUnknownDatasetFields, strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
 func (d *ds) GetProperties(ctx context.Context, params GetPropertiesRequest) (*PropertiesDetails, error) {
 	logger := d.Log(ctx)
 	logger.Debug("GetProperties")
@@ -302,3 +302,55 @@ func TestDs_GetDatasetFields(t *testing.T) {
 		})
 	}
 }
+
+func TestDataSets_GroupedFields(t *testing.T) {
+	dataSets := DataSets{DataSetFields: []DataSetField{
+		{DatasetFieldID: 1000, DatasetFieldName: "CP code", DatasetFieldGroup: "Log information"},
+		{DatasetFieldID: 2000, DatasetFieldName: "Request host", DatasetFieldGroup: "Message exchange data"},
+		{DatasetFieldID: 1002, DatasetFieldName: "Request ID", DatasetFieldGroup: "Log information"},
+	}}
+
+	assert.Equal(t, map[string][]DataSetField{
+		"Log information": {
+			{DatasetFieldID: 1000, DatasetFieldName: "CP code", DatasetFieldGroup: "Log information"},
+			{DatasetFieldID: 1002, DatasetFieldName: "Request ID", DatasetFieldGroup: "Log information"},
+		},
+		"Message exchange data": {
+			{DatasetFieldID: 2000, DatasetFieldName: "Request host", DatasetFieldGroup: "Message exchange data"},
+		},
+	}, dataSets.GroupedFields())
+}
+
+func TestDataSets_ValidateFieldIDs(t *testing.T) {
+	dataSets := DataSets{DataSetFields: []DataSetField{
+		{DatasetFieldID: 1000, DatasetFieldGroup: "Log information"},
+		{DatasetFieldID: 1002, DatasetFieldGroup: "Log information"},
+		{DatasetFieldID: 2000, DatasetFieldGroup: "Message exchange data"},
+	}}
+
+	tests := map[string]struct {
+		fields        []DatasetFieldID
+		expectedError string
+	}{
+		"all fields known": {
+			fields: []DatasetFieldID{{DatasetFieldID: 1000}, {DatasetFieldID: 2000}},
+		},
+		"no fields": {},
+		"unknown fields": {
+			fields:        []DatasetFieldID{{DatasetFieldID: 1000}, {DatasetFieldID: 1001}, {DatasetFieldID: 9999}},
+			expectedError: "unknown data set fields: 1001, 9999",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := dataSets.ValidateFieldIDs(test.fields)
+			if test.expectedError != "" {
+				assert.True(t, errors.Is(err, ErrUnknownDatasetFields), "want: %s; got: %s", ErrUnknownDatasetFields, err)
+				assert.EqualError(t, err, test.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -27,6 +27,14 @@ type (
 		//
 		// See: https://techdocs.akamai.com/datastream2/v2/reference/get-stream-activation-history
 		GetActivationHistory(context.Context, GetActivationHistoryRequest) ([]ActivationHistoryEntry, error)
+
+		// WaitForStreamStatus gets the stream every WaitOptions.Interval until it has the requested status,
+		// e.g. StreamStatusActivated after ActivateStream. Errors with status 429 or 5xx are treated as transient
+		// and polling continues. It returns *UnexpectedStreamStatusError if the stream moves towards the opposite
+		// status, and stops with an error when WaitOptions.Timeout elapses or the context is done.
+		//
+		// See: https://techdocs.akamai.com/datastream2/v2/reference/get-stream
+		WaitForStreamStatus(context.Context, WaitForStreamStatusRequest, WaitOptions) (*DetailedStreamVersion, error)
 	}
 
 	// ActivationHistoryEntry contains single ActivationHistory item
@@ -0,0 +1,142 @@
+package datastream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type (
+	// WaitForStreamStatusRequest is passed to WaitForStreamStatus
+	WaitForStreamStatusRequest struct {
+		StreamID int64
+		// Status is the status to wait for, either StreamStatusActivated or StreamStatusDeactivated
+		Status StreamStatus
+	}
+
+	// WaitOptions configures polling of WaitForStreamStatus
+	WaitOptions struct {
+		// Interval is the time between consecutive status checks. DefaultWaitInterval is used if it is not set.
+		Interval time.Duration
+		// Timeout limits the total time of waiting. The deadline of the context applies if it is not set.
+		Timeout time.Duration
+	}
+
+	// UnexpectedStreamStatusError is returned by WaitForStreamStatus when the stream moves away from the expected
+	// status, e.g. it is deactivated while its activation is waited for
+	UnexpectedStreamStatusError struct {
+		StreamID int64
+		Expected StreamStatus
+		Actual   StreamStatus
+	}
+)
+
+// DefaultWaitInterval is the interval used by WaitForStreamStatus if none is given
+const DefaultWaitInterval = 30 * time.Second
+
+var (
+	// ErrUnexpectedStreamStatus is returned when the stream moves away from the status which is waited for
+	ErrUnexpectedStreamStatus = errors.New("unexpected stream status")
+	// ErrWaitForStreamStatus is returned when WaitForStreamStatus fails
+	ErrWaitForStreamStatus = errors.New("wait for stream status")
+
+	// oppositeStreamStatuses are the statuses which mean the stream will not reach the status it is mapped from
+	oppositeStreamStatuses = map[StreamStatus][]StreamStatus{
+		StreamStatusActivated:   {StreamStatusDeactivating, StreamStatusDeactivated},
+		StreamStatusDeactivated: {StreamStatusActivating, StreamStatusActivated},
+	}
+)
+
+// Validate validates WaitForStreamStatusRequest
+func (r WaitForStreamStatusRequest) Validate() error {
+	return validation.Errors{
+		"StreamID": validation.Validate(r.StreamID, validation.Required),
+		"Status":   validation.Validate(r.Status, validation.Required, validation.In(StreamStatusActivated, StreamStatusDeactivated)),
+	}.Filter()
+}
+
+// Validate validates WaitOptions
+func (o WaitOptions) Validate() error {
+	return validation.Errors{
+		"Interval": validation.Validate(o.Interval, validation.Min(time.Duration(0))),
+		"Timeout":  validation.Validate(o.Timeout, validation.Min(time.Duration(0))),
+	}.Filter()
+}
+
+// Error returns the error message
+func (e *UnexpectedStreamStatusError) Error() string {
+	return fmt.Sprintf("stream %d has status %s while waiting for status %s", e.StreamID, e.Actual, e.Expected)
+}
+
+// Is allows the error to be matched with ErrUnexpectedStreamStatus
+func (e *UnexpectedStreamStatusError) Is(target error) bool {
+	return target == ErrUnexpectedStreamStatus
+}
+
+func (d *ds) WaitForStreamStatus(ctx context.Context, params WaitForStreamStatusRequest, opts WaitOptions) (*DetailedStreamVersion, error) {
+	logger := d.Log(ctx)
+	logger.Debug("WaitForStreamStatus")
+
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrWaitForStreamStatus, ErrStructValidation, err)
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrWaitForStreamStatus, ErrStructValidation, err)
+	}
+
+	interval := opts.Interval
+	if interval == 0 {
+		interval = DefaultWaitInterval
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	for {
+		stream, err := d.GetStream(ctx, GetStreamRequest{StreamID: params.StreamID})
+		switch {
+		case err == nil:
+			logger.Debugf("stream %d has status %s", params.StreamID, stream.StreamStatus)
+			if stream.StreamStatus == params.Status {
+				return stream, nil
+			}
+			for _, status := range oppositeStreamStatuses[params.Status] {
+				if stream.StreamStatus == status {
+					return nil, fmt.Errorf("%s: %w", ErrWaitForStreamStatus, &UnexpectedStreamStatusError{
+						StreamID: params.StreamID,
+						Expected: params.Status,
+						Actual:   stream.StreamStatus,
+					})
+				}
+			}
+		case ctx.Err() != nil:
+			// GetStream does not wrap the error of the request, so the expired context is returned instead
+			return nil, fmt.Errorf("%s: %w", ErrWaitForStreamStatus, ctx.Err())
+		case isTransientError(err):
+			logger.Debugf("retrying after transient error: %s", err)
+		default:
+			return nil, fmt.Errorf("%s: %w", ErrWaitForStreamStatus, err)
+		}
+
+		if err := session.Wait(ctx, interval); err != nil {
+			return nil, fmt.Errorf("%s: %w", ErrWaitForStreamStatus, err)
+		}
+	}
+}
+
+// isTransientError reports whether the request failed with an error which may not occur when it is retried,
+// i.e. 429 Too Many Requests or a 5xx status
+func isTransientError(err error) bool {
+	var e *Error
+	if !errors.As(err, &e) {
+		return false
+	}
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,147 @@
+package datastream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDs_WaitForStreamStatus(t *testing.T) {
+	type response struct {
+		status int
+		body   string
+	}
+	stream := func(status StreamStatus) response {
+		return response{http.StatusOK, fmt.Sprintf(`{"streamId": 12321, "streamName": "test_stream", "streamVersion": 2, "streamStatus": "%s"}`, status)}
+	}
+	serverError := response{http.StatusInternalServerError, `{"type": "internal_error", "title": "Internal Server Error", "detail": "Error processing request", "statusCode": 500}`}
+	// hang is not answered until the request is cancelled
+	hang := response{}
+	notFound := response{http.StatusNotFound, `{"type": "not-found", "title": "Not Found", "detail": "Stream does not exist", "statusCode": 404}`}
+
+	tests := map[string]struct {
+		params           WaitForStreamStatusRequest
+		opts             WaitOptions
+		responses        []response
+		expectedRequests int
+		expectedStatus   StreamStatus
+		withError        []error
+	}{
+		"stream activated": {
+			params:           WaitForStreamStatusRequest{StreamID: 12321, Status: StreamStatusActivated},
+			responses:        []response{stream(StreamStatusActivating), stream(StreamStatusActivating), stream(StreamStatusActivated)},
+			expectedRequests: 3,
+			expectedStatus:   StreamStatusActivated,
+		},
+		"stream deactivated": {
+			params:           WaitForStreamStatusRequest{StreamID: 12321, Status: StreamStatusDeactivated},
+			responses:        []response{stream(StreamStatusDeactivating), stream(StreamStatusDeactivated)},
+			expectedRequests: 2,
+			expectedStatus:   StreamStatusDeactivated,
+		},
+		"transient errors are retried": {
+			params:           WaitForStreamStatusRequest{StreamID: 12321, Status: StreamStatusActivated},
+			responses:        []response{stream(StreamStatusActivating), serverError, stream(StreamStatusActivated)},
+			expectedRequests: 3,
+			expectedStatus:   StreamStatusActivated,
+		},
+		"stream deactivated while activation is expected": {
+			params:           WaitForStreamStatusRequest{StreamID: 12321, Status: StreamStatusActivated},
+			responses:        []response{stream(StreamStatusActivating), stream(StreamStatusDeactivated)},
+			expectedRequests: 2,
+			withError:        []error{ErrUnexpectedStreamStatus},
+		},
+		"stream activating while deactivation is expected": {
+			params:           WaitForStreamStatusRequest{StreamID: 12321, Status: StreamStatusDeactivated},
+			responses:        []response{stream(StreamStatusActivating)},
+			expectedRequests: 1,
+			withError:        []error{ErrUnexpectedStreamStatus},
+		},
+		"404 is not retried": {
+			params:           WaitForStreamStatusRequest{StreamID: 12321, Status: StreamStatusActivated},
+			responses:        []response{notFound},
+			expectedRequests: 1,
+			withError:        []error{&Error{Type: "not-found", Title: "Not Found", Detail: "Stream does not exist", StatusCode: http.StatusNotFound}},
+		},
+		"timeout": {
+			params:    WaitForStreamStatusRequest{StreamID: 12321, Status: StreamStatusActivated},
+			opts:      WaitOptions{Timeout: 50 * time.Millisecond},
+			responses: []response{stream(StreamStatusActivating)},
+			withError: []error{context.DeadlineExceeded},
+		},
+		"timeout during request": {
+			params:    WaitForStreamStatusRequest{StreamID: 12321, Status: StreamStatusActivated},
+			opts:      WaitOptions{Timeout: 50 * time.Millisecond},
+			responses: []response{stream(StreamStatusActivating), hang},
+			withError: []error{context.DeadlineExceeded},
+		},
+		"invalid status": {
+			params:    WaitForStreamStatusRequest{StreamID: 12321, Status: StreamStatusActivating},
+			withError: []error{ErrStructValidation},
+		},
+		"missing stream ID": {
+			params:    WaitForStreamStatusRequest{Status: StreamStatusActivated},
+			withError: []error{ErrStructValidation},
+		},
+		"negative timeout": {
+			params:    WaitForStreamStatusRequest{StreamID: 12321, Status: StreamStatusActivated},
+			opts:      WaitOptions{Timeout: -time.Second},
+			withError: []error{ErrStructValidation},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests int
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/datastream-config-api/v2/log/streams/12321", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				resp := test.responses[len(test.responses)-1]
+				if requests < len(test.responses) {
+					resp = test.responses[requests]
+				}
+				requests++
+				if resp.status == 0 {
+					<-r.Context().Done()
+					return
+				}
+				w.WriteHeader(resp.status)
+				_, err := w.Write([]byte(resp.body))
+				assert.NoError(t, err)
+			}))
+			defer mockServer.Close()
+			client := mockAPIClient(t, mockServer)
+
+			opts := test.opts
+			if opts.Interval == 0 {
+				opts.Interval = time.Millisecond
+			}
+			result, err := client.WaitForStreamStatus(context.Background(), test.params, opts)
+			if test.withError != nil {
+				for _, want := range test.withError {
+					assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+				}
+				if test.expectedRequests > 0 {
+					assert.Equal(t, test.expectedRequests, requests)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedRequests, requests)
+			assert.Equal(t, test.expectedStatus, result.StreamStatus)
+		})
+	}
+}
+
+func TestUnexpectedStreamStatusError(t *testing.T) {
+	err := &UnexpectedStreamStatusError{StreamID: 12321, Expected: StreamStatusActivated, Actual: StreamStatusDeactivated}
+	assert.True(t, errors.Is(err, ErrUnexpectedStreamStatus))
+	assert.Equal(t, "stream 12321 has status DEACTIVATED while waiting for status ACTIVATED", err.Error())
+}
@@ -0,0 +1,209 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type (
+	// ChangeLists contains operations available on change lists, which batch edits of zone recordsets,
+	// so that they can be reviewed and then applied together.
+	ChangeLists interface {
+		// CreateChangeList creates a change list based on the most recent version of the zone.
+		// If overwrite is true, a pending change list of the zone is replaced, otherwise only a stale one is.
+		//
+		// See: https://techdocs.akamai.com/edge-dns/reference/post-changelists
+		CreateChangeList(ctx context.Context, zone string, overwrite bool) (*ChangeListResponse, error)
+		// AddRecordSetToChangeList adds, edits or deletes the recordset in the change list of the zone.
+		//
+		// See: https://techdocs.akamai.com/edge-dns/reference/post-changelists-zone-recordsets-add-change
+		AddRecordSetToChangeList(ctx context.Context, zone string, op ChangeOp, recordset Recordset) error
+		// SubmitChangeList applies the change list of the zone. Use GetChangeList to review it before.
+		//
+		// See: https://techdocs.akamai.com/edge-dns/reference/post-changelists-zone-submit
+		SubmitChangeList(ctx context.Context, zone string) error
+		// DiscardChangeList deletes the change list of the zone without applying it.
+		//
+		// See: https://techdocs.akamai.com/edge-dns/reference/delete-changelists-zone
+		DiscardChangeList(ctx context.Context, zone string) error
+	}
+
+	// ChangeOp is an operation on a recordset in a change list
+	ChangeOp string
+
+	// recordsetChange is a change of a recordset added to a change list
+	recordsetChange struct {
+		Name  string   `json:"name"`
+		Type  string   `json:"type"`
+		Op    string   `json:"op"`
+		TTL   int      `json:"ttl,omitempty"`
+		Rdata []string `json:"rdata,omitempty"`
+	}
+)
+
+var (
+	// ErrChangeListExists is returned when the zone already has a pending change list
+	ErrChangeListExists = errors.New("change list already exists")
+)
+
+const (
+	// ChangeOpAdd adds a new recordset
+	ChangeOpAdd ChangeOp = "ADD"
+	// ChangeOpEdit replaces an existing recordset
+	ChangeOpEdit ChangeOp = "EDIT"
+	// ChangeOpDelete deletes an existing recordset
+	ChangeOpDelete ChangeOp = "DELETE"
+)
+
+// validateChange validates the recordset change in the zone. Only name and type of a deleted recordset are required.
+func validateChange(zone string, op ChangeOp, rs Recordset) error {
+	var field string
+	var err error
+	switch op {
+	case ChangeOpAdd, ChangeOpEdit:
+		field, err = validateRecordset(rs)
+		if err == nil {
+			field, err = validateApexAlias(zone, rs)
+		}
+	case ChangeOpDelete:
+		field, err = validateRecordsetID(rs)
+	default:
+		return fmt.Errorf("%w: unsupported change operation %q", ErrStructValidation, op)
+	}
+	if err != nil {
+		return &RecordsetError{Name: rs.Name, Type: rs.Type, Field: field, Err: err}
+	}
+	return nil
+}
+
+func (p *dns) CreateChangeList(ctx context.Context, zone string, overwrite bool) (*ChangeListResponse, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("CreateChangeList")
+
+	if err := validateZoneName(zone); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{"zone": []string{zone}}
+	if overwrite {
+		query.Set("overwrite", "any")
+	} else {
+		query.Set("overwrite", "stale")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/config-dns/v2/changelists?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CreateChangeList request: %w", err)
+	}
+
+	var changelist ChangeListResponse
+	resp, err := p.Exec(req, &changelist)
+	if err != nil {
+		return nil, fmt.Errorf("CreateChangeList request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, p.Error(resp)
+	}
+
+	return &changelist, nil
+}
+
+func (p *dns) AddRecordSetToChangeList(ctx context.Context, zone string, op ChangeOp, recordset Recordset) error {
+
+	logger := p.Log(ctx)
+	logger.Debug("AddRecordSetToChangeList")
+
+	if err := validateZoneName(zone); err != nil {
+		return err
+	}
+	if err := validateChange(zone, op, recordset); err != nil {
+		return err
+	}
+
+	change := recordsetChange{
+		Name: recordset.Name,
+		Type: strings.ToUpper(recordset.Type),
+		Op:   string(op),
+	}
+	if op != ChangeOpDelete {
+		change.TTL = recordset.TTL
+		change.Rdata = recordset.Rdata
+	}
+
+	postURL := fmt.Sprintf("/config-dns/v2/changelists/%s/recordsets/add-change", zone)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, postURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create AddRecordSetToChangeList request: %w", err)
+	}
+
+	resp, err := p.Exec(req, nil, change)
+	if err != nil {
+		return fmt.Errorf("AddRecordSetToChangeList request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return p.Error(resp)
+	}
+
+	return nil
+}
+
+func (p *dns) SubmitChangeList(ctx context.Context, zone string) error {
+
+	logger := p.Log(ctx)
+	logger.Debug("SubmitChangeList")
+
+	if err := validateZoneName(zone); err != nil {
+		return err
+	}
+
+	postURL := fmt.Sprintf("/config-dns/v2/changelists/%s/submit", zone)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, postURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create SubmitChangeList request: %w", err)
+	}
+
+	resp, err := p.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("SubmitChangeList request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return p.Error(resp)
+	}
+
+	return nil
+}
+
+func (p *dns) DiscardChangeList(ctx context.Context, zone string) error {
+
+	logger := p.Log(ctx)
+	logger.Debug("DiscardChangeList")
+
+	if err := validateZoneName(zone); err != nil {
+		return err
+	}
+
+	deleteURL := fmt.Sprintf("/config-dns/v2/changelists/%s", zone)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, deleteURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create DiscardChangeList request: %w", err)
+	}
+
+	resp, err := p.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("DiscardChangeList request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return p.Error(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,243 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDns_ChangeListCycle(t *testing.T) {
+	var calls []string
+	var changes []recordsetChange
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.String())
+		switch r.URL.Path {
+		case "/config-dns/v2/changelists":
+			w.WriteHeader(http.StatusCreated)
+			_, err := w.Write([]byte(`
+{
+	"zone": "example.com",
+	"changeTag": "476754f4-d605-479f-853b-db854d7254fa",
+	"zoneVersionId": "1d9c887c-49bb-4382-87a6-d1bf690aa58f",
+	"lastModifiedDate": "2023-09-21T14:38:05Z",
+	"stale": false
+}`))
+			assert.NoError(t, err)
+		case "/config-dns/v2/changelists/example.com/recordsets/add-change":
+			var change recordsetChange
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&change))
+			changes = append(changes, change)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	client := mockAPIClient(t, mockServer)
+	ctx := context.Background()
+
+	changelist, err := client.CreateChangeList(ctx, "example.com", false)
+	require.NoError(t, err)
+	assert.Equal(t, &ChangeListResponse{
+		Zone:             "example.com",
+		ChangeTag:        "476754f4-d605-479f-853b-db854d7254fa",
+		ZoneVersionID:    "1d9c887c-49bb-4382-87a6-d1bf690aa58f",
+		LastModifiedDate: "2023-09-21T14:38:05Z",
+	}, changelist)
+
+	require.NoError(t, client.AddRecordSetToChangeList(ctx, "example.com", ChangeOpAdd, Recordset{Name: "www.example.com", Type: "a", TTL: 300, Rdata: []string{"10.0.0.2"}}))
+	require.NoError(t, client.AddRecordSetToChangeList(ctx, "example.com", ChangeOpEdit, Recordset{Name: "example.com", Type: "MX", TTL: 600, Rdata: []string{"10 mail.example.com."}}))
+	require.NoError(t, client.AddRecordSetToChangeList(ctx, "example.com", ChangeOpDelete, Recordset{Name: "old.example.com", Type: "CNAME"}))
+	require.NoError(t, client.SubmitChangeList(ctx, "example.com"))
+
+	assert.Equal(t, []string{
+		"POST /config-dns/v2/changelists?overwrite=stale&zone=example.com",
+		"POST /config-dns/v2/changelists/example.com/recordsets/add-change",
+		"POST /config-dns/v2/changelists/example.com/recordsets/add-change",
+		"POST /config-dns/v2/changelists/example.com/recordsets/add-change",
+		"POST /config-dns/v2/changelists/example.com/submit",
+	}, calls)
+	assert.Equal(t, []recordsetChange{
+		{Name: "www.example.com", Type: "A", Op: "ADD", TTL: 300, Rdata: []string{"10.0.0.2"}},
+		{Name: "example.com", Type: "MX", Op: "EDIT", TTL: 600, Rdata: []string{"10 mail.example.com."}},
+		{Name: "old.example.com", Type: "CNAME", Op: "DELETE"},
+	}, changes)
+}
+
+func TestDns_CreateChangeList(t *testing.T) {
+	tests := map[string]struct {
+		zone           string
+		overwrite      bool
+		responseStatus int
+		responseBody   string
+		expectedPath   string
+		withError      func(*testing.T, error)
+	}{
+		"201 Created with overwrite": {
+			zone:           "example.com",
+			overwrite:      true,
+			responseStatus: http.StatusCreated,
+			responseBody:   `{"zone": "example.com", "changeTag": "476754f4-d605-479f-853b-db854d7254fa", "stale": false}`,
+			expectedPath:   "/config-dns/v2/changelists?overwrite=any&zone=example.com",
+		},
+		"invalid zone": {
+			zone: "example..com",
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+			},
+		},
+		"409 conflict": {
+			zone:           "example.com",
+			responseStatus: http.StatusConflict,
+			responseBody: `
+{
+	"type": "https://problems.luna.akamaiapis.net/config-dns/v2/conflict",
+	"title": "Conflict",
+	"detail": "A change list already exists for zone example.com",
+	"status": 409
+}`,
+			expectedPath: "/config-dns/v2/changelists?overwrite=stale&zone=example.com",
+			withError: func(t *testing.T, err error) {
+				want := &Error{
+					Type:       "https://problems.luna.akamaiapis.net/config-dns/v2/conflict",
+					Title:      "Conflict",
+					Detail:     "A change list already exists for zone example.com",
+					StatusCode: http.StatusConflict,
+				}
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodPost, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.CreateChangeList(context.Background(), test.zone, test.overwrite)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "example.com", result.Zone)
+		})
+	}
+}
+
+func TestDns_AddRecordSetToChangeListValidation(t *testing.T) {
+	tests := map[string]struct {
+		zone          string
+		op            ChangeOp
+		recordset     Recordset
+		expectedField string
+	}{
+		"invalid zone": {
+			zone:      "-example.com",
+			op:        ChangeOpAdd,
+			recordset: Recordset{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2"}},
+		},
+		"unsupported operation": {
+			zone:      "example.com",
+			op:        "REPLACE",
+			recordset: Recordset{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2"}},
+		},
+		"invalid rdata": {
+			zone:          "example.com",
+			op:            ChangeOpEdit,
+			recordset:     Recordset{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.256"}},
+			expectedField: "Rdata[0]",
+		},
+		"TTL out of range": {
+			zone:          "example.com",
+			op:            ChangeOpAdd,
+			recordset:     Recordset{Name: "www.example.com", Type: "A", TTL: 5, Rdata: []string{"10.0.0.2"}},
+			expectedField: "TTL",
+		},
+		"AKAMAICDN not at apex": {
+			zone:          "example.com",
+			op:            ChangeOpAdd,
+			recordset:     Recordset{Name: "www.example.com", Type: "AKAMAICDN", TTL: 300, Rdata: []string{"www.example.com.edgekey.net"}},
+			expectedField: "Name",
+		},
+		"delete without type": {
+			zone:          "example.com",
+			op:            ChangeOpDelete,
+			recordset:     Recordset{Name: "www.example.com"},
+			expectedField: "Type",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Fatal("request with invalid change should not be sent")
+			}))
+			client := mockAPIClient(t, mockServer)
+			err := client.AddRecordSetToChangeList(context.Background(), test.zone, test.op, test.recordset)
+			assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+			if test.expectedField != "" {
+				var recordErr *RecordsetError
+				require.True(t, errors.As(err, &recordErr))
+				assert.Equal(t, test.expectedField, recordErr.Field)
+			}
+		})
+	}
+}
+
+func TestDns_DiscardChangeList(t *testing.T) {
+	tests := map[string]struct {
+		responseStatus int
+		responseBody   string
+		withError      error
+	}{
+		"204 No Content": {
+			responseStatus: http.StatusNoContent,
+		},
+		"404 not found": {
+			responseStatus: http.StatusNotFound,
+			responseBody: `
+{
+	"type": "https://problems.luna.akamaiapis.net/config-dns/v2/not-found",
+	"title": "Not Found",
+	"detail": "Change list for zone example.com not found",
+	"status": 404
+}`,
+			withError: &Error{
+				Type:       "https://problems.luna.akamaiapis.net/config-dns/v2/not-found",
+				Title:      "Not Found",
+				Detail:     "Change list for zone example.com not found",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-dns/v2/changelists/example.com", r.URL.String())
+				assert.Equal(t, http.MethodDelete, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			err := client.DiscardChangeList(context.Background(), "example.com")
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
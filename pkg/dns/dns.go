@@ -5,7 +5,12 @@ package dns
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
@@ -15,6 +20,9 @@ var (
 	ErrStructValidation = errors.New("struct validation")
 )
 
+// MaxRetryWait caps the wait between retries set with WithRetry, except for a longer Retry-After of the response
+const MaxRetryWait = 30 * time.Second
+
 type (
 	// DNS is the dns api interface
 	DNS interface {
@@ -23,10 +31,13 @@ type (
 		Authorities
 		Records
 		RecordSets
+		ChangeLists
 	}
 
 	dns struct {
 		session.Session
+		maxRetries int
+		retryWait  time.Duration
 	}
 
 	// Option defines a DNS option
@@ -48,8 +59,99 @@ func Client(sess session.Session, opts ...Option) DNS {
 	return p
 }
 
+// WithDefaultHeaders adds the headers to every request made by the client, e.g. a static team identifier.
+// Headers set by the client methods and with session.WithContextHeaders take precedence, see session.WithDefaultHeaders.
+func WithDefaultHeaders(h http.Header) Option {
+	return func(c *dns) {
+		c.Session = session.WithDefaultHeaders(c.Session, h)
+	}
+}
+
+// WithRetry retries requests which failed with a transient error, i.e. 429 Too Many Requests or a 5xx status,
+// at most maxRetries times. Like session.WithRetry, only requests with idempotent methods and POST requests with
+// Idempotency-Key header, see session.ContextWithIdempotencyKey, are retried, so that a change is not applied twice.
+// The wait before a retry starts at wait and doubles with each retry up to MaxRetryWait, but it is not shorter
+// than the Retry-After header of the response. Requests with a body which cannot be replayed are not retried.
+func WithRetry(maxRetries int, wait time.Duration) Option {
+	return func(c *dns) {
+		c.maxRetries = maxRetries
+		c.retryWait = wait
+	}
+}
+
 // Exec overrides the session.Exec to add dns options
 func (p *dns) Exec(r *http.Request, out interface{}, in ...interface{}) (*http.Response, error) {
+	if p.maxRetries <= 0 {
+		return p.Session.Exec(r, out, in...)
+	}
+
+	req := r
+	wait := p.retryWait
+	if wait > MaxRetryWait {
+		wait = MaxRetryWait
+	}
+	for attempt := 0; ; attempt++ {
+		resp, err := p.Session.Exec(req, out, in...)
+		if err != nil || attempt >= p.maxRetries || !isTransientStatus(resp.StatusCode) || !isIdempotent(r) || r.Context().Err() != nil {
+			return resp, err
+		}
+		next, err := replayRequest(r, len(in) > 0)
+		if err != nil {
+			p.Log(r.Context()).Debugf("request cannot be retried: %s", err)
+			return resp, nil
+		}
+
+		delay := wait
+		if retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && time.Duration(retryAfter)*time.Second > delay {
+			delay = time.Duration(retryAfter) * time.Second
+		}
+		if err := session.Wait(r.Context(), delay); err != nil {
+			if ctxErr := r.Context().Err(); ctxErr != nil {
+				_ = resp.Body.Close()
+				return nil, fmt.Errorf("retry not sent: %w", ctxErr)
+			}
+			// the retry could not start before the deadline, so the last response is returned
+			return resp, nil
+		}
+		p.Log(r.Context()).Debugf("retrying request after status %d, attempt %d of %d", resp.StatusCode, attempt+1, p.maxRetries)
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		_ = resp.Body.Close()
 
-	return p.Session.Exec(r, out, in...)
+		req = next
+		if wait *= 2; wait > MaxRetryWait {
+			wait = MaxRetryWait
+		}
+	}
+}
+
+// isIdempotent reports whether the request can be sent again without the risk of applying a change twice
+func isIdempotent(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return r.Header.Get(session.IdempotencyKeyHeader) != ""
+}
+
+// isTransientStatus reports whether the request failed with the status may succeed when retried
+func isTransientStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// replayRequest returns a copy of the request which can be sent again. The body is marshaled by Exec when hasIn is set,
+// otherwise it is recreated with GetBody.
+func replayRequest(r *http.Request, hasIn bool) (*http.Request, error) {
+	next := r.Clone(r.Context())
+	if hasIn || r.Body == nil || r.Body == http.NoBody {
+		return next, nil
+	}
+	if r.GetBody == nil {
+		return nil, errors.New("request body cannot be replayed")
+	}
+	body, err := r.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	next.Body = body
+	return next, nil
 }
@@ -1,12 +1,16 @@
 package dns
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/edgegrid"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
@@ -14,7 +18,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func mockAPIClient(t *testing.T, mockServer *httptest.Server) DNS {
+func mockAPIClient(t *testing.T, mockServer *httptest.Server, opts ...Option) DNS {
 	serverURL, err := url.Parse(mockServer.URL)
 	require.NoError(t, err)
 	certPool := x509.NewCertPool()
@@ -28,7 +32,7 @@ func mockAPIClient(t *testing.T, mockServer *httptest.Server) DNS {
 	}
 	s, err := session.New(session.WithClient(httpClient), session.WithSigner(&edgegrid.Config{Host: serverURL.Host}))
 	assert.NoError(t, err)
-	return Client(s)
+	return Client(s, opts...)
 }
 
 func dummyOpt() Option {
@@ -64,3 +68,136 @@ func TestClient(t *testing.T) {
 		})
 	}
 }
+
+func TestWithRetry(t *testing.T) {
+	notFound := `{"type": "https://problems.luna.akamaiapis.net/config-dns/v2/not-found", "title": "Not Found", "detail": "Zone example.com not found", "status": 404}`
+	unavailable := `{"type": "https://problems.luna.akamaiapis.net/config-dns/v2/unavailable", "title": "Service Unavailable", "detail": "Try again later", "status": 503}`
+	tooMany := `{"type": "https://problems.luna.akamaiapis.net/config-dns/v2/too-many-requests", "title": "Too Many Requests", "detail": "Rate limit exceeded", "status": 429}`
+	zone := `{"zone": "example.com", "type": "PRIMARY", "activationState": "ACTIVE"}`
+
+	type response struct {
+		status int
+		body   string
+	}
+	tests := map[string]struct {
+		maxRetries    int
+		responses     []response
+		expectedCalls int
+		withError     func(*testing.T, error)
+	}{
+		"retry then success": {
+			maxRetries:    3,
+			responses:     []response{{http.StatusServiceUnavailable, unavailable}, {http.StatusTooManyRequests, tooMany}, {http.StatusOK, zone}},
+			expectedCalls: 3,
+		},
+		"retries exhausted": {
+			maxRetries:    2,
+			responses:     []response{{http.StatusTooManyRequests, tooMany}, {http.StatusTooManyRequests, tooMany}, {http.StatusTooManyRequests, tooMany}},
+			expectedCalls: 3,
+			withError: func(t *testing.T, err error) {
+				var e *Error
+				require.True(t, errors.As(err, &e))
+				assert.Equal(t, http.StatusTooManyRequests, e.StatusCode)
+				assert.Equal(t, "Rate limit exceeded", e.Detail)
+			},
+		},
+		"404 is not retried": {
+			maxRetries:    3,
+			responses:     []response{{http.StatusNotFound, notFound}},
+			expectedCalls: 1,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrNotFound), "want: %s; got: %s", ErrNotFound, err)
+			},
+		},
+		"retry disabled": {
+			responses:     []response{{http.StatusServiceUnavailable, unavailable}},
+			expectedCalls: 1,
+			withError: func(t *testing.T, err error) {
+				var e *Error
+				require.True(t, errors.As(err, &e))
+				assert.Equal(t, http.StatusServiceUnavailable, e.StatusCode)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls int
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-dns/v2/zones/example.com", r.URL.String())
+				resp := test.responses[calls]
+				calls++
+				w.WriteHeader(resp.status)
+				_, err := w.Write([]byte(resp.body))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer, WithRetry(test.maxRetries, time.Millisecond))
+			result, err := client.GetZone(context.Background(), "example.com")
+			assert.Equal(t, test.expectedCalls, calls)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "ACTIVE", result.ActivationState)
+		})
+	}
+}
+
+func TestWithRetryReplaysBody(t *testing.T) {
+	tests := map[string]struct {
+		send          func(context.Context, DNS) error
+		ctx           context.Context
+		expectedCalls int
+	}{
+		"PUT is retried": {
+			send: func(ctx context.Context, client DNS) error {
+				return client.UpdateTsigKey(ctx, &TSIGKey{Name: "example.com.akamai.com.", Algorithm: "hmac-sha256", Secret: "bWQ1c2VjcmV0"}, "example.com")
+			},
+			ctx:           context.Background(),
+			expectedCalls: 2,
+		},
+		"POST with idempotency key is retried": {
+			send: func(ctx context.Context, client DNS) error {
+				return client.AddRecordSetToChangeList(ctx, "example.com", ChangeOpAdd, Recordset{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2"}})
+			},
+			ctx:           session.ContextWithIdempotencyKey(context.Background(), "b7d5e3c1"),
+			expectedCalls: 2,
+		},
+		"POST without idempotency key is not retried": {
+			send: func(ctx context.Context, client DNS) error {
+				return client.AddRecordSetToChangeList(ctx, "example.com", ChangeOpAdd, Recordset{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2"}})
+			},
+			ctx:           context.Background(),
+			expectedCalls: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var bodies []string
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				bodies = append(bodies, string(body))
+				if len(bodies) == 1 {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer mockServer.Close()
+			client := mockAPIClient(t, mockServer, WithRetry(1, time.Millisecond))
+
+			err := test.send(test.ctx, client)
+			require.Len(t, bodies, test.expectedCalls)
+			assert.NotEmpty(t, bodies[0])
+			if test.expectedCalls == 1 {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, bodies[0], bodies[1])
+		})
+	}
+}
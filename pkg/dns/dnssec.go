@@ -0,0 +1,211 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type (
+	// DNSSECStatus contains the DNSSEC signing state of a zone and its key records
+	DNSSECStatus struct {
+		Zone string `json:"zone"`
+		// Signed reports whether the zone is signed, i.e. it has DNSSEC records
+		Signed bool `json:"-"`
+		// Alerts are the issues with the signing of the zone, e.g. about an upcoming key rotation
+		Alerts []string `json:"alerts,omitempty"`
+		// CurrentRecords are the records of the key currently used to sign the zone
+		CurrentRecords *DNSSECRecords `json:"currentRecords,omitempty"`
+		// NewRecords are the records of the next key, set while the key is being rotated
+		NewRecords *DNSSECRecords `json:"newRecords,omitempty"`
+	}
+
+	// DNSSECRecords contains the DNSKEY and DS records of a zone signing key
+	DNSSECRecords struct {
+		DNSKeyRecord     string `json:"dnskeyRecord"`
+		DSRecord         string `json:"dsRecord"`
+		ExpectedTTL      int64  `json:"expectedTtl"`
+		LastModifiedDate string `json:"lastModifiedDate"`
+	}
+
+	// DSRecord is the data of a DS record, which is published at the registrar of the parent zone
+	DSRecord struct {
+		KeyTag     int
+		Algorithm  int
+		DigestType int
+		Digest     string
+	}
+
+	// RotateKeyRequest contains request parameters of RotateDNSSECKey
+	RotateKeyRequest struct {
+		Zone string `json:"-"`
+		// KeyType is the type of the key to rotate, either DNSSECKeyTypeKSK or DNSSECKeyTypeZSK
+		KeyType string `json:"keyType"`
+		Comment string `json:"comment,omitempty"`
+	}
+
+	// dnssecStatusRequest is the request body of DNSSEC status endpoint
+	dnssecStatusRequest struct {
+		Zones []string `json:"zones"`
+	}
+
+	// dnssecStatusResponse is the response of DNSSEC status endpoint
+	dnssecStatusResponse struct {
+		DNSSecStatuses []DNSSECStatus `json:"dnsSecStatuses"`
+	}
+)
+
+const (
+	// DNSSECKeyTypeKSK is the key signing key, which is referenced by the DS record at the registrar
+	DNSSECKeyTypeKSK = "KSK"
+	// DNSSECKeyTypeZSK is the zone signing key, which signs the records of the zone
+	DNSSECKeyTypeZSK = "ZSK"
+)
+
+var (
+	// ErrInvalidDSRecord is returned when a DS record cannot be parsed
+	ErrInvalidDSRecord = errors.New("invalid DS record")
+)
+
+// Validate validates RotateKeyRequest
+func (r RotateKeyRequest) Validate() error {
+	if err := validateZoneName(r.Zone); err != nil {
+		return err
+	}
+	err := validation.Errors{
+		"KeyType": validation.Validate(r.KeyType, validation.Required,
+			validation.In(DNSSECKeyTypeKSK, DNSSECKeyTypeZSK).Error("must be one of KSK or ZSK")),
+	}.Filter()
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrStructValidation, err)
+	}
+	return nil
+}
+
+// DS parses the DS record, e.g. "example.com. 7200 IN DS 3622 13 2 8B6C...", and returns its data
+func (r *DNSSECRecords) DS() (*DSRecord, error) {
+	fields := strings.Fields(r.DSRecord)
+	for i, field := range fields {
+		if strings.EqualFold(field, "DS") {
+			fields = fields[i+1:]
+			break
+		}
+	}
+	if len(fields) < 4 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidDSRecord, r.DSRecord)
+	}
+
+	var ds DSRecord
+	for i, dst := range []*int{&ds.KeyTag, &ds.Algorithm, &ds.DigestType} {
+		value, err := strconv.Atoi(fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %s", ErrInvalidDSRecord, r.DSRecord, err)
+		}
+		*dst = value
+	}
+	// digest may be split into multiple fields by white space
+	ds.Digest = strings.ToUpper(strings.Join(fields[3:], ""))
+
+	return &ds, nil
+}
+
+func (p *dns) GetZoneDNSSECStatus(ctx context.Context, zone string) (*DNSSECStatus, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("GetZoneDNSSECStatus")
+
+	if err := validateZoneName(zone); err != nil {
+		return nil, err
+	}
+	zone = strings.TrimSuffix(zone, ".")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/config-dns/v2/zones/dns-sec-status", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GetZoneDNSSECStatus request: %w", err)
+	}
+
+	var rval dnssecStatusResponse
+	resp, err := p.Exec(req, &rval, dnssecStatusRequest{Zones: []string{zone}})
+	if err != nil {
+		return nil, fmt.Errorf("GetZoneDNSSECStatus request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, p.Error(resp)
+	}
+
+	status := &DNSSECStatus{Zone: zone}
+	for _, s := range rval.DNSSecStatuses {
+		if strings.EqualFold(strings.TrimSuffix(s.Zone, "."), zone) {
+			*status = s
+			break
+		}
+	}
+	status.Signed = status.CurrentRecords != nil && status.CurrentRecords.DNSKeyRecord != ""
+
+	return status, nil
+}
+
+func (p *dns) EnableDNSSEC(ctx context.Context, zone string) (*DNSSECStatus, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("EnableDNSSEC")
+
+	zoneResp, err := p.GetZone(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(zoneResp.Type, "PRIMARY") {
+		return nil, fmt.Errorf("%w: DNSSEC can be enabled only for PRIMARY zone, zone %q is %s", ErrStructValidation, zone, zoneResp.Type)
+	}
+
+	if !zoneResp.SignAndServe {
+		update := &ZoneCreate{
+			Zone:                  zoneResp.Zone,
+			Type:                  zoneResp.Type,
+			Comment:               zoneResp.Comment,
+			SignAndServe:          true,
+			SignAndServeAlgorithm: zoneResp.SignAndServeAlgorithm,
+			EndCustomerID:         zoneResp.EndCustomerID,
+			ContractID:            zoneResp.ContractID,
+		}
+		if err := p.UpdateZone(ctx, update, ZoneQueryString{}); err != nil {
+			return nil, err
+		}
+	}
+
+	return p.GetZoneDNSSECStatus(ctx, zone)
+}
+
+func (p *dns) RotateDNSSECKey(ctx context.Context, params RotateKeyRequest) (*DNSSECStatus, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("RotateDNSSECKey")
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	zone := strings.TrimSuffix(params.Zone, ".")
+
+	postURL := fmt.Sprintf("/config-dns/v2/zones/%s/key-rotation", zone)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, postURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RotateDNSSECKey request: %w", err)
+	}
+
+	resp, err := p.Exec(req, nil, params)
+	if err != nil {
+		return nil, fmt.Errorf("RotateDNSSECKey request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusNoContent {
+		return nil, p.Error(resp)
+	}
+
+	return p.GetZoneDNSSECStatus(ctx, zone)
+}
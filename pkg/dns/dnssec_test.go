@@ -0,0 +1,369 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDns_GetZoneDNSSECStatus(t *testing.T) {
+	tests := map[string]struct {
+		zone             string
+		responseStatus   int
+		responseBody     string
+		expectedZones    []string
+		expectedResponse *DNSSECStatus
+		expectedDS       *DSRecord
+		withError        error
+	}{
+		"200 OK - signed zone": {
+			zone:           "example.com",
+			responseStatus: http.StatusOK,
+			responseBody: `
+{
+	"dnsSecStatuses": [
+		{
+			"zone": "example.com",
+			"alerts": ["PARENT_DS_MISSING"],
+			"currentRecords": {
+				"dnskeyRecord": "example.com. 7200 IN DNSKEY 257 3 13 Mx2ZkUk6X0Sa3wCBcDpcT2a0B6KA3sV3nmh6j8Q3D4Q=",
+				"dsRecord": "example.com. 86400 IN DS 3622 13 2 8b6c2f4d6a7e2c1b5d0f3e9a8c7b6a5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f0a9b",
+				"expectedTtl": 86400,
+				"lastModifiedDate": "2023-09-21T14:38:05Z"
+			}
+		}
+	]
+}`,
+			expectedZones: []string{"example.com"},
+			expectedResponse: &DNSSECStatus{
+				Zone:   "example.com",
+				Signed: true,
+				Alerts: []string{"PARENT_DS_MISSING"},
+				CurrentRecords: &DNSSECRecords{
+					DNSKeyRecord:     "example.com. 7200 IN DNSKEY 257 3 13 Mx2ZkUk6X0Sa3wCBcDpcT2a0B6KA3sV3nmh6j8Q3D4Q=",
+					DSRecord:         "example.com. 86400 IN DS 3622 13 2 8b6c2f4d6a7e2c1b5d0f3e9a8c7b6a5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f0a9b",
+					ExpectedTTL:      86400,
+					LastModifiedDate: "2023-09-21T14:38:05Z",
+				},
+			},
+			expectedDS: &DSRecord{
+				KeyTag:     3622,
+				Algorithm:  13,
+				DigestType: 2,
+				Digest:     "8B6C2F4D6A7E2C1B5D0F3E9A8C7B6A5D4E3F2A1B0C9D8E7F6A5B4C3D2E1F0A9B",
+			},
+		},
+		"200 OK - unsigned zone": {
+			zone:           "unsigned.com.",
+			responseStatus: http.StatusOK,
+			responseBody:   `{"dnsSecStatuses": [{"zone": "unsigned.com", "alerts": []}]}`,
+			expectedZones:  []string{"unsigned.com"},
+			expectedResponse: &DNSSECStatus{
+				Zone:   "unsigned.com",
+				Signed: false,
+				Alerts: []string{},
+			},
+		},
+		"200 OK - zone missing in response": {
+			zone:           "unsigned.com",
+			responseStatus: http.StatusOK,
+			responseBody:   `{"dnsSecStatuses": []}`,
+			expectedZones:  []string{"unsigned.com"},
+			expectedResponse: &DNSSECStatus{
+				Zone: "unsigned.com",
+			},
+		},
+		"invalid zone name": {
+			zone:      "",
+			withError: ErrStructValidation,
+		},
+		"500 internal server error": {
+			zone:           "example.com",
+			responseStatus: http.StatusInternalServerError,
+			responseBody: `
+{
+	"type": "internal_error",
+    "title": "Internal Server Error",
+    "detail": "Error fetching DNSSEC status",
+    "status": 500
+}`,
+			expectedZones: []string{"example.com"},
+			withError: &Error{
+				Type:       "internal_error",
+				Title:      "Internal Server Error",
+				Detail:     "Error fetching DNSSEC status",
+				StatusCode: http.StatusInternalServerError,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-dns/v2/zones/dns-sec-status", r.URL.String())
+				assert.Equal(t, http.MethodPost, r.Method)
+				var body dnssecStatusRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, test.expectedZones, body.Zones)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.GetZoneDNSSECStatus(context.Background(), test.zone)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+			if test.expectedDS != nil {
+				ds, err := result.CurrentRecords.DS()
+				require.NoError(t, err)
+				assert.Equal(t, test.expectedDS, ds)
+			}
+		})
+	}
+}
+
+func TestDNSSECRecords_DS(t *testing.T) {
+	tests := map[string]struct {
+		record    string
+		expected  *DSRecord
+		withError bool
+	}{
+		"rdata only with split digest": {
+			record:   "60485 5 1 2BB183AF5F22588179A53B0A 98631FAD1A292118",
+			expected: &DSRecord{KeyTag: 60485, Algorithm: 5, DigestType: 1, Digest: "2BB183AF5F22588179A53B0A98631FAD1A292118"},
+		},
+		"missing digest": {
+			record:    "example.com. 86400 IN DS 3622 13 2",
+			withError: true,
+		},
+		"invalid key tag": {
+			record:    "example.com. 86400 IN DS tag 13 2 ABCD",
+			withError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ds, err := (&DNSSECRecords{DSRecord: test.record}).DS()
+			if test.withError {
+				assert.True(t, errors.Is(err, ErrInvalidDSRecord), "want: %s; got: %s", ErrInvalidDSRecord, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, ds)
+		})
+	}
+}
+
+func TestDns_EnableDNSSEC(t *testing.T) {
+	statusBody := `
+{
+	"dnsSecStatuses": [
+		{
+			"zone": "example.com",
+			"currentRecords": {
+				"dnskeyRecord": "example.com. 7200 IN DNSKEY 257 3 13 Mx2ZkUk6X0Sa3wCBcDpcT2a0B6KA3sV3nmh6j8Q3D4Q=",
+				"dsRecord": "example.com. 86400 IN DS 3622 13 2 8B6C2F4D",
+				"expectedTtl": 86400,
+				"lastModifiedDate": "2023-09-21T14:38:05Z"
+			}
+		}
+	]
+}`
+	expectedStatus := &DNSSECStatus{
+		Zone:   "example.com",
+		Signed: true,
+		CurrentRecords: &DNSSECRecords{
+			DNSKeyRecord:     "example.com. 7200 IN DNSKEY 257 3 13 Mx2ZkUk6X0Sa3wCBcDpcT2a0B6KA3sV3nmh6j8Q3D4Q=",
+			DSRecord:         "example.com. 86400 IN DS 3622 13 2 8B6C2F4D",
+			ExpectedTTL:      86400,
+			LastModifiedDate: "2023-09-21T14:38:05Z",
+		},
+	}
+
+	tests := map[string]struct {
+		zone             string
+		zoneStatus       int
+		zoneBody         string
+		expectedUpdate   bool
+		expectedResponse *DNSSECStatus
+		withError        error
+	}{
+		"primary zone is signed": {
+			zone:             "example.com",
+			zoneStatus:       http.StatusOK,
+			zoneBody:         `{"zone": "example.com", "type": "PRIMARY", "comment": "converted", "contractId": "1-2ABCDE"}`,
+			expectedUpdate:   true,
+			expectedResponse: expectedStatus,
+		},
+		"signed primary zone is not updated": {
+			zone:             "example.com",
+			zoneStatus:       http.StatusOK,
+			zoneBody:         `{"zone": "example.com", "type": "PRIMARY", "signAndServe": true}`,
+			expectedResponse: expectedStatus,
+		},
+		"secondary zone": {
+			zone:       "example.com",
+			zoneStatus: http.StatusOK,
+			zoneBody:   `{"zone": "example.com", "type": "SECONDARY", "masters": ["1.2.3.4"]}`,
+			withError:  ErrStructValidation,
+		},
+		"invalid zone name": {
+			zone:      "",
+			withError: ErrStructValidation,
+		},
+		"404 zone not found": {
+			zone:       "example.com",
+			zoneStatus: http.StatusNotFound,
+			zoneBody:   `{"type": "not_found", "title": "Not Found", "detail": "zone not found", "status": 404}`,
+			withError:  ErrNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var updated bool
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/config-dns/v2/zones/example.com":
+					w.WriteHeader(test.zoneStatus)
+					_, err := w.Write([]byte(test.zoneBody))
+					assert.NoError(t, err)
+				case r.Method == http.MethodPut && r.URL.Path == "/config-dns/v2/zones/example.com":
+					updated = true
+					var body map[string]interface{}
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					assert.Equal(t, true, body["signAndServe"])
+					assert.Equal(t, "PRIMARY", body["type"])
+					assert.Equal(t, "converted", body["comment"])
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(`{"zone": "example.com", "type": "PRIMARY", "signAndServe": true}`))
+					assert.NoError(t, err)
+				case r.Method == http.MethodPost && r.URL.Path == "/config-dns/v2/zones/dns-sec-status":
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(statusBody))
+					assert.NoError(t, err)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.EnableDNSSEC(context.Background(), test.zone)
+			assert.Equal(t, test.expectedUpdate, updated)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestDns_RotateDNSSECKey(t *testing.T) {
+	tests := map[string]struct {
+		params           RotateKeyRequest
+		responseStatus   int
+		responseBody     string
+		expectedBody     string
+		expectedResponse *DNSSECStatus
+		withError        error
+	}{
+		"202 accepted": {
+			params:         RotateKeyRequest{Zone: "example.com.", KeyType: DNSSECKeyTypeKSK, Comment: "yearly rotation"},
+			responseStatus: http.StatusAccepted,
+			expectedBody:   `{"keyType":"KSK","comment":"yearly rotation"}`,
+			expectedResponse: &DNSSECStatus{
+				Zone:   "example.com",
+				Signed: true,
+				CurrentRecords: &DNSSECRecords{
+					DNSKeyRecord: "example.com. 7200 IN DNSKEY 257 3 13 Mx2ZkUk6X0Sa3wCBcDpcT2a0B6KA3sV3nmh6j8Q3D4Q=",
+					DSRecord:     "example.com. 86400 IN DS 3622 13 2 8B6C2F4D",
+				},
+				NewRecords: &DNSSECRecords{
+					DNSKeyRecord: "example.com. 7200 IN DNSKEY 257 3 13 2Fq0n8TiNi3CjbwTZcXP0VzE8oR3o0Yvkj9aT0r8lFk=",
+					DSRecord:     "example.com. 86400 IN DS 4511 13 2 1A2B3C4D",
+				},
+			},
+		},
+		"invalid key type": {
+			params:    RotateKeyRequest{Zone: "example.com", KeyType: "CSK"},
+			withError: ErrStructValidation,
+		},
+		"missing zone": {
+			params:    RotateKeyRequest{KeyType: DNSSECKeyTypeZSK},
+			withError: ErrStructValidation,
+		},
+		"400 zone not signed": {
+			params:         RotateKeyRequest{Zone: "example.com", KeyType: DNSSECKeyTypeZSK},
+			responseStatus: http.StatusBadRequest,
+			responseBody:   `{"type": "bad_request", "title": "Bad Request", "detail": "zone is not signed", "status": 400}`,
+			expectedBody:   `{"keyType":"ZSK"}`,
+			withError: &Error{
+				Type:       "bad_request",
+				Title:      "Bad Request",
+				Detail:     "zone is not signed",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				switch r.URL.Path {
+				case "/config-dns/v2/zones/example.com/key-rotation":
+					body, err := ioutil.ReadAll(r.Body)
+					assert.NoError(t, err)
+					assert.JSONEq(t, test.expectedBody, string(body))
+					w.WriteHeader(test.responseStatus)
+					_, err = w.Write([]byte(test.responseBody))
+					assert.NoError(t, err)
+				case "/config-dns/v2/zones/dns-sec-status":
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(`
+{
+	"dnsSecStatuses": [
+		{
+			"zone": "example.com",
+			"currentRecords": {
+				"dnskeyRecord": "example.com. 7200 IN DNSKEY 257 3 13 Mx2ZkUk6X0Sa3wCBcDpcT2a0B6KA3sV3nmh6j8Q3D4Q=",
+				"dsRecord": "example.com. 86400 IN DS 3622 13 2 8B6C2F4D"
+			},
+			"newRecords": {
+				"dnskeyRecord": "example.com. 7200 IN DNSKEY 257 3 13 2Fq0n8TiNi3CjbwTZcXP0VzE8oR3o0Yvkj9aT0r8lFk=",
+				"dsRecord": "example.com. 86400 IN DS 4511 13 2 1A2B3C4D"
+			}
+		}
+	]
+}`))
+					assert.NoError(t, err)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.RotateDNSSECKey(context.Background(), test.params)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
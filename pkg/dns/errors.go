@@ -11,6 +11,8 @@ import (
 var (
 	// ErrBadRequest is returned when a required parameter is missing
 	ErrBadRequest = errors.New("missing argument")
+	// ErrNotFound is matched by API errors with status 404 Not Found, e.g. when a zone does not exist
+	ErrNotFound = errors.New("not found")
 )
 
 type (
@@ -58,6 +60,10 @@ func (e *Error) Error() string {
 
 // Is handles error comparisons
 func (e *Error) Is(target error) bool {
+	if target == ErrNotFound && e.StatusCode == http.StatusNotFound {
+		return true
+	}
+
 	var t *Error
 	if !errors.As(target, &t) {
 		return false
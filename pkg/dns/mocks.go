@@ -4,7 +4,9 @@ package dns
 
 import (
 	"context"
+	"io"
 	"net"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -97,6 +99,72 @@ func (d *Mock) GetMasterZoneFile(ctx context.Context, param string) (string, err
 	return args.String(0), args.Error(1)
 }
 
+func (d *Mock) WaitForZoneActivation(ctx context.Context, zone string, interval time.Duration) (*ZoneResponse, error) {
+	args := d.Called(ctx, zone, interval)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ZoneResponse), args.Error(1)
+}
+
+func (d *Mock) GetZonesStatus(ctx context.Context, zones []string, concurrency int) (map[string]ZoneStatus, error) {
+	args := d.Called(ctx, zones, concurrency)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(map[string]ZoneStatus), args.Error(1)
+}
+
+func (d *Mock) GetZoneDNSSECStatus(ctx context.Context, zone string) (*DNSSECStatus, error) {
+	args := d.Called(ctx, zone)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*DNSSECStatus), args.Error(1)
+}
+
+func (d *Mock) EnableDNSSEC(ctx context.Context, zone string) (*DNSSECStatus, error) {
+	args := d.Called(ctx, zone)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*DNSSECStatus), args.Error(1)
+}
+
+func (d *Mock) RotateDNSSECKey(ctx context.Context, params RotateKeyRequest) (*DNSSECStatus, error) {
+	args := d.Called(ctx, params)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*DNSSECStatus), args.Error(1)
+}
+
+func (d *Mock) ExportZone(ctx context.Context, zone string, w io.Writer, format Format) error {
+	args := d.Called(ctx, zone, w, format)
+
+	return args.Error(0)
+}
+
+func (d *Mock) ImportMasterFile(ctx context.Context, zone string, r io.Reader, opts ImportMasterFileOptions) (*ImportMasterFileResult, error) {
+	args := d.Called(ctx, zone, r, opts)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ImportMasterFileResult), args.Error(1)
+}
+
 func (d *Mock) CreateZone(ctx context.Context, param1 *ZoneCreate, param2 ZoneQueryString, param3 ...bool) error {
 	var args mock.Arguments
 
@@ -109,6 +177,16 @@ func (d *Mock) CreateZone(ctx context.Context, param1 *ZoneCreate, param2 ZoneQu
 	return args.Error(0)
 }
 
+func (d *Mock) CreateZones(ctx context.Context, reqs []CreateZoneRequest, concurrency int) ([]CreateZoneResult, error) {
+	args := d.Called(ctx, reqs, concurrency)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]CreateZoneResult), args.Error(1)
+}
+
 func (d *Mock) SaveChangelist(ctx context.Context, param *ZoneCreate) error {
 	args := d.Called(ctx, param)
 
@@ -121,6 +199,22 @@ func (d *Mock) SubmitChangelist(ctx context.Context, param *ZoneCreate) error {
 	return args.Error(0)
 }
 
+func (d *Mock) GetZoneMasters(ctx context.Context, zone string) ([]string, error) {
+	args := d.Called(ctx, zone)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (d *Mock) UpdateZoneMasters(ctx context.Context, zone string, masters []string) error {
+	args := d.Called(ctx, zone, masters)
+
+	return args.Error(0)
+}
+
 func (d *Mock) UpdateZone(ctx context.Context, param1 *ZoneCreate, param2 ZoneQueryString) error {
 	args := d.Called(ctx, param1, param2)
 
@@ -345,6 +439,22 @@ func (d *Mock) DeleteRecord(ctx context.Context, param *RecordBody, param2 strin
 	return args.Error(0)
 }
 
+func (d *Mock) DeleteRecordSet(ctx context.Context, zone, name, recordType string, opts DeleteRecordSetOptions) (*RecordSetSnapshot, error) {
+	args := d.Called(ctx, zone, name, recordType, opts)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*RecordSetSnapshot), args.Error(1)
+}
+
+func (d *Mock) RestoreRecordSet(ctx context.Context, snapshot *RecordSetSnapshot) error {
+	args := d.Called(ctx, snapshot)
+
+	return args.Error(0)
+}
+
 func (d *Mock) UpdateRecord(ctx context.Context, param *RecordBody, param2 string, param3 ...bool) error {
 	var args mock.Arguments
 
@@ -393,6 +503,16 @@ func (d *Mock) GetRecordsets(ctx context.Context, param string, param2 ...Record
 	return args.Get(0).(*RecordSetResponse), args.Error(1)
 }
 
+func (d *Mock) GetRecordSetsByName(ctx context.Context, zone, name string) ([]Recordset, error) {
+	args := d.Called(ctx, zone, name)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]Recordset), args.Error(1)
+}
+
 func (d *Mock) CreateRecordsets(ctx context.Context, param *Recordsets, param2 string, param3 ...bool) error {
 	var args mock.Arguments
 
@@ -417,6 +537,28 @@ func (d *Mock) UpdateRecordsets(ctx context.Context, param *Recordsets, param2 s
 	return args.Error(0)
 }
 
+func (d *Mock) SetRecordSet(ctx context.Context, zone string, recordset Recordset, opts SetRecordSetOptions) (*ZoneResponse, error) {
+	args := d.Called(ctx, zone, recordset, opts)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ZoneResponse), args.Error(1)
+}
+
+func (d *Mock) UpsertRecordsets(ctx context.Context, zone string, recordsets []Recordset, opts UpsertRecordsetsOptions) error {
+	args := d.Called(ctx, zone, recordsets, opts)
+
+	return args.Error(0)
+}
+
+func (d *Mock) BulkUpsertRecordsets(ctx context.Context, zone string, recordsets []Recordset, opts BulkUpsertRecordsetsOptions) error {
+	args := d.Called(ctx, zone, recordsets, opts)
+
+	return args.Error(0)
+}
+
 func (d *Mock) PostMasterZoneFile(ctx context.Context, param string, param2 string) error {
 	args := d.Called(ctx, param, param2)
 
@@ -480,3 +622,31 @@ func (d *Mock) GetBulkZoneDeleteResult(ctx context.Context, param string) (*Bulk
 
 	return args.Get(0).(*BulkDeleteResultResponse), args.Error(1)
 }
+
+func (d *Mock) CreateChangeList(ctx context.Context, zone string, overwrite bool) (*ChangeListResponse, error) {
+	args := d.Called(ctx, zone, overwrite)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ChangeListResponse), args.Error(1)
+}
+
+func (d *Mock) AddRecordSetToChangeList(ctx context.Context, zone string, op ChangeOp, recordset Recordset) error {
+	args := d.Called(ctx, zone, op, recordset)
+
+	return args.Error(0)
+}
+
+func (d *Mock) SubmitChangeList(ctx context.Context, zone string) error {
+	args := d.Called(ctx, zone)
+
+	return args.Error(0)
+}
+
+func (d *Mock) DiscardChangeList(ctx context.Context, zone string) error {
+	args := d.Called(ctx, zone)
+
+	return args.Error(0)
+}
@@ -37,6 +37,15 @@ type Records interface {
 	//
 	// See: https://techdocs.akamai.com/edge-dns/reference/delete-zone-name-type
 	DeleteRecord(context.Context, *RecordBody, string, ...bool) error
+	// DeleteRecordSet removes the recordset of the given name and type. With DeleteRecordSetOptions.Snapshot
+	// the recordset is retrieved before it is deleted and returned, so it can be restored with RestoreRecordSet.
+	//
+	// See: https://techdocs.akamai.com/edge-dns/reference/delete-zone-name-type
+	DeleteRecordSet(context.Context, string, string, string, DeleteRecordSetOptions) (*RecordSetSnapshot, error)
+	// RestoreRecordSet creates the recordset again from the snapshot returned by DeleteRecordSet.
+	//
+	// See: https://techdocs.akamai.com/edge-dns/reference/post-zones-zone-names-name-types-type
+	RestoreRecordSet(context.Context, *RecordSetSnapshot) error
 	// UpdateRecord replaces the recordset.
 	//
 	// See: https://techdocs.akamai.com/edge-dns/reference/put-zones-zone-names-name-types-type
@@ -76,6 +85,16 @@ func (rec *RecordBody) Validate() error {
 	if rec.Target == nil || len(rec.Target) < 1 {
 		return fmt.Errorf("Record body is missing Target")
 	}
+	if err := validateTTL(rec.TTL); err != nil {
+		return &RecordsetError{Name: rec.Name, Type: rec.RecordType, Field: "TTL", Err: err}
+	}
+	if i, err := validateRecordRdata(rec.RecordType, rec.Target); err != nil {
+		field := "Target"
+		if i >= 0 {
+			field = fmt.Sprintf("Target[%d]", i)
+		}
+		return &RecordsetError{Name: rec.Name, Type: rec.RecordType, Field: field, Err: err}
+	}
 
 	return nil
 }
@@ -141,6 +160,11 @@ func (p *dns) CreateRecord(ctx context.Context, record *RecordBody, zone string,
 		return fmt.Errorf("Record content not valid. [%w]", err)
 	}
 
+	if field, err := validateApexAlias(zone, Recordset{Name: record.Name, Type: record.RecordType}); err != nil {
+		err = &RecordsetError{Name: record.Name, Type: record.RecordType, Field: field, Err: err}
+		return fmt.Errorf("Record content not valid. [%w]", err)
+	}
+
 	reqbody, err := convertStructToReqBody(record)
 	if err != nil {
 		return fmt.Errorf("failed to generate request body: %w", err)
@@ -185,6 +209,11 @@ func (p *dns) UpdateRecord(ctx context.Context, record *RecordBody, zone string,
 		return fmt.Errorf("Record content not valid. [%w]", err)
 	}
 
+	if field, err := validateApexAlias(zone, Recordset{Name: record.Name, Type: record.RecordType}); err != nil {
+		err = &RecordsetError{Name: record.Name, Type: record.RecordType, Field: field, Err: err}
+		return fmt.Errorf("Record content not valid. [%w]", err)
+	}
+
 	reqbody, err := convertStructToReqBody(record)
 	if err != nil {
 		return fmt.Errorf("failed to generate request body: %w", err)
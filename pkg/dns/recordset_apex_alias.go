@@ -0,0 +1,69 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RecordTypeAkamaiCDN is the type of Akamai apex alias record, which points the zone apex to an Akamai edge hostname,
+// e.g. where a CNAME record cannot be used
+const RecordTypeAkamaiCDN = "AKAMAICDN"
+
+// apexAliasDomains are the domains of Akamai edge hostnames which AKAMAICDN record may point to
+var apexAliasDomains = []string{
+	"akamaized.net",
+	"akamaized-staging.net",
+	"edgekey.net",
+	"edgekey-staging.net",
+	"edgesuite.net",
+	"edgesuite-staging.net",
+}
+
+// NewApexAlias returns AKAMAICDN recordset at the apex of the zone pointing to the edge hostname
+func NewApexAlias(zone, edgeHostname string, ttl int) Recordset {
+	return Recordset{
+		Name:  strings.TrimSuffix(zone, "."),
+		Type:  RecordTypeAkamaiCDN,
+		TTL:   ttl,
+		Rdata: []string{strings.TrimSuffix(edgeHostname, ".")},
+	}
+}
+
+// validateApexAliasTarget checks that the target of AKAMAICDN record is an Akamai edge hostname
+func validateApexAliasTarget(target string) error {
+	if !isDomainName(target) {
+		return fmt.Errorf("%q is not a valid domain name", target)
+	}
+	host := strings.ToLower(strings.TrimSuffix(target, "."))
+	for _, domain := range apexAliasDomains {
+		if strings.HasSuffix(host, "."+domain) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%q is not an Akamai edge hostname, it should be within one of: %s", target, strings.Join(apexAliasDomains, ", "))
+}
+
+// validateApexAlias checks that AKAMAICDN recordset is at the apex of the zone. Other recordsets are not checked.
+func validateApexAlias(zone string, rs Recordset) (string, error) {
+	if !strings.EqualFold(rs.Type, RecordTypeAkamaiCDN) {
+		return "", nil
+	}
+	if !strings.EqualFold(strings.TrimSuffix(rs.Name, "."), strings.TrimSuffix(zone, ".")) {
+		return "Name", fmt.Errorf("%s record can be used only at the zone apex %q", RecordTypeAkamaiCDN, zone)
+	}
+	return "", nil
+}
+
+// validateApexAliases checks all recordsets with validateApexAlias and returns RecordsetErrors listing the invalid ones, if any
+func validateApexAliases(zone string, recordsets []Recordset) error {
+	var errs RecordsetErrors
+	for i, rs := range recordsets {
+		if field, err := validateApexAlias(zone, rs); err != nil {
+			errs = append(errs, &RecordsetError{Index: i, Name: rs.Name, Type: rs.Type, Field: field, Err: err})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDns_ApexAlias(t *testing.T) {
+	tests := map[string]struct {
+		recordset      Recordset
+		expectedChange *recordsetChange
+		expectedField  string
+	}{
+		"apex alias": {
+			recordset:      NewApexAlias("example.com.", "www.example.com.edgekey.net.", 300),
+			expectedChange: &recordsetChange{Name: "example.com", Type: "AKAMAICDN", Op: "ADD", TTL: 300, Rdata: []string{"www.example.com.edgekey.net"}},
+		},
+		"alias not at apex": {
+			recordset:     Recordset{Name: "www.example.com", Type: "AKAMAICDN", TTL: 300, Rdata: []string{"www.example.com.edgekey.net"}},
+			expectedField: "Name",
+		},
+		"alias to other domain": {
+			recordset:     NewApexAlias("example.com", "origin.example.net", 300),
+			expectedField: "Rdata[0]",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var change *recordsetChange
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-dns/v2/changelists/example.com/recordsets/add-change", r.URL.String())
+				change = &recordsetChange{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(change))
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			client := mockAPIClient(t, mockServer)
+			err := client.AddRecordSetToChangeList(context.Background(), "example.com", ChangeOpAdd, test.recordset)
+			if test.expectedField != "" {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				var recordErr *RecordsetError
+				require.True(t, errors.As(err, &recordErr))
+				assert.Equal(t, test.expectedField, recordErr.Field)
+				assert.Nil(t, change)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedChange, change)
+		})
+	}
+}
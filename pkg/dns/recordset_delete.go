@@ -0,0 +1,111 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type (
+	// DeleteRecordSetOptions contains options of DeleteRecordSet
+	DeleteRecordSetOptions struct {
+		// Snapshot retrieves the recordset before it is deleted, so that it can be restored with RestoreRecordSet
+		Snapshot bool
+	}
+
+	// RecordSetSnapshot is the state of a recordset before it was deleted
+	RecordSetSnapshot struct {
+		Zone      string
+		Recordset Recordset
+	}
+)
+
+func (p *dns) DeleteRecordSet(ctx context.Context, zone, name, recordType string, opts DeleteRecordSetOptions) (*RecordSetSnapshot, error) {
+	// same lock as in DeleteRecord, so the snapshot is not taken while the recordset is being changed
+	zoneRecordWriteLock.Lock()
+	defer zoneRecordWriteLock.Unlock()
+
+	logger := p.Log(ctx)
+	logger.Debug("DeleteRecordSet")
+
+	if err := validateZoneName(zone); err != nil {
+		return nil, err
+	}
+	if err := validateChange(zone, ChangeOpDelete, Recordset{Name: name, Type: recordType}); err != nil {
+		return nil, err
+	}
+	recordType = strings.ToUpper(recordType)
+
+	var snapshot *RecordSetSnapshot
+	if opts.Snapshot {
+		rec, err := p.GetRecord(ctx, zone, name, recordType)
+		if err != nil {
+			return nil, fmt.Errorf("DeleteRecordSet failed to get recordset snapshot: %w", err)
+		}
+		snapshot = &RecordSetSnapshot{
+			Zone: zone,
+			Recordset: Recordset{
+				Name:  rec.Name,
+				Type:  rec.RecordType,
+				TTL:   rec.TTL,
+				Rdata: rec.Target,
+			},
+		}
+	}
+
+	deleteURL := fmt.Sprintf("/config-dns/v2/zones/%s/names/%s/types/%s", zone, name, recordType)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, deleteURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create DeleteRecordSet request: %w", err)
+	}
+
+	resp, err := p.Exec(req, nil)
+	if err != nil {
+		return nil, fmt.Errorf("DeleteRecordSet request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return nil, p.Error(resp)
+	}
+
+	return snapshot, nil
+}
+
+func (p *dns) RestoreRecordSet(ctx context.Context, snapshot *RecordSetSnapshot) error {
+
+	zoneRecordWriteLock.Lock()
+	defer zoneRecordWriteLock.Unlock()
+
+	logger := p.Log(ctx)
+	logger.Debug("RestoreRecordSet")
+
+	if snapshot == nil {
+		return fmt.Errorf("%w: snapshot is required", ErrStructValidation)
+	}
+	if err := validateZoneName(snapshot.Zone); err != nil {
+		return err
+	}
+	if err := validateChange(snapshot.Zone, ChangeOpAdd, snapshot.Recordset); err != nil {
+		return err
+	}
+
+	rs := snapshot.Recordset
+	rs.Type = strings.ToUpper(rs.Type)
+	postURL := fmt.Sprintf("/config-dns/v2/zones/%s/names/%s/types/%s", snapshot.Zone, rs.Name, rs.Type)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, postURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create RestoreRecordSet request: %w", err)
+	}
+
+	resp, err := p.Exec(req, nil, rs)
+	if err != nil {
+		return fmt.Errorf("RestoreRecordSet request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return p.Error(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDns_DeleteRecordSet(t *testing.T) {
+	tests := map[string]struct {
+		name             string
+		recordType       string
+		opts             DeleteRecordSetOptions
+		responseStatus   int
+		responseBody     string
+		expectedCalls    []string
+		expectedSnapshot *RecordSetSnapshot
+		withError        error
+	}{
+		"delete with snapshot": {
+			name:           "www.example.com",
+			recordType:     "a",
+			opts:           DeleteRecordSetOptions{Snapshot: true},
+			responseStatus: http.StatusNoContent,
+			expectedCalls: []string{
+				"GET /config-dns/v2/zones/example.com/names/www.example.com/types/A",
+				"DELETE /config-dns/v2/zones/example.com/names/www.example.com/types/A",
+			},
+			expectedSnapshot: &RecordSetSnapshot{
+				Zone:      "example.com",
+				Recordset: Recordset{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2", "10.0.0.3"}},
+			},
+		},
+		"delete without snapshot": {
+			name:           "www.example.com",
+			recordType:     "A",
+			responseStatus: http.StatusNoContent,
+			expectedCalls: []string{
+				"DELETE /config-dns/v2/zones/example.com/names/www.example.com/types/A",
+			},
+		},
+		"missing type": {
+			name:      "www.example.com",
+			withError: ErrStructValidation,
+		},
+		"404 not found": {
+			name:           "www.example.com",
+			recordType:     "A",
+			responseStatus: http.StatusNotFound,
+			responseBody: `
+{
+	"type": "https://problems.luna.akamaiapis.net/config-dns/v2/not-found",
+	"title": "Not Found",
+	"detail": "Recordset www.example.com A not found",
+	"status": 404
+}`,
+			expectedCalls: []string{
+				"DELETE /config-dns/v2/zones/example.com/names/www.example.com/types/A",
+			},
+			withError: ErrNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.Method+" "+r.URL.String())
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(`{"name": "www.example.com", "type": "A", "ttl": 300, "rdata": ["10.0.0.2", "10.0.0.3"]}`))
+					assert.NoError(t, err)
+					return
+				}
+				w.WriteHeader(test.responseStatus)
+				if len(test.responseBody) > 0 {
+					_, err := w.Write([]byte(test.responseBody))
+					assert.NoError(t, err)
+				}
+			}))
+			client := mockAPIClient(t, mockServer)
+			snapshot, err := client.DeleteRecordSet(context.Background(), "example.com", test.name, test.recordType, test.opts)
+			assert.Equal(t, test.expectedCalls, calls)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedSnapshot, snapshot)
+		})
+	}
+}
+
+func TestDns_RestoreRecordSet(t *testing.T) {
+	tests := map[string]struct {
+		snapshot       *RecordSetSnapshot
+		responseStatus int
+		responseBody   string
+		expectedBody   *Recordset
+		withError      error
+	}{
+		"201 Created": {
+			snapshot: &RecordSetSnapshot{
+				Zone:      "example.com",
+				Recordset: Recordset{Name: "www.example.com", Type: "a", TTL: 300, Rdata: []string{"10.0.0.2", "10.0.0.3"}},
+			},
+			responseStatus: http.StatusCreated,
+			responseBody:   `{"name": "www.example.com", "type": "A", "ttl": 300, "rdata": ["10.0.0.2", "10.0.0.3"]}`,
+			expectedBody:   &Recordset{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2", "10.0.0.3"}},
+		},
+		"missing snapshot": {
+			withError: ErrStructValidation,
+		},
+		"409 conflict": {
+			snapshot: &RecordSetSnapshot{
+				Zone:      "example.com",
+				Recordset: Recordset{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2"}},
+			},
+			responseStatus: http.StatusConflict,
+			responseBody: `
+{
+	"type": "https://problems.luna.akamaiapis.net/config-dns/v2/conflict",
+	"title": "Conflict",
+	"detail": "Recordset www.example.com A already exists",
+	"status": 409
+}`,
+			expectedBody: &Recordset{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2"}},
+			withError: &Error{
+				Type:       "https://problems.luna.akamaiapis.net/config-dns/v2/conflict",
+				Title:      "Conflict",
+				Detail:     "Recordset www.example.com A already exists",
+				StatusCode: http.StatusConflict,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-dns/v2/zones/example.com/names/www.example.com/types/A", r.URL.String())
+				assert.Equal(t, http.MethodPost, r.Method)
+				var body Recordset
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, test.expectedBody, &body)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			err := client.RestoreRecordSet(context.Background(), test.snapshot)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
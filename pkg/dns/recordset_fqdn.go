@@ -0,0 +1,127 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TrailingDots defines how names and targets of recordsets are normalized before they are submitted
+type TrailingDots int
+
+const (
+	// TrailingDotsKeep sends names and targets as they are
+	TrailingDotsKeep TrailingDots = iota
+	// TrailingDotsNormalize removes the trailing dot from names and adds it to host name targets,
+	// e.g. of CNAME, MX, NS and SRV records. Names and single label targets without a trailing dot
+	// are treated as relative to the zone.
+	TrailingDotsNormalize
+	// TrailingDotsStrict normalizes as TrailingDotsNormalize, but fails instead of guessing,
+	// i.e. if a name is not within the zone or a target has no trailing dot.
+	TrailingDotsStrict
+)
+
+// hostTargetField is the index of the field of rdata holding a host name, by record type
+var hostTargetField = map[string]int{
+	"CNAME": 0,
+	"NS":    0,
+	"PTR":   0,
+	"MX":    1,
+	"SRV":   3,
+}
+
+// NormalizeRecordset returns the recordset with trailing dots of its name and host name targets normalized
+// according to the mode. Errors are of *RecordsetError type and match ErrStructValidation.
+func NormalizeRecordset(zone string, rs Recordset, mode TrailingDots) (Recordset, error) {
+	if mode == TrailingDotsKeep {
+		return rs, nil
+	}
+	zone = strings.TrimSuffix(zone, ".")
+	strict := mode == TrailingDotsStrict
+
+	name, err := normalizeName(zone, rs.Name, strict)
+	if err != nil {
+		return rs, &RecordsetError{Name: rs.Name, Type: rs.Type, Field: "Name", Err: err}
+	}
+	normalized := Recordset{Name: name, Type: rs.Type, TTL: rs.TTL}
+
+	field, ok := hostTargetField[strings.ToUpper(rs.Type)]
+	if !ok {
+		normalized.Rdata = rs.Rdata
+		return normalized, nil
+	}
+	normalized.Rdata = make([]string, len(rs.Rdata))
+	for i, rdata := range rs.Rdata {
+		fields := strings.Fields(rdata)
+		if len(fields) <= field {
+			// malformed rdata is left to validation
+			normalized.Rdata[i] = rdata
+			continue
+		}
+		target, err := normalizeTarget(zone, fields[field], strict)
+		if err != nil {
+			return rs, &RecordsetError{Name: rs.Name, Type: rs.Type, Field: fmt.Sprintf("Rdata[%d]", i), Err: err}
+		}
+		fields[field] = target
+		normalized.Rdata[i] = strings.Join(fields, " ")
+	}
+	return normalized, nil
+}
+
+// normalizeRecordsets normalizes all recordsets and returns RecordsetErrors listing the ones which failed, if any
+func normalizeRecordsets(zone string, recordsets []Recordset, mode TrailingDots) ([]Recordset, error) {
+	if mode == TrailingDotsKeep {
+		return recordsets, nil
+	}
+	var errs RecordsetErrors
+	normalized := make([]Recordset, len(recordsets))
+	for i, rs := range recordsets {
+		var err error
+		normalized[i], err = NormalizeRecordset(zone, rs, mode)
+		var rsErr *RecordsetError
+		if errors.As(err, &rsErr) {
+			rsErr.Index = i
+			errs = append(errs, rsErr)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return normalized, nil
+}
+
+// normalizeName returns the name without a trailing dot. A name without the trailing dot which is not within
+// the zone is treated as relative to it, unless strict is set.
+func normalizeName(zone, name string, strict bool) (string, error) {
+	if name == "@" {
+		return zone, nil
+	}
+	if strings.HasSuffix(name, ".") {
+		return strings.TrimSuffix(name, "."), nil
+	}
+	if strings.EqualFold(name, zone) || strings.HasSuffix(strings.ToLower(name), "."+strings.ToLower(zone)) {
+		return name, nil
+	}
+	if strict {
+		return "", fmt.Errorf("name %q is not within zone %q, add a trailing dot or the zone name", name, zone)
+	}
+	return name + "." + zone, nil
+}
+
+// normalizeTarget returns the host name with a trailing dot. A single label host name without the trailing dot
+// is treated as relative to the zone. In strict mode, the trailing dot is required.
+func normalizeTarget(zone, target string, strict bool) (string, error) {
+	if strings.HasSuffix(target, ".") {
+		return target, nil
+	}
+	if target == "@" {
+		return zone + ".", nil
+	}
+	if strict {
+		return "", fmt.Errorf("host name %q has no trailing dot, it could be relative to the zone or fully qualified", target)
+	}
+	if !strings.Contains(target, ".") {
+		return target + "." + zone + ".", nil
+	}
+	return target + ".", nil
+}
@@ -0,0 +1,89 @@
+package dns
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeRecordset(t *testing.T) {
+	tests := map[string]struct {
+		recordset     Recordset
+		mode          TrailingDots
+		expected      Recordset
+		expectedField string
+	}{
+		"keep": {
+			recordset: Recordset{Name: "www.example.com.", Type: "CNAME", TTL: 300, Rdata: []string{"origin.example.net"}},
+			mode:      TrailingDotsKeep,
+			expected:  Recordset{Name: "www.example.com.", Type: "CNAME", TTL: 300, Rdata: []string{"origin.example.net"}},
+		},
+		"CNAME without trailing dots": {
+			recordset: Recordset{Name: "www.example.com", Type: "CNAME", TTL: 300, Rdata: []string{"origin.example.net"}},
+			mode:      TrailingDotsNormalize,
+			expected:  Recordset{Name: "www.example.com", Type: "CNAME", TTL: 300, Rdata: []string{"origin.example.net."}},
+		},
+		"CNAME with trailing dots": {
+			recordset: Recordset{Name: "www.example.com.", Type: "CNAME", TTL: 300, Rdata: []string{"origin.example.net."}},
+			mode:      TrailingDotsStrict,
+			expected:  Recordset{Name: "www.example.com", Type: "CNAME", TTL: 300, Rdata: []string{"origin.example.net."}},
+		},
+		"CNAME with relative name and target": {
+			recordset: Recordset{Name: "www", Type: "cname", TTL: 300, Rdata: []string{"origin"}},
+			mode:      TrailingDotsNormalize,
+			expected:  Recordset{Name: "www.example.com", Type: "cname", TTL: 300, Rdata: []string{"origin.example.com."}},
+		},
+		"MX with and without trailing dots": {
+			recordset: Recordset{Name: "@", Type: "MX", TTL: 300, Rdata: []string{"10 mail.example.com", "20  mail2.example.com.", "30 mail3", "0 ."}},
+			mode:      TrailingDotsNormalize,
+			expected:  Recordset{Name: "example.com", Type: "MX", TTL: 300, Rdata: []string{"10 mail.example.com.", "20 mail2.example.com.", "30 mail3.example.com.", "0 ."}},
+		},
+		"NS without trailing dots": {
+			recordset: Recordset{Name: "sub.example.com", Type: "NS", TTL: 300, Rdata: []string{"a1-1.akam.net", "a2-2.akam.net."}},
+			mode:      TrailingDotsNormalize,
+			expected:  Recordset{Name: "sub.example.com", Type: "NS", TTL: 300, Rdata: []string{"a1-1.akam.net.", "a2-2.akam.net."}},
+		},
+		"rdata of other types is kept": {
+			recordset: Recordset{Name: "www.example.com", Type: "TXT", TTL: 300, Rdata: []string{`"origin.example.net"`}},
+			mode:      TrailingDotsStrict,
+			expected:  Recordset{Name: "www.example.com", Type: "TXT", TTL: 300, Rdata: []string{`"origin.example.net"`}},
+		},
+		"strict CNAME without trailing dot": {
+			recordset:     Recordset{Name: "www.example.com", Type: "CNAME", TTL: 300, Rdata: []string{"origin.example.net"}},
+			mode:          TrailingDotsStrict,
+			expectedField: "Rdata[0]",
+		},
+		"strict MX without trailing dot": {
+			recordset:     Recordset{Name: "example.com", Type: "MX", TTL: 300, Rdata: []string{"10 mail.example.com.", "20 mail2"}},
+			mode:          TrailingDotsStrict,
+			expectedField: "Rdata[1]",
+		},
+		"strict NS without trailing dot": {
+			recordset:     Recordset{Name: "sub.example.com", Type: "NS", TTL: 300, Rdata: []string{"a1-1.akam.net"}},
+			mode:          TrailingDotsStrict,
+			expectedField: "Rdata[0]",
+		},
+		"strict name outside of zone": {
+			recordset:     Recordset{Name: "www.example.org", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2"}},
+			mode:          TrailingDotsStrict,
+			expectedField: "Name",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := NormalizeRecordset("example.com.", test.recordset, test.mode)
+			if test.expectedField != "" {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				var recordErr *RecordsetError
+				require.True(t, errors.As(err, &recordErr))
+				assert.Equal(t, test.expectedField, recordErr.Field)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
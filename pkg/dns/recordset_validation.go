@@ -0,0 +1,234 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+type (
+	// RecordsetError describes why a recordset is invalid. It matches ErrStructValidation with errors.Is.
+	RecordsetError struct {
+		// Index is the position of the recordset in the validated list
+		Index int
+		Name  string
+		Type  string
+		// Field is the invalid field of the recordset, e.g. Rdata
+		Field string
+		Err   error
+	}
+
+	// RecordsetErrors is a list of errors of all invalid recordsets of a batch.
+	// It matches ErrStructValidation with errors.Is.
+	RecordsetErrors []*RecordsetError
+)
+
+const (
+	// MinRecordTTL is the lowest TTL of a recordset accepted by Edge DNS, in seconds
+	MinRecordTTL = 30
+	// MaxRecordTTL is the highest TTL of a recordset accepted by Edge DNS, in seconds, see RFC 2181
+	MaxRecordTTL = 2147483647
+)
+
+// recordTypes are the record types supported by Edge DNS
+var recordTypes = map[string]struct{}{
+	"A": {}, "AAAA": {}, "AFSDB": {}, "AKAMAICDN": {}, "AKAMAITLC": {}, "CAA": {}, "CERT": {}, "CNAME": {},
+	"DNSKEY": {}, "DS": {}, "HINFO": {}, "HTTPS": {}, "LOC": {}, "MX": {}, "NAPTR": {}, "NS": {}, "NSEC3": {},
+	"NSEC3PARAM": {}, "PTR": {}, "RP": {}, "RRSIG": {}, "SOA": {}, "SPF": {}, "SRV": {}, "SSHFP": {}, "SVCB": {},
+	"TLSA": {}, "TXT": {}, "ZONEMD": {},
+}
+
+func (e *RecordsetError) Error() string {
+	return fmt.Sprintf("%s %s: %s: %s", e.Name, e.Type, e.Field, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *RecordsetError) Unwrap() error {
+	return e.Err
+}
+
+// Is handles error comparisons
+func (e *RecordsetError) Is(target error) bool {
+	return target == ErrStructValidation
+}
+
+func (e RecordsetErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, fmt.Sprintf("recordset %d (%s)", err.Index, err))
+	}
+	return fmt.Sprintf("%s: %d invalid recordset(s):\n%s", ErrStructValidation, len(e), strings.Join(msgs, "\n"))
+}
+
+// Is handles error comparisons
+func (e RecordsetErrors) Is(target error) bool {
+	return target == ErrStructValidation
+}
+
+// validateRecordsets validates all recordsets and returns RecordsetErrors listing the invalid ones, if any
+func validateRecordsets(recordsets []Recordset) error {
+	var errs RecordsetErrors
+	for i, rs := range recordsets {
+		if field, err := validateRecordset(rs); err != nil {
+			errs = append(errs, &RecordsetError{Index: i, Name: rs.Name, Type: rs.Type, Field: field, Err: err})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateRecordset validates the recordset and returns the name of the invalid field along with the error
+func validateRecordset(rs Recordset) (string, error) {
+	if field, err := validateRecordsetID(rs); err != nil {
+		return field, err
+	}
+	if err := validateTTL(rs.TTL); err != nil {
+		return "TTL", err
+	}
+	if len(rs.Rdata) == 0 {
+		return "Rdata", errors.New("cannot be blank")
+	}
+	if i, err := validateRecordRdata(rs.Type, rs.Rdata); err != nil {
+		if i < 0 {
+			return "Rdata", err
+		}
+		return fmt.Sprintf("Rdata[%d]", i), err
+	}
+	return "", nil
+}
+
+// validateRecordsetID validates name and type identifying the recordset
+func validateRecordsetID(rs Recordset) (string, error) {
+	if rs.Name == "" {
+		return "Name", errors.New("cannot be blank")
+	}
+	if rs.Type == "" {
+		return "Type", errors.New("cannot be blank")
+	}
+	if _, ok := recordTypes[strings.ToUpper(rs.Type)]; !ok {
+		return "Type", fmt.Errorf("unsupported record type %q", rs.Type)
+	}
+	return "", nil
+}
+
+// validateTTL checks that the TTL is within the range accepted by Edge DNS
+func validateTTL(ttl int) error {
+	switch {
+	case ttl < 0:
+		return fmt.Errorf("%d must not be negative", ttl)
+	case ttl == 0:
+		return errors.New("must be greater than 0")
+	case ttl < MinRecordTTL || ttl > MaxRecordTTL:
+		return fmt.Errorf("%d is out of range %d-%d", ttl, MinRecordTTL, MaxRecordTTL)
+	}
+	return nil
+}
+
+// validateRdata validates format of a single rdata value of the record type.
+// Values of the types without specific rules are only checked not to be blank.
+func validateRdata(recordType, rdata string) error {
+	if strings.TrimSpace(rdata) == "" {
+		return errors.New("cannot be blank")
+	}
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(rdata); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("%q is not a valid IPv4 address", rdata)
+		}
+	case "AAAA":
+		if ip := net.ParseIP(rdata); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("%q is not a valid IPv6 address", rdata)
+		}
+	case "CNAME":
+		if !isDomainName(rdata) {
+			return fmt.Errorf("%q is not a valid domain name", rdata)
+		}
+	case RecordTypeAkamaiCDN:
+		return validateApexAliasTarget(rdata)
+	case "MX":
+		fields := strings.Fields(rdata)
+		if len(fields) != 2 {
+			return fmt.Errorf("%q should have format '<priority> <mail server>'", rdata)
+		}
+		if err := validateUint16("priority", fields[0]); err != nil {
+			return err
+		}
+		if fields[1] != "." && !isDomainName(fields[1]) {
+			return fmt.Errorf("mail server %q is not a valid domain name", fields[1])
+		}
+	case "SRV":
+		fields := strings.Fields(rdata)
+		if len(fields) != 4 {
+			return fmt.Errorf("%q should have format '<priority> <weight> <port> <target>'", rdata)
+		}
+		for i, name := range []string{"priority", "weight", "port"} {
+			if err := validateUint16(name, fields[i]); err != nil {
+				return err
+			}
+		}
+		if fields[3] != "." && !isDomainName(fields[3]) {
+			return fmt.Errorf("target %q is not a valid domain name", fields[3])
+		}
+	case "TXT":
+		return validateTXT(rdata)
+	}
+	return nil
+}
+
+// validateRecordRdata validates the number of rdata values of the record type and each of the values
+func validateRecordRdata(recordType string, rdata []string) (int, error) {
+	recordType = strings.ToUpper(recordType)
+	if (recordType == "CNAME" || recordType == RecordTypeAkamaiCDN) && len(rdata) > 1 {
+		return -1, fmt.Errorf("%s record can have only one value, got %d", recordType, len(rdata))
+	}
+	for i, value := range rdata {
+		if err := validateRdata(recordType, value); err != nil {
+			return i, err
+		}
+	}
+	return 0, nil
+}
+
+// validateUint16 checks that the field of rdata is a number in range 0-65535
+func validateUint16(field, value string) error {
+	if _, err := strconv.ParseUint(value, 10, 16); err != nil {
+		return fmt.Errorf("%s %q should be a number in range 0-65535", field, value)
+	}
+	return nil
+}
+
+// validateTXT checks that quotes of TXT rdata are balanced and each quoted string is at most 255 characters long
+func validateTXT(rdata string) error {
+	if !strings.Contains(rdata, `"`) {
+		return nil
+	}
+	var current strings.Builder
+	quoted, escaped := false, false
+	for _, r := range rdata {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+			continue
+		case r == '"':
+			if quoted && current.Len() > 255 {
+				return fmt.Errorf("TXT string %.20q... is longer than 255 characters, split it into multiple quoted strings", current.String())
+			}
+			quoted = !quoted
+			current.Reset()
+			continue
+		}
+		if quoted {
+			current.WriteRune(r)
+		}
+	}
+	if quoted {
+		return fmt.Errorf("%q has unbalanced quotes", rdata)
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRecordset(t *testing.T) {
+	tests := map[string]struct {
+		recordset     Recordset
+		expectedField string
+		expectedError string
+	}{
+		"valid A": {
+			recordset: Recordset{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2", "10.0.0.3"}},
+		},
+		"A with IPv6 address": {
+			recordset:     Recordset{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2", "2001:db8::1"}},
+			expectedField: "Rdata[1]",
+			expectedError: "not a valid IPv4 address",
+		},
+		"A with host name": {
+			recordset:     Recordset{Name: "www.example.com", Type: "a", TTL: 300, Rdata: []string{"origin.example.com"}},
+			expectedField: "Rdata[0]",
+			expectedError: "not a valid IPv4 address",
+		},
+		"valid AAAA": {
+			recordset: Recordset{Name: "www.example.com", Type: "AAAA", TTL: 300, Rdata: []string{"2001:db8::1", "2001:0db8:0000:0000:0000:0000:0000:0002"}},
+		},
+		"AAAA with IPv4 address": {
+			recordset:     Recordset{Name: "www.example.com", Type: "AAAA", TTL: 300, Rdata: []string{"10.0.0.2"}},
+			expectedField: "Rdata[0]",
+			expectedError: "not a valid IPv6 address",
+		},
+		"valid CNAME": {
+			recordset: Recordset{Name: "www.example.com", Type: "CNAME", TTL: 300, Rdata: []string{"www.example.com.edgekey.net."}},
+		},
+		"CNAME with multiple values": {
+			recordset:     Recordset{Name: "www.example.com", Type: "CNAME", TTL: 300, Rdata: []string{"a.example.com.", "b.example.com."}},
+			expectedField: "Rdata",
+			expectedError: "only one value",
+		},
+		"CNAME with invalid target": {
+			recordset:     Recordset{Name: "www.example.com", Type: "CNAME", TTL: 300, Rdata: []string{"bad..example.com"}},
+			expectedField: "Rdata[0]",
+			expectedError: "not a valid domain name",
+		},
+		"valid AKAMAICDN": {
+			recordset: Recordset{Name: "example.com", Type: "AKAMAICDN", TTL: 300, Rdata: []string{"www.example.com.edgekey.net"}},
+		},
+		"AKAMAICDN with non-Akamai target": {
+			recordset:     Recordset{Name: "example.com", Type: "AKAMAICDN", TTL: 300, Rdata: []string{"origin.example.net"}},
+			expectedField: "Rdata[0]",
+			expectedError: "not an Akamai edge hostname",
+		},
+		"AKAMAICDN with multiple values": {
+			recordset:     Recordset{Name: "example.com", Type: "AKAMAICDN", TTL: 300, Rdata: []string{"a.edgekey.net", "b.edgekey.net"}},
+			expectedField: "Rdata",
+			expectedError: "only one value",
+		},
+		"valid MX": {
+			recordset: Recordset{Name: "example.com", Type: "MX", TTL: 300, Rdata: []string{"10 mail.example.com.", "20 mail2.example.com", "0 ."}},
+		},
+		"MX without priority": {
+			recordset:     Recordset{Name: "example.com", Type: "MX", TTL: 300, Rdata: []string{"mail.example.com."}},
+			expectedField: "Rdata[0]",
+			expectedError: "should have format '<priority> <mail server>'",
+		},
+		"MX with priority out of range": {
+			recordset:     Recordset{Name: "example.com", Type: "MX", TTL: 300, Rdata: []string{"65536 mail.example.com."}},
+			expectedField: "Rdata[0]",
+			expectedError: "priority \"65536\" should be a number in range 0-65535",
+		},
+		"MX with invalid mail server": {
+			recordset:     Recordset{Name: "example.com", Type: "MX", TTL: 300, Rdata: []string{"10 -mail.example.com."}},
+			expectedField: "Rdata[0]",
+			expectedError: "mail server \"-mail.example.com.\" is not a valid domain name",
+		},
+		"valid TXT": {
+			recordset: Recordset{Name: "example.com", Type: "TXT", TTL: 300, Rdata: []string{`"v=spf1 -all"`, `"part \"one\"" "part two"`, "unquoted"}},
+		},
+		"TXT with unbalanced quotes": {
+			recordset:     Recordset{Name: "example.com", Type: "TXT", TTL: 300, Rdata: []string{`"v=spf1 -all`}},
+			expectedField: "Rdata[0]",
+			expectedError: "unbalanced quotes",
+		},
+		"TXT with too long string": {
+			recordset:     Recordset{Name: "example.com", Type: "TXT", TTL: 300, Rdata: []string{`"` + strings.Repeat("a", 256) + `"`}},
+			expectedField: "Rdata[0]",
+			expectedError: "longer than 255 characters",
+		},
+		"valid SRV": {
+			recordset: Recordset{Name: "_sip._tcp.example.com", Type: "SRV", TTL: 300, Rdata: []string{"10 60 5060 sip.example.com."}},
+		},
+		"SRV without weight": {
+			recordset:     Recordset{Name: "_sip._tcp.example.com", Type: "SRV", TTL: 300, Rdata: []string{"10 5060 sip.example.com."}},
+			expectedField: "Rdata[0]",
+			expectedError: "should have format '<priority> <weight> <port> <target>'",
+		},
+		"SRV with invalid port": {
+			recordset:     Recordset{Name: "_sip._tcp.example.com", Type: "SRV", TTL: 300, Rdata: []string{"10 60 port sip.example.com."}},
+			expectedField: "Rdata[0]",
+			expectedError: "port \"port\" should be a number in range 0-65535",
+		},
+		"blank rdata value": {
+			recordset:     Recordset{Name: "example.com", Type: "NS", TTL: 300, Rdata: []string{"a1-1.akam.net.", " "}},
+			expectedField: "Rdata[1]",
+			expectedError: "cannot be blank",
+		},
+		"unsupported type": {
+			recordset:     Recordset{Name: "example.com", Type: "FOO", TTL: 300, Rdata: []string{"foo"}},
+			expectedField: "Type",
+			expectedError: "unsupported record type",
+		},
+		"TTL too low": {
+			recordset:     Recordset{Name: "example.com", Type: "A", TTL: 29, Rdata: []string{"10.0.0.2"}},
+			expectedField: "TTL",
+			expectedError: "29 is out of range 30-2147483647",
+		},
+		"TTL too high": {
+			recordset:     Recordset{Name: "example.com", Type: "A", TTL: MaxRecordTTL + 1, Rdata: []string{"10.0.0.2"}},
+			expectedField: "TTL",
+			expectedError: "2147483648 is out of range 30-2147483647",
+		},
+		"negative TTL": {
+			recordset:     Recordset{Name: "example.com", Type: "A", TTL: -300, Rdata: []string{"10.0.0.2"}},
+			expectedField: "TTL",
+			expectedError: "must not be negative",
+		},
+		"TTL at bounds": {
+			recordset: Recordset{Name: "example.com", Type: "A", TTL: MinRecordTTL, Rdata: []string{"10.0.0.2"}},
+		},
+		"maximum TTL": {
+			recordset: Recordset{Name: "example.com", Type: "A", TTL: MaxRecordTTL, Rdata: []string{"10.0.0.2"}},
+		},
+		"missing TTL": {
+			recordset:     Recordset{Name: "example.com", Type: "A", Rdata: []string{"10.0.0.2"}},
+			expectedField: "TTL",
+			expectedError: "must be greater than 0",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			field, err := validateRecordset(test.recordset)
+			if test.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, test.expectedField, field)
+			assert.Contains(t, err.Error(), test.expectedError)
+		})
+	}
+}
+
+func TestDns_CreateRecordInvalidRdata(t *testing.T) {
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request with invalid record should not be sent")
+	}))
+	client := mockAPIClient(t, mockServer)
+
+	err := client.CreateRecord(context.Background(), &RecordBody{
+		Name:       "example.com",
+		RecordType: "MX",
+		TTL:        300,
+		Target:     []string{"10 mail.example.com.", "mail2.example.com."},
+	}, "example.com")
+	var recordErr *RecordsetError
+	require.True(t, errors.As(err, &recordErr), "want: *RecordsetError; got: %s", err)
+	assert.Equal(t, "Target[1]", recordErr.Field)
+
+	err = client.UpdateRecord(context.Background(), &RecordBody{
+		Name:       "www.example.com",
+		RecordType: "A",
+		TTL:        10,
+		Target:     []string{"10.0.0.2"},
+	}, "example.com")
+	require.True(t, errors.As(err, &recordErr), "want: *RecordsetError; got: %s", err)
+	assert.Equal(t, "TTL", recordErr.Field)
+
+	err = client.CreateRecordsets(context.Background(), &Recordsets{Recordsets: []Recordset{
+		{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2"}},
+		{Name: "www.example.com", Type: "AAAA", TTL: 300, Rdata: []string{"10.0.0.2"}},
+	}}, "example.com")
+	assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+	var recordsetErrs RecordsetErrors
+	require.True(t, errors.As(err, &recordsetErrs))
+	require.Len(t, recordsetErrs, 1)
+	assert.Equal(t, 1, recordsetErrs[0].Index)
+	assert.Equal(t, "Rdata[0]", recordsetErrs[0].Field)
+}
@@ -4,10 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-
-	validation "github.com/go-ozzo/ozzo-validation/v4"
-
 	"strconv"
+	"strings"
 	"sync"
 )
 
@@ -19,10 +17,16 @@ var (
 type RecordSets interface {
 	// NewRecordSetResponse returns new response object.
 	NewRecordSetResponse(context.Context, string) *RecordSetResponse
-	// GetRecordsets retrieves recordsets with Query Args. No formatting of arg values.
+	// GetRecordsets retrieves recordsets with Query Args, e.g. a single page of recordsets of the given types
+	// which names match the search. Metadata of the response contains the pagination details.
 	//
 	// See: See: https://techdocs.akamai.com/edge-dns/reference/get-zones-zone-recordsets
 	GetRecordsets(context.Context, string, ...RecordsetQueryArgs) (*RecordSetResponse, error)
+	// GetRecordSetsByName retrieves all recordsets of the name, regardless of their type.
+	// It returns an empty list if there are no recordsets with the name.
+	//
+	// See: https://techdocs.akamai.com/edge-dns/reference/get-zones-zone-recordsets
+	GetRecordSetsByName(context.Context, string, string) ([]Recordset, error)
 	// CreateRecordsets creates multiple recordsets.
 	//
 	// See: https://techdocs.akamai.com/edge-dns/reference/post-zones-zone-recordsets
@@ -31,16 +35,37 @@ type RecordSets interface {
 	//
 	// See: https://techdocs.akamai.com/edge-dns/reference/put-zones-zone-recordsets
 	UpdateRecordsets(context.Context, *Recordsets, string, ...bool) error
+	// UpsertRecordsets creates or replaces the recordsets in a single change list, so all of them are applied together.
+	// The recordsets are validated before any request is made and RecordsetErrors lists all the invalid ones.
+	// The change list is discarded if any of the changes cannot be added or submitted.
+	//
+	// See: https://techdocs.akamai.com/edge-dns/reference/post-changelists-zone-recordsets-add-change
+	UpsertRecordsets(context.Context, string, []Recordset, UpsertRecordsetsOptions) error
+	// BulkUpsertRecordsets creates or replaces many recordsets without a change list, e.g. when migrating a zone.
+	// New recordsets are created in chunks of BulkUpsertRecordsetsOptions.ChunkSize, existing ones are replaced
+	// one by one, so the changes are not applied together. Invalid recordsets are skipped and the valid ones
+	// are still sent; BulkUpsertError lists all recordsets which were invalid or rejected by the API.
+	//
+	// See: https://techdocs.akamai.com/edge-dns/reference/post-zones-zone-recordsets
+	BulkUpsertRecordsets(context.Context, string, []Recordset, BulkUpsertRecordsetsOptions) error
+	// SetRecordSet creates or replaces a single recordset, creating and submitting a change list for it.
+	// It returns the zone, which activation state can be passed to WaitForZoneActivation.
+	// See SetRecordSetOptions on how a pending change list of the zone is handled.
+	//
+	// See: https://techdocs.akamai.com/edge-dns/reference/post-changelists-zone-submit
+	SetRecordSet(context.Context, string, Recordset, SetRecordSetOptions) (*ZoneResponse, error)
 }
 
 // RecordsetQueryArgs contains query parameters for recordset request
 type RecordsetQueryArgs struct {
 	Page     int
 	PageSize int
-	Search   string
-	ShowAll  bool
-	SortBy   string
-	Types    string
+	// Search filters recordsets by name
+	Search  string
+	ShowAll bool
+	SortBy  string
+	// Types is a comma-separated list of record types to filter by, e.g. "A,AAAA"
+	Types string
 }
 
 // Recordsets Struct. Used for Create and Update Recordsets. Contains a list of Recordset objects
@@ -71,21 +96,30 @@ type RecordSetResponse struct {
 	Recordsets []Recordset `json:"recordsets"`
 }
 
-// Validate validates Recordsets
+// Validate validates Recordsets. Type-specific format of rdata is validated for the common record types
+// and RecordsetErrors lists all invalid recordsets.
 func (rs *Recordsets) Validate() error {
 
 	if len(rs.Recordsets) < 1 {
 		return fmt.Errorf("Request initiated with empty recordsets list")
 	}
-	for _, rec := range rs.Recordsets {
-		err := validation.Errors{
-			"Name":  validation.Validate(rec.Name, validation.Required),
-			"Type":  validation.Validate(rec.Type, validation.Required),
-			"TTL":   validation.Validate(rec.TTL, validation.Required),
-			"Rdata": validation.Validate(rec.Rdata, validation.Required),
-		}.Filter()
-		if err != nil {
-			return err
+	return validateRecordsets(rs.Recordsets)
+}
+
+// Validate validates RecordsetQueryArgs
+func (args RecordsetQueryArgs) Validate() error {
+	if args.Page < 0 {
+		return fmt.Errorf("%w: page must not be negative", ErrStructValidation)
+	}
+	if args.PageSize < 0 {
+		return fmt.Errorf("%w: page size must not be negative", ErrStructValidation)
+	}
+	if args.Types == "" {
+		return nil
+	}
+	for _, recordType := range strings.Split(args.Types, ",") {
+		if _, ok := recordTypes[strings.ToUpper(strings.TrimSpace(recordType))]; !ok {
+			return fmt.Errorf("%w: unsupported record type %q", ErrStructValidation, recordType)
 		}
 	}
 	return nil
@@ -104,6 +138,14 @@ func (p *dns) GetRecordsets(ctx context.Context, zone string, queryArgs ...Recor
 	if len(queryArgs) > 1 {
 		return nil, fmt.Errorf("invalid arguments GetRecordsets QueryArgs")
 	}
+	if err := validateZoneName(zone); err != nil {
+		return nil, err
+	}
+	if len(queryArgs) > 0 {
+		if err := queryArgs[0].Validate(); err != nil {
+			return nil, err
+		}
+	}
 
 	var recordsetResp RecordSetResponse
 	getURL := fmt.Sprintf("/config-dns/v2/zones/%s/recordsets", zone)
@@ -129,7 +171,7 @@ func (p *dns) GetRecordsets(ctx context.Context, zone string, queryArgs ...Recor
 			q.Add("sortBy", queryArgs[0].SortBy)
 		}
 		if queryArgs[0].Types != "" {
-			q.Add("types", queryArgs[0].Types)
+			q.Add("types", strings.ToUpper(strings.ReplaceAll(queryArgs[0].Types, " ", "")))
 		}
 		req.URL.RawQuery = q.Encode()
 	}
@@ -164,6 +206,9 @@ func (p *dns) CreateRecordsets(ctx context.Context, recordsets *Recordsets, zone
 	if err := recordsets.Validate(); err != nil {
 		return err
 	}
+	if err := validateApexAliases(zone, recordsets.Recordsets); err != nil {
+		return err
+	}
 
 	reqbody, err := convertStructToReqBody(recordsets)
 	if err != nil {
@@ -206,6 +251,9 @@ func (p *dns) UpdateRecordsets(ctx context.Context, recordsets *Recordsets, zone
 	if err := recordsets.Validate(); err != nil {
 		return err
 	}
+	if err := validateApexAliases(zone, recordsets.Recordsets); err != nil {
+		return err
+	}
 
 	reqbody, err := convertStructToReqBody(recordsets)
 	if err != nil {
@@ -0,0 +1,172 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type (
+	// BulkUpsertRecordsetsOptions contains options of BulkUpsertRecordsets
+	BulkUpsertRecordsetsOptions struct {
+		// ChunkSize is the maximum number of recordsets created with a single request, DefaultBulkChunkSize if not set.
+		// It keeps the request body under the size accepted by the API.
+		ChunkSize int
+	}
+
+	// RejectedRecordset is a recordset which was rejected by the API
+	RejectedRecordset struct {
+		// Index is the position of the recordset in the list passed to BulkUpsertRecordsets
+		Index int
+		Name  string
+		Type  string
+		// Err is the error of the request which contained the recordset
+		Err error
+	}
+
+	// BulkUpsertError lists the recordsets which were not created or replaced by BulkUpsertRecordsets.
+	// It matches ErrStructValidation with errors.Is if any of the recordsets is invalid.
+	BulkUpsertError struct {
+		// Invalid are the recordsets which failed validation and were not sent
+		Invalid RecordsetErrors
+		// Rejected are the recordsets which were sent, but the request failed
+		Rejected []*RejectedRecordset
+	}
+)
+
+// DefaultBulkChunkSize is the default maximum number of recordsets created with a single request by BulkUpsertRecordsets
+const DefaultBulkChunkSize = 500
+
+func (e *RejectedRecordset) Error() string {
+	return fmt.Sprintf("%s %s: %s", e.Name, e.Type, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *RejectedRecordset) Unwrap() error {
+	return e.Err
+}
+
+func (e *BulkUpsertError) Error() string {
+	msgs := make([]string, 0, len(e.Invalid)+len(e.Rejected))
+	for _, err := range e.Invalid {
+		msgs = append(msgs, fmt.Sprintf("recordset %d (%s)", err.Index, err))
+	}
+	for _, err := range e.Rejected {
+		msgs = append(msgs, fmt.Sprintf("recordset %d (%s)", err.Index, err))
+	}
+	return fmt.Sprintf("%d invalid and %d rejected recordset(s):\n%s", len(e.Invalid), len(e.Rejected), strings.Join(msgs, "\n"))
+}
+
+// Is handles error comparisons
+func (e *BulkUpsertError) Is(target error) bool {
+	return target == ErrStructValidation && len(e.Invalid) > 0
+}
+
+func (p *dns) BulkUpsertRecordsets(ctx context.Context, zone string, recordsets []Recordset, opts BulkUpsertRecordsetsOptions) error {
+	// Changes to the zone are serialized, same as in UpsertRecordsets
+	zoneRecordsetsWriteLock.Lock()
+	defer zoneRecordsetsWriteLock.Unlock()
+
+	logger := p.Log(ctx)
+	logger.Debug("BulkUpsertRecordsets")
+
+	if err := validateZoneName(zone); err != nil {
+		return err
+	}
+	if len(recordsets) == 0 {
+		return fmt.Errorf("%w: recordsets list is empty", ErrStructValidation)
+	}
+	if opts.ChunkSize < 0 {
+		return fmt.Errorf("%w: chunk size must not be negative", ErrStructValidation)
+	}
+	chunkSize := opts.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = DefaultBulkChunkSize
+	}
+
+	var bulkErr BulkUpsertError
+	valid := make([]int, 0, len(recordsets))
+	for i, rs := range recordsets {
+		field, err := validateRecordset(rs)
+		if err == nil {
+			field, err = validateApexAlias(zone, rs)
+		}
+		if err != nil {
+			bulkErr.Invalid = append(bulkErr.Invalid, &RecordsetError{Index: i, Name: rs.Name, Type: rs.Type, Field: field, Err: err})
+			continue
+		}
+		valid = append(valid, i)
+	}
+
+	if len(valid) > 0 {
+		existing, err := p.GetRecordsets(ctx, zone, RecordsetQueryArgs{ShowAll: true})
+		if err != nil {
+			return fmt.Errorf("BulkUpsertRecordsets failed to get recordsets: %w", err)
+		}
+		exists := make(map[string]struct{}, len(existing.Recordsets))
+		for _, rs := range existing.Recordsets {
+			exists[recordsetKey(rs.Name, rs.Type)] = struct{}{}
+		}
+
+		// new recordsets are created in bulk, the existing ones can only be replaced one by one,
+		// as the bulk PUT replaces all recordsets of the zone
+		var created []int
+		for _, i := range valid {
+			rs := recordsets[i]
+			if _, ok := exists[recordsetKey(rs.Name, rs.Type)]; !ok {
+				created = append(created, i)
+				continue
+			}
+			if err := p.replaceRecordset(ctx, zone, rs); err != nil {
+				bulkErr.Rejected = append(bulkErr.Rejected, &RejectedRecordset{Index: i, Name: rs.Name, Type: rs.Type, Err: err})
+			}
+		}
+
+		for start := 0; start < len(created); start += chunkSize {
+			end := start + chunkSize
+			if end > len(created) {
+				end = len(created)
+			}
+			chunk := make([]Recordset, 0, end-start)
+			for _, i := range created[start:end] {
+				chunk = append(chunk, recordsets[i])
+			}
+			if err := p.CreateRecordsets(ctx, &Recordsets{Recordsets: chunk}, zone, false); err != nil {
+				for _, i := range created[start:end] {
+					bulkErr.Rejected = append(bulkErr.Rejected, &RejectedRecordset{Index: i, Name: recordsets[i].Name, Type: recordsets[i].Type, Err: err})
+				}
+			}
+		}
+	}
+
+	if len(bulkErr.Invalid) > 0 || len(bulkErr.Rejected) > 0 {
+		return &bulkErr
+	}
+	return nil
+}
+
+// replaceRecordset replaces the existing recordset of the zone
+func (p *dns) replaceRecordset(ctx context.Context, zone string, recordset Recordset) error {
+	reqbody, err := convertStructToReqBody(recordset)
+	if err != nil {
+		return fmt.Errorf("failed to generate request body: %w", err)
+	}
+
+	putURL := fmt.Sprintf("/config-dns/v2/zones/%s/names/%s/types/%s", zone, recordset.Name, recordset.Type)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, putURL, reqbody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := p.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return p.Error(resp)
+	}
+
+	return nil
+}
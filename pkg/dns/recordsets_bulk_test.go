@@ -0,0 +1,167 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDns_BulkUpsertRecordsets(t *testing.T) {
+	existingBody := `
+{
+	"metadata": {"page": 1, "pageSize": 4, "totalElements": 4, "lastPage": 1, "showAll": true},
+	"recordsets": [
+		{"name": "example.com", "type": "SOA", "ttl": 86400, "rdata": ["a1-1.akam.net. hostmaster.example.com. 1 3600 600 604800 300"]},
+		{"name": "example.com", "type": "NS", "ttl": 86400, "rdata": ["a1-1.akam.net."]},
+		{"name": "www.example.com", "type": "A", "ttl": 300, "rdata": ["10.0.0.1"]},
+		{"name": "cdn.example.com", "type": "AKAMAICDN", "ttl": 300, "rdata": ["www.example.com.edgekey.net"]}
+	]
+}`
+
+	tests := map[string]struct {
+		recordsets       []Recordset
+		opts             BulkUpsertRecordsetsOptions
+		createStatus     int
+		replaceStatus    int
+		expectedCreated  [][]string
+		expectedReplaced []string
+		expectedInvalid  []int
+		expectedRejected []int
+		withError        error
+	}{
+		"new recordsets are created in chunks and existing ones replaced": {
+			recordsets: []Recordset{
+				{Name: "www.example.com", Type: "A", TTL: 600, Rdata: []string{"10.0.0.2"}},
+				{Name: "a.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.1.1"}},
+				{Name: "b.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.1.2"}},
+				{Name: "c.example.com", Type: "TXT", TTL: 300, Rdata: []string{`"hello"`}},
+			},
+			opts:             BulkUpsertRecordsetsOptions{ChunkSize: 2},
+			createStatus:     http.StatusNoContent,
+			replaceStatus:    http.StatusOK,
+			expectedCreated:  [][]string{{"a.example.com", "b.example.com"}, {"c.example.com"}},
+			expectedReplaced: []string{"/config-dns/v2/zones/example.com/names/www.example.com/types/A"},
+		},
+		"mixed valid and invalid batch": {
+			recordsets: []Recordset{
+				{Name: "a.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.1.1"}},
+				{Name: "b.example.com", Type: "A", TTL: 300, Rdata: []string{"not an ip"}},
+				{Name: "c.example.com", Type: "FOO", TTL: 300, Rdata: []string{"foo"}},
+				{Name: "d.example.com", Type: "A", TTL: 1, Rdata: []string{"10.0.1.4"}},
+				{Name: "e.example.com", Type: "AAAA", TTL: 300, Rdata: []string{"2001:db8::1"}},
+			},
+			createStatus:    http.StatusNoContent,
+			expectedCreated: [][]string{{"a.example.com", "e.example.com"}},
+			expectedInvalid: []int{1, 2, 3},
+			withError:       ErrStructValidation,
+		},
+		"AKAMAICDN outside of zone apex": {
+			recordsets: []Recordset{
+				{Name: "cdn.example.com", Type: "AKAMAICDN", TTL: 300, Rdata: []string{"www.example.com.edgekey.net"}},
+				{Name: "new.example.com", Type: "AKAMAICDN", TTL: 300, Rdata: []string{"www.example.com.edgekey.net"}},
+				{Name: "example.com", Type: "AKAMAICDN", TTL: 300, Rdata: []string{"www.example.com.edgekey.net"}},
+			},
+			createStatus:    http.StatusNoContent,
+			expectedCreated: [][]string{{"example.com"}},
+			expectedInvalid: []int{0, 1},
+			withError:       ErrStructValidation,
+		},
+		"rejected chunk and replace": {
+			recordsets: []Recordset{
+				{Name: "www.example.com", Type: "A", TTL: 600, Rdata: []string{"10.0.0.2"}},
+				{Name: "a.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.1.1"}},
+				{Name: "b.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.1.2"}},
+			},
+			createStatus:     http.StatusBadRequest,
+			replaceStatus:    http.StatusInternalServerError,
+			expectedCreated:  [][]string{{"a.example.com", "b.example.com"}},
+			expectedReplaced: []string{"/config-dns/v2/zones/example.com/names/www.example.com/types/A"},
+			expectedRejected: []int{0, 1, 2},
+		},
+		"empty list": {
+			withError: ErrStructValidation,
+		},
+		"negative chunk size": {
+			recordsets: []Recordset{{Name: "a.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.1.1"}}},
+			opts:       BulkUpsertRecordsetsOptions{ChunkSize: -1},
+			withError:  ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			var created [][]string
+			var replaced []string
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				switch {
+				case r.Method == http.MethodGet && r.URL.String() == "/config-dns/v2/zones/example.com/recordsets?showAll=true":
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(existingBody))
+					assert.NoError(t, err)
+				case r.Method == http.MethodPost && r.URL.Path == "/config-dns/v2/zones/example.com/recordsets":
+					var body Recordsets
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					names := make([]string, 0, len(body.Recordsets))
+					for _, rs := range body.Recordsets {
+						names = append(names, rs.Name)
+					}
+					created = append(created, names)
+					w.WriteHeader(test.createStatus)
+					if test.createStatus != http.StatusNoContent {
+						_, err := w.Write([]byte(`{"type": "bad_request", "title": "Bad Request", "detail": "recordset exists", "status": 400}`))
+						assert.NoError(t, err)
+					}
+				case r.Method == http.MethodPut:
+					var body Recordset
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					assert.Equal(t, 600, body.TTL)
+					replaced = append(replaced, r.URL.Path)
+					w.WriteHeader(test.replaceStatus)
+					_, err := w.Write([]byte(`{}`))
+					assert.NoError(t, err)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}))
+			client := mockAPIClient(t, mockServer)
+			err := client.BulkUpsertRecordsets(context.Background(), "example.com", test.recordsets, test.opts)
+			assert.Equal(t, test.expectedCreated, created)
+			assert.Equal(t, test.expectedReplaced, replaced)
+
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+			}
+			if test.expectedInvalid == nil && test.expectedRejected == nil {
+				if test.withError == nil {
+					require.NoError(t, err)
+				}
+				return
+			}
+			var bulkErr *BulkUpsertError
+			require.True(t, errors.As(err, &bulkErr), "want: BulkUpsertError; got: %s", err)
+			var invalid, rejected []int
+			for _, e := range bulkErr.Invalid {
+				invalid = append(invalid, e.Index)
+			}
+			for _, e := range bulkErr.Rejected {
+				rejected = append(rejected, e.Index)
+			}
+			assert.Equal(t, test.expectedInvalid, invalid)
+			assert.Equal(t, test.expectedRejected, rejected)
+			if test.expectedInvalid == nil {
+				assert.False(t, errors.Is(err, ErrStructValidation))
+			}
+		})
+	}
+}
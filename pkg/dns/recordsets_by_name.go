@@ -0,0 +1,32 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+func (p *dns) GetRecordSetsByName(ctx context.Context, zone, name string) ([]Recordset, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("GetRecordSetsByName")
+
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrStructValidation)
+	}
+
+	// search matches names containing the given one, so only recordsets with exactly the same name are returned
+	name = strings.TrimSuffix(name, ".")
+	resp, err := p.GetRecordsets(ctx, zone, RecordsetQueryArgs{Search: name, ShowAll: true})
+	if err != nil {
+		return nil, fmt.Errorf("GetRecordSetsByName failed: %w", err)
+	}
+
+	recordsets := make([]Recordset, 0, len(resp.Recordsets))
+	for _, rs := range resp.Recordsets {
+		if strings.EqualFold(strings.TrimSuffix(rs.Name, "."), name) {
+			recordsets = append(recordsets, rs)
+		}
+	}
+	return recordsets, nil
+}
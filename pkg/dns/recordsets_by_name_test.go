@@ -0,0 +1,90 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDns_GetRecordSetsByName(t *testing.T) {
+	tests := map[string]struct {
+		name             string
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedResponse []Recordset
+		withError        error
+	}{
+		"name with multiple types": {
+			name:           "www.example.com.",
+			responseStatus: http.StatusOK,
+			responseBody: `
+			{
+				"metadata": {"zone": "example.com", "page": 1, "pageSize": 25, "totalElements": 4, "showAll": true},
+				"recordsets": [
+					{"name": "www.example.com", "type": "A", "ttl": 300, "rdata": ["10.0.0.2"]},
+					{"name": "www.example.com", "type": "AAAA", "ttl": 300, "rdata": ["2001:db8::1"]},
+					{"name": "WWW.example.com", "type": "TXT", "ttl": 300, "rdata": ["\"owner=web\""]},
+					{"name": "old.www.example.com", "type": "CNAME", "ttl": 300, "rdata": ["www.example.com."]}
+				]
+			}`,
+			expectedPath: "/config-dns/v2/zones/example.com/recordsets?search=www.example.com&showAll=true",
+			expectedResponse: []Recordset{
+				{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2"}},
+				{Name: "www.example.com", Type: "AAAA", TTL: 300, Rdata: []string{"2001:db8::1"}},
+				{Name: "WWW.example.com", Type: "TXT", TTL: 300, Rdata: []string{`"owner=web"`}},
+			},
+		},
+		"name without recordsets": {
+			name:           "mail.example.com",
+			responseStatus: http.StatusOK,
+			responseBody: `
+			{
+				"metadata": {"zone": "example.com", "page": 1, "pageSize": 25, "totalElements": 0, "showAll": true},
+				"recordsets": []
+			}`,
+			expectedPath:     "/config-dns/v2/zones/example.com/recordsets?search=mail.example.com&showAll=true",
+			expectedResponse: []Recordset{},
+		},
+		"empty name": {
+			withError: ErrStructValidation,
+		},
+		"404 zone not found": {
+			name:           "www.example.com",
+			responseStatus: http.StatusNotFound,
+			responseBody: `
+			{
+				"type": "https://problems.luna.akamaiapis.net/authoritative-dns/notFound",
+				"title": "Not Found",
+				"status": 404
+			}`,
+			expectedPath: "/config-dns/v2/zones/example.com/recordsets?search=www.example.com&showAll=true",
+			withError:    ErrNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.GetRecordSetsByName(context.Background(), "example.com", test.name)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
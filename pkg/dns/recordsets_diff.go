@@ -0,0 +1,81 @@
+package dns
+
+import (
+	"sort"
+	"strings"
+)
+
+// RecordSetDiff contains the changes which turn live recordsets into the desired ones
+type RecordSetDiff struct {
+	// Creates are the desired recordsets which do not exist
+	Creates []Recordset
+	// Updates are the desired recordsets which exist, but their TTL or rdata differ
+	Updates []Recordset
+	// Deletes are the live recordsets which are not desired
+	Deletes []Recordset
+}
+
+// IsEmpty reports whether there are no changes
+func (d RecordSetDiff) IsEmpty() bool {
+	return len(d.Creates) == 0 && len(d.Updates) == 0 && len(d.Deletes) == 0
+}
+
+// DiffRecordSets compares the desired recordsets with the live ones, e.g. to plan changes of a zone kept in a repository.
+// Recordsets are matched by name and type, ignoring case and a trailing dot of the name. The order of rdata values
+// does not matter. A desired TTL of 0 matches any live TTL, and updates keep the live TTL in that case.
+// Each of the lists in the result is sorted by name and type.
+func DiffRecordSets(desired, live []Recordset) RecordSetDiff {
+	liveByKey := make(map[string]Recordset, len(live))
+	for _, rs := range live {
+		liveByKey[recordsetKey(rs.Name, rs.Type)] = rs
+	}
+
+	var diff RecordSetDiff
+	seen := make(map[string]struct{}, len(desired))
+	for _, rs := range desired {
+		key := recordsetKey(rs.Name, rs.Type)
+		seen[key] = struct{}{}
+		liveRs, ok := liveByKey[key]
+		if !ok {
+			diff.Creates = append(diff.Creates, rs)
+			continue
+		}
+		if rs.TTL == 0 {
+			rs.TTL = liveRs.TTL
+		}
+		if rs.TTL != liveRs.TTL || !equalRdata(rs.Rdata, liveRs.Rdata) {
+			diff.Updates = append(diff.Updates, rs)
+		}
+	}
+	for _, rs := range live {
+		if _, ok := seen[recordsetKey(rs.Name, rs.Type)]; !ok {
+			diff.Deletes = append(diff.Deletes, rs)
+		}
+	}
+
+	for _, list := range [][]Recordset{diff.Creates, diff.Updates, diff.Deletes} {
+		sort.SliceStable(list, func(i, j int) bool {
+			return recordsetKey(list[i].Name, list[i].Type) < recordsetKey(list[j].Name, list[j].Type)
+		})
+	}
+	return diff
+}
+
+// equalRdata reports whether both lists contain the same values, regardless of their order
+func equalRdata(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[string]int, len(a))
+	for _, v := range a {
+		counts[strings.TrimSpace(v)]++
+	}
+	for _, v := range b {
+		v = strings.TrimSpace(v)
+		if counts[v] == 0 {
+			return false
+		}
+		counts[v]--
+	}
+	return true
+}
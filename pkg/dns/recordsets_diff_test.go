@@ -0,0 +1,91 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffRecordSets(t *testing.T) {
+	live := []Recordset{
+		{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2", "10.0.0.3"}},
+		{Name: "example.com", Type: "MX", TTL: 600, Rdata: []string{"10 mail.example.com."}},
+		{Name: "old.example.com", Type: "CNAME", TTL: 300, Rdata: []string{"www.example.com."}},
+	}
+
+	tests := map[string]struct {
+		desired  []Recordset
+		live     []Recordset
+		expected RecordSetDiff
+	}{
+		"no changes with rdata in different order": {
+			desired: []Recordset{
+				{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.3", "10.0.0.2"}},
+				{Name: "example.com", Type: "MX", TTL: 600, Rdata: []string{"10 mail.example.com."}},
+				{Name: "old.example.com", Type: "CNAME", TTL: 300, Rdata: []string{"www.example.com."}},
+			},
+			live: live,
+		},
+		"added record": {
+			desired: append([]Recordset{
+				{Name: "v6.example.com", Type: "AAAA", TTL: 300, Rdata: []string{"2001:db8::1"}},
+			}, live...),
+			live: live,
+			expected: RecordSetDiff{
+				Creates: []Recordset{{Name: "v6.example.com", Type: "AAAA", TTL: 300, Rdata: []string{"2001:db8::1"}}},
+			},
+		},
+		"changed TTL": {
+			desired: []Recordset{
+				{Name: "www.example.com", Type: "A", TTL: 60, Rdata: []string{"10.0.0.2", "10.0.0.3"}},
+				{Name: "example.com", Type: "MX", TTL: 600, Rdata: []string{"10 mail.example.com."}},
+				{Name: "old.example.com", Type: "CNAME", TTL: 300, Rdata: []string{"www.example.com."}},
+			},
+			live: live,
+			expected: RecordSetDiff{
+				Updates: []Recordset{{Name: "www.example.com", Type: "A", TTL: 60, Rdata: []string{"10.0.0.2", "10.0.0.3"}}},
+			},
+		},
+		"unset TTL keeps live TTL": {
+			desired: []Recordset{
+				{Name: "www.example.com", Type: "A", Rdata: []string{"10.0.0.2"}},
+				{Name: "example.com", Type: "MX", Rdata: []string{"10 mail.example.com."}},
+				{Name: "old.example.com", Type: "CNAME", TTL: 300, Rdata: []string{"www.example.com."}},
+			},
+			live: live,
+			expected: RecordSetDiff{
+				Updates: []Recordset{{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2"}}},
+			},
+		},
+		"trailing dot and case of names are normalized": {
+			desired: []Recordset{
+				{Name: "WWW.example.com.", Type: "a", TTL: 300, Rdata: []string{"10.0.0.2", "10.0.0.3"}},
+				{Name: "example.com.", Type: "MX", TTL: 600, Rdata: []string{"10 mail.example.com."}},
+			},
+			live: live,
+			expected: RecordSetDiff{
+				Deletes: []Recordset{{Name: "old.example.com", Type: "CNAME", TTL: 300, Rdata: []string{"www.example.com."}}},
+			},
+		},
+		"results are sorted": {
+			desired: []Recordset{
+				{Name: "b.example.com", Type: "TXT", TTL: 300, Rdata: []string{`"b"`}},
+				{Name: "a.example.com", Type: "TXT", TTL: 300, Rdata: []string{`"a"`}},
+			},
+			expected: RecordSetDiff{
+				Creates: []Recordset{
+					{Name: "a.example.com", Type: "TXT", TTL: 300, Rdata: []string{`"a"`}},
+					{Name: "b.example.com", Type: "TXT", TTL: 300, Rdata: []string{`"b"`}},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diff := DiffRecordSets(test.desired, test.live)
+			assert.Equal(t, test.expected, diff)
+			assert.Equal(t, test.expected.IsEmpty(), diff.IsEmpty())
+		})
+	}
+}
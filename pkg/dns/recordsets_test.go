@@ -67,6 +67,83 @@ func TestDns_GetRecordsets(t *testing.T) {
 				},
 			},
 		},
+		"200 OK - filtered by type and name": {
+			zone:           "example.com",
+			args:           []RecordsetQueryArgs{{Search: "mail", Types: "mx, txt"}},
+			responseStatus: http.StatusOK,
+			responseBody: `
+			{
+				"metadata": {
+					"zone": "example.com",
+					"page": 1,
+					"pageSize": 25,
+					"lastPage": 1,
+					"totalElements": 2,
+					"types": ["MX", "TXT"]
+				},
+				"recordsets": [
+					{"name": "mail.example.com", "type": "MX", "ttl": 300, "rdata": ["10 mail.example.com."]},
+					{"name": "mail.example.com", "type": "TXT", "ttl": 300, "rdata": ["\"v=spf1 -all\""]}
+				]
+			}`,
+			expectedPath: "/config-dns/v2/zones/example.com/recordsets?search=mail&showAll=false&types=MX%2CTXT",
+			expectedResponse: &RecordSetResponse{
+				Metadata: MetadataH{
+					LastPage:      1,
+					Page:          1,
+					PageSize:      25,
+					TotalElements: 2,
+				},
+				Recordsets: []Recordset{
+					{Name: "mail.example.com", Type: "MX", TTL: 300, Rdata: []string{"10 mail.example.com."}},
+					{Name: "mail.example.com", Type: "TXT", TTL: 300, Rdata: []string{`"v=spf1 -all"`}},
+				},
+			},
+		},
+		"200 OK - second page": {
+			zone:           "example.com",
+			args:           []RecordsetQueryArgs{{Page: 2, PageSize: 1, SortBy: "name"}},
+			responseStatus: http.StatusOK,
+			responseBody: `
+			{
+				"metadata": {
+					"zone": "example.com",
+					"page": 2,
+					"pageSize": 1,
+					"lastPage": 3,
+					"totalElements": 3
+				},
+				"recordsets": [
+					{"name": "b.example.com", "type": "A", "ttl": 300, "rdata": ["10.0.0.2"]}
+				]
+			}`,
+			expectedPath: "/config-dns/v2/zones/example.com/recordsets?page=2&pageSize=1&showAll=false&sortBy=name",
+			expectedResponse: &RecordSetResponse{
+				Metadata: MetadataH{
+					LastPage:      3,
+					Page:          2,
+					PageSize:      1,
+					TotalElements: 3,
+				},
+				Recordsets: []Recordset{
+					{Name: "b.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2"}},
+				},
+			},
+		},
+		"unsupported type filter": {
+			zone:      "example.com",
+			args:      []RecordsetQueryArgs{{Types: "A,FOO"}},
+			withError: ErrStructValidation,
+		},
+		"negative page": {
+			zone:      "example.com",
+			args:      []RecordsetQueryArgs{{Page: -1}},
+			withError: ErrStructValidation,
+		},
+		"invalid zone name": {
+			zone:      "example..com",
+			withError: ErrStructValidation,
+		},
 		"500 internal server error": {
 			zone:           "example.com",
 			args:           []RecordsetQueryArgs{},
@@ -0,0 +1,184 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// UpsertRecordsetsOptions contains options of UpsertRecordsets
+type UpsertRecordsetsOptions struct {
+	// Overwrite replaces a pending change list of the zone. By default, only a stale change list is replaced
+	// and UpsertRecordsets fails if the zone has a change list which is up to date.
+	Overwrite bool
+	// TrailingDots normalizes names and host name targets of the recordsets before they are validated
+	TrailingDots TrailingDots
+}
+
+func (p *dns) UpsertRecordsets(ctx context.Context, zone string, recordsets []Recordset, opts UpsertRecordsetsOptions) error {
+	// This lock will restrict the concurrency of API calls
+	// to 1 save request at a time. This is needed for the Soa.Serial value which
+	// is required to be incremented for every subsequent update to a zone
+	// so we have to save just one request at a time to ensure this is always
+	// incremented properly
+	zoneRecordsetsWriteLock.Lock()
+	defer zoneRecordsetsWriteLock.Unlock()
+
+	logger := p.Log(ctx)
+	logger.Debug("UpsertRecordsets")
+
+	if err := validateZoneName(zone); err != nil {
+		return err
+	}
+	if len(recordsets) == 0 {
+		return fmt.Errorf("%w: recordsets list is empty", ErrStructValidation)
+	}
+	recordsets, err := normalizeRecordsets(zone, recordsets, opts.TrailingDots)
+	if err != nil {
+		return err
+	}
+	if err := validateRecordsets(recordsets); err != nil {
+		return err
+	}
+	if err := validateApexAliases(zone, recordsets); err != nil {
+		return err
+	}
+
+	if _, err := p.CreateChangeList(ctx, zone, opts.Overwrite); err != nil {
+		return fmt.Errorf("UpsertRecordsets failed to create change list: %w", err)
+	}
+
+	if err := p.upsertChanges(ctx, zone, recordsets); err != nil {
+		if discardErr := p.DiscardChangeList(ctx, zone); discardErr != nil {
+			logger.Errorf("UpsertRecordsets failed to discard change list: %s", discardErr)
+		}
+		return fmt.Errorf("UpsertRecordsets failed to update change list: %w", err)
+	}
+
+	if err := p.SubmitChangeList(ctx, zone); err != nil {
+		if discardErr := p.DiscardChangeList(ctx, zone); discardErr != nil {
+			logger.Errorf("UpsertRecordsets failed to discard change list: %s", discardErr)
+		}
+		return fmt.Errorf("UpsertRecordsets failed to submit change list: %w", err)
+	}
+
+	return nil
+}
+
+// SetRecordSetOptions contains options of SetRecordSet
+type SetRecordSetOptions struct {
+	// ReuseChangeList adds the change to a pending change list of the zone, which is then submitted along
+	// with the change. By default, SetRecordSet fails with ErrChangeListExists if the zone has a pending change list.
+	ReuseChangeList bool
+	// TrailingDots normalizes name and host name targets of the recordset before it is validated
+	TrailingDots TrailingDots
+}
+
+func (p *dns) SetRecordSet(ctx context.Context, zone string, recordset Recordset, opts SetRecordSetOptions) (*ZoneResponse, error) {
+	// Changes to the zone are serialized, same as in UpsertRecordsets
+	zoneRecordsetsWriteLock.Lock()
+	defer zoneRecordsetsWriteLock.Unlock()
+
+	logger := p.Log(ctx)
+	logger.Debug("SetRecordSet")
+
+	if err := validateZoneName(zone); err != nil {
+		return nil, err
+	}
+	recordset, err := NormalizeRecordset(zone, recordset, opts.TrailingDots)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateChange(zone, ChangeOpAdd, recordset); err != nil {
+		return nil, err
+	}
+
+	var created bool
+	changelist, err := p.GetChangeList(ctx, zone)
+	switch {
+	case errors.Is(err, ErrNotFound), err == nil && changelist.Stale:
+		if _, err := p.CreateChangeList(ctx, zone, false); err != nil {
+			return nil, fmt.Errorf("SetRecordSet failed to create change list: %w", err)
+		}
+		created = true
+	case err != nil:
+		return nil, fmt.Errorf("SetRecordSet failed to get change list: %w", err)
+	case !opts.ReuseChangeList:
+		return nil, fmt.Errorf("%w: zone %q", ErrChangeListExists, zone)
+	}
+
+	// only the change list created here is discarded, a reused one is left for its owner
+	discard := func() {
+		if !created {
+			return
+		}
+		if discardErr := p.DiscardChangeList(ctx, zone); discardErr != nil {
+			logger.Errorf("SetRecordSet failed to discard change list: %s", discardErr)
+		}
+	}
+
+	if err := p.upsertChanges(ctx, zone, []Recordset{recordset}); err != nil {
+		discard()
+		return nil, fmt.Errorf("SetRecordSet failed to update change list: %w", err)
+	}
+	if err := p.SubmitChangeList(ctx, zone); err != nil {
+		discard()
+		return nil, fmt.Errorf("SetRecordSet failed to submit change list: %w", err)
+	}
+
+	zoneResp, err := p.GetZone(ctx, zone)
+	if err != nil {
+		return nil, fmt.Errorf("SetRecordSet failed to get zone: %w", err)
+	}
+	return zoneResp, nil
+}
+
+// upsertChanges adds the recordsets to the change list of the zone, editing the ones which already exist
+func (p *dns) upsertChanges(ctx context.Context, zone string, recordsets []Recordset) error {
+	existing, err := p.getChangeListRecordsets(ctx, zone)
+	if err != nil {
+		return fmt.Errorf("failed to get change list recordsets: %w", err)
+	}
+	exists := make(map[string]struct{}, len(existing))
+	for _, rs := range existing {
+		exists[recordsetKey(rs.Name, rs.Type)] = struct{}{}
+	}
+
+	for _, rs := range recordsets {
+		op := ChangeOpAdd
+		if _, ok := exists[recordsetKey(rs.Name, rs.Type)]; ok {
+			op = ChangeOpEdit
+		}
+		if err := p.AddRecordSetToChangeList(ctx, zone, op, rs); err != nil {
+			return fmt.Errorf("failed to add %s %s to change list: %w", rs.Name, rs.Type, err)
+		}
+	}
+	return nil
+}
+
+// recordsetKey identifies a recordset by its name and type
+func recordsetKey(name, recordType string) string {
+	return strings.ToLower(strings.TrimSuffix(name, ".")) + " " + strings.ToUpper(recordType)
+}
+
+func (p *dns) getChangeListRecordsets(ctx context.Context, zone string) ([]Recordset, error) {
+	getURL := fmt.Sprintf("/config-dns/v2/changelists/%s/recordsets?showAll=true", zone)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var rval RecordSetResponse
+	resp, err := p.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, p.Error(resp)
+	}
+
+	return rval.Recordsets, nil
+}
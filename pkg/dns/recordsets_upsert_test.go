@@ -0,0 +1,284 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDns_UpsertRecordsets(t *testing.T) {
+	changeListRecordsets := `
+{
+	"recordsets": [
+		{"name": "example.com", "type": "SOA", "ttl": 86400, "rdata": ["a1-1.akam.net. hostmaster.example.com. 2023010101 3600 600 604800 300"]},
+		{"name": "www.example.com", "type": "A", "ttl": 300, "rdata": ["10.0.0.2"]}
+	]
+}`
+	recordsets := []Recordset{
+		{Name: "www.example.com", Type: "A", TTL: 600, Rdata: []string{"10.0.0.2", "10.0.0.3"}},
+		{Name: "v6.example.com", Type: "aaaa", TTL: 300, Rdata: []string{"2001:db8::1"}},
+	}
+
+	tests := map[string]struct {
+		recordsets      []Recordset
+		opts            UpsertRecordsetsOptions
+		addChangeStatus int
+		expectedCalls   []string
+		expectedChanges []recordsetChange
+		withError       func(*testing.T, error)
+	}{
+		"atomic apply": {
+			recordsets:      recordsets,
+			addChangeStatus: http.StatusNoContent,
+			expectedCalls: []string{
+				"POST /config-dns/v2/changelists?overwrite=stale&zone=example.com",
+				"GET /config-dns/v2/changelists/example.com/recordsets?showAll=true",
+				"POST /config-dns/v2/changelists/example.com/recordsets/add-change",
+				"POST /config-dns/v2/changelists/example.com/recordsets/add-change",
+				"POST /config-dns/v2/changelists/example.com/submit",
+			},
+			expectedChanges: []recordsetChange{
+				{Name: "www.example.com", Type: "A", Op: "EDIT", TTL: 600, Rdata: []string{"10.0.0.2", "10.0.0.3"}},
+				{Name: "v6.example.com", Type: "AAAA", Op: "ADD", TTL: 300, Rdata: []string{"2001:db8::1"}},
+			},
+		},
+		"overwrite pending change list": {
+			recordsets:      recordsets[:1],
+			opts:            UpsertRecordsetsOptions{Overwrite: true},
+			addChangeStatus: http.StatusNoContent,
+			expectedCalls: []string{
+				"POST /config-dns/v2/changelists?overwrite=any&zone=example.com",
+				"GET /config-dns/v2/changelists/example.com/recordsets?showAll=true",
+				"POST /config-dns/v2/changelists/example.com/recordsets/add-change",
+				"POST /config-dns/v2/changelists/example.com/submit",
+			},
+			expectedChanges: []recordsetChange{
+				{Name: "www.example.com", Type: "A", Op: "EDIT", TTL: 600, Rdata: []string{"10.0.0.2", "10.0.0.3"}},
+			},
+		},
+		"mixed valid and invalid batch": {
+			recordsets: []Recordset{
+				{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2"}},
+				{Name: "bad.example.com", Type: "A", TTL: 300, Rdata: []string{"not-an-ip"}},
+				{Name: "v6.example.com", Type: "AAAA", TTL: 300, Rdata: []string{"2001:db8::1"}},
+				{Name: "unknown.example.com", Type: "FOO", TTL: 300, Rdata: []string{"foo"}},
+				{Name: "nottl.example.com", Type: "CNAME", Rdata: []string{"www.example.com."}},
+			},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				var recordsetErrs RecordsetErrors
+				require.True(t, errors.As(err, &recordsetErrs))
+				require.Len(t, recordsetErrs, 3)
+				assert.Equal(t, 1, recordsetErrs[0].Index)
+				assert.Equal(t, "Rdata[0]", recordsetErrs[0].Field)
+				assert.Equal(t, 3, recordsetErrs[1].Index)
+				assert.Equal(t, "Type", recordsetErrs[1].Field)
+				assert.Equal(t, 4, recordsetErrs[2].Index)
+				assert.Equal(t, "TTL", recordsetErrs[2].Field)
+			},
+		},
+		"ambiguous target in strict mode": {
+			recordsets: []Recordset{
+				{Name: "www.example.com.", Type: "CNAME", TTL: 300, Rdata: []string{"origin.example.net."}},
+				{Name: "old.example.com", Type: "CNAME", TTL: 300, Rdata: []string{"origin.example.net"}},
+			},
+			opts: UpsertRecordsetsOptions{TrailingDots: TrailingDotsStrict},
+			withError: func(t *testing.T, err error) {
+				var recordsetErrs RecordsetErrors
+				require.True(t, errors.As(err, &recordsetErrs), "want: RecordsetErrors; got: %s", err)
+				require.Len(t, recordsetErrs, 1)
+				assert.Equal(t, 1, recordsetErrs[0].Index)
+				assert.Equal(t, "Rdata[0]", recordsetErrs[0].Field)
+			},
+		},
+		"empty batch": {
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+			},
+		},
+		"failed change is discarded": {
+			recordsets:      recordsets,
+			addChangeStatus: http.StatusBadRequest,
+			expectedCalls: []string{
+				"POST /config-dns/v2/changelists?overwrite=stale&zone=example.com",
+				"GET /config-dns/v2/changelists/example.com/recordsets?showAll=true",
+				"POST /config-dns/v2/changelists/example.com/recordsets/add-change",
+				"DELETE /config-dns/v2/changelists/example.com",
+			},
+			expectedChanges: []recordsetChange{
+				{Name: "www.example.com", Type: "A", Op: "EDIT", TTL: 600, Rdata: []string{"10.0.0.2", "10.0.0.3"}},
+			},
+			withError: func(t *testing.T, err error) {
+				want := &Error{
+					Type:       "https://problems.luna.akamaiapis.net/config-dns/v2/bad-request",
+					Title:      "Bad Request",
+					Detail:     "Invalid rdata",
+					StatusCode: http.StatusBadRequest,
+				}
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			var changes []recordsetChange
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.Method+" "+r.URL.String())
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/config-dns/v2/changelists":
+					w.WriteHeader(http.StatusCreated)
+					_, err := w.Write([]byte(`{"zone": "example.com", "changeTag": "476754f4-d605-479f-853b-db854d7254fa", "stale": false}`))
+					assert.NoError(t, err)
+				case r.Method == http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(changeListRecordsets))
+					assert.NoError(t, err)
+				case r.URL.Path == "/config-dns/v2/changelists/example.com/recordsets/add-change":
+					var change recordsetChange
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&change))
+					changes = append(changes, change)
+					w.WriteHeader(test.addChangeStatus)
+					if test.addChangeStatus != http.StatusNoContent {
+						_, err := w.Write([]byte(`{"type": "https://problems.luna.akamaiapis.net/config-dns/v2/bad-request", "title": "Bad Request", "detail": "Invalid rdata", "status": 400}`))
+						assert.NoError(t, err)
+					}
+				default:
+					w.WriteHeader(http.StatusNoContent)
+				}
+			}))
+			client := mockAPIClient(t, mockServer)
+			err := client.UpsertRecordsets(context.Background(), "example.com", test.recordsets, test.opts)
+			assert.Equal(t, test.expectedCalls, calls)
+			assert.Equal(t, test.expectedChanges, changes)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDns_SetRecordSet(t *testing.T) {
+	recordset := Recordset{Name: "www.example.com", Type: "A", TTL: 600, Rdata: []string{"10.0.0.3"}}
+	pendingChangeList := `{"zone": "example.com", "changeTag": "476754f4-d605-479f-853b-db854d7254fa", "stale": false}`
+	changeListNotFound := `{"type": "https://problems.luna.akamaiapis.net/config-dns/v2/not-found", "title": "Not Found", "detail": "Change list for zone example.com not found", "status": 404}`
+
+	tests := map[string]struct {
+		opts             SetRecordSetOptions
+		changeListStatus int
+		changeListBody   string
+		expectedCalls    []string
+		expectedChanges  []recordsetChange
+		withError        func(*testing.T, error)
+	}{
+		"create and submit": {
+			changeListStatus: http.StatusNotFound,
+			changeListBody:   changeListNotFound,
+			expectedCalls: []string{
+				"GET /config-dns/v2/changelists/example.com",
+				"POST /config-dns/v2/changelists?overwrite=stale&zone=example.com",
+				"GET /config-dns/v2/changelists/example.com/recordsets?showAll=true",
+				"POST /config-dns/v2/changelists/example.com/recordsets/add-change",
+				"POST /config-dns/v2/changelists/example.com/submit",
+				"GET /config-dns/v2/zones/example.com",
+			},
+			expectedChanges: []recordsetChange{
+				{Name: "www.example.com", Type: "A", Op: "EDIT", TTL: 600, Rdata: []string{"10.0.0.3"}},
+			},
+		},
+		"existing change list conflict": {
+			changeListStatus: http.StatusOK,
+			changeListBody:   pendingChangeList,
+			expectedCalls: []string{
+				"GET /config-dns/v2/changelists/example.com",
+			},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrChangeListExists), "want: %s; got: %s", ErrChangeListExists, err)
+			},
+		},
+		"reuse existing change list": {
+			opts:             SetRecordSetOptions{ReuseChangeList: true},
+			changeListStatus: http.StatusOK,
+			changeListBody:   pendingChangeList,
+			expectedCalls: []string{
+				"GET /config-dns/v2/changelists/example.com",
+				"GET /config-dns/v2/changelists/example.com/recordsets?showAll=true",
+				"POST /config-dns/v2/changelists/example.com/recordsets/add-change",
+				"POST /config-dns/v2/changelists/example.com/submit",
+				"GET /config-dns/v2/zones/example.com",
+			},
+			expectedChanges: []recordsetChange{
+				{Name: "www.example.com", Type: "A", Op: "EDIT", TTL: 600, Rdata: []string{"10.0.0.3"}},
+			},
+		},
+		"stale change list is replaced": {
+			changeListStatus: http.StatusOK,
+			changeListBody:   `{"zone": "example.com", "changeTag": "476754f4-d605-479f-853b-db854d7254fa", "stale": true}`,
+			expectedCalls: []string{
+				"GET /config-dns/v2/changelists/example.com",
+				"POST /config-dns/v2/changelists?overwrite=stale&zone=example.com",
+				"GET /config-dns/v2/changelists/example.com/recordsets?showAll=true",
+				"POST /config-dns/v2/changelists/example.com/recordsets/add-change",
+				"POST /config-dns/v2/changelists/example.com/submit",
+				"GET /config-dns/v2/zones/example.com",
+			},
+			expectedChanges: []recordsetChange{
+				{Name: "www.example.com", Type: "A", Op: "EDIT", TTL: 600, Rdata: []string{"10.0.0.3"}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			var changes []recordsetChange
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.Method+" "+r.URL.String())
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/config-dns/v2/changelists/example.com":
+					w.WriteHeader(test.changeListStatus)
+					_, err := w.Write([]byte(test.changeListBody))
+					assert.NoError(t, err)
+				case r.Method == http.MethodGet && r.URL.Path == "/config-dns/v2/zones/example.com":
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(`{"zone": "example.com", "type": "PRIMARY", "activationState": "PENDING"}`))
+					assert.NoError(t, err)
+				case r.Method == http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(`{"recordsets": [{"name": "www.example.com", "type": "A", "ttl": 300, "rdata": ["10.0.0.2"]}]}`))
+					assert.NoError(t, err)
+				case r.URL.Path == "/config-dns/v2/changelists":
+					w.WriteHeader(http.StatusCreated)
+					_, err := w.Write([]byte(pendingChangeList))
+					assert.NoError(t, err)
+				case r.URL.Path == "/config-dns/v2/changelists/example.com/recordsets/add-change":
+					var change recordsetChange
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&change))
+					changes = append(changes, change)
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					w.WriteHeader(http.StatusNoContent)
+				}
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.SetRecordSet(context.Background(), "example.com", recordset, test.opts)
+			assert.Equal(t, test.expectedCalls, calls)
+			assert.Equal(t, test.expectedChanges, changes)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "PENDING", result.ActivationState)
+		})
+	}
+}
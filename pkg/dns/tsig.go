@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
 
 	"reflect"
 	"strings"
@@ -14,6 +15,16 @@ import (
 
 var (
 	tsigWriteLock sync.Mutex
+
+	// tsigAlgorithms are the TSIG key algorithms supported by Edge DNS
+	tsigAlgorithms = []string{
+		"hmac-md5.sig-alg.reg.int",
+		"hmac-sha1",
+		"hmac-sha224",
+		"hmac-sha256",
+		"hmac-sha384",
+		"hmac-sha512",
+	}
 )
 
 type (
@@ -27,7 +38,7 @@ type (
 		//
 		// See: https://techdocs.akamai.com/edge-dns/reference/get-keys
 		ListTsigKeys(context.Context, *TSIGQueryString) (*TSIGReportResponse, error)
-		// GetTsigKeyZones retrieves DNS Zones using tsig key.
+		// GetTsigKeyZones retrieves DNS Zones using tsig key. The key is validated as in UpdateTsigKey.
 		//
 		// See: https://techdocs.akamai.com/edge-dns/reference/post-keys-used-by
 		GetTsigKeyZones(context.Context, *TSIGKey) (*ZoneNameListResponse, error)
@@ -47,7 +58,8 @@ type (
 		//
 		// See: https://techdocs.akamai.com/edge-dns/reference/delete-zones-zone-key
 		DeleteTsigKey(context.Context, string) error
-		// UpdateTsigKey updates tsig key for zone.
+		// UpdateTsigKey updates tsig key for zone. The key is rejected with ErrStructValidation
+		// if its algorithm is not supported or its secret is not base64 encoded.
 		//
 		// See: https://techdocs.akamai.com/edge-dns/reference/put-zones-zone-key
 		UpdateTsigKey(context.Context, *TSIGKey, string) error
@@ -100,16 +112,30 @@ type (
 	}
 )
 
-// Validate validates RecordBody
+// Validate validates TSIGKey
 func (key *TSIGKey) Validate() error {
 
 	return validation.Errors{
 		"Name":      validation.Validate(key.Name, validation.Required),
-		"Algorithm": validation.Validate(key.Algorithm, validation.Required),
-		"Secret":    validation.Validate(key.Secret, validation.Required),
+		"Algorithm": validation.Validate(key.Algorithm, validation.Required, validation.By(validateTSIGAlgorithm)),
+		"Secret":    validation.Validate(key.Secret, validation.Required, is.Base64.Error("must be a base64 encoded string")),
 	}.Filter()
 }
 
+// validateTSIGAlgorithm checks if the algorithm is one of tsigAlgorithms, ignoring case
+func validateTSIGAlgorithm(value interface{}) error {
+	algorithm, ok := value.(string)
+	if !ok || algorithm == "" {
+		return nil
+	}
+	for _, a := range tsigAlgorithms {
+		if strings.EqualFold(a, algorithm) {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported algorithm %q, supported algorithms are: %s", algorithm, strings.Join(tsigAlgorithms, ", "))
+}
+
 // Validate validates TSIGKeyBulkPost
 func (bulk *TSIGKeyBulkPost) Validate() error {
 	return validation.Errors{
@@ -218,7 +244,7 @@ func (p *dns) GetTsigKeyZones(ctx context.Context, tsigKey *TSIGKey) (*ZoneNameL
 	logger.Debug("GetTsigKeyZones")
 
 	if err := tsigKey.Validate(); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %s", ErrStructValidation, err)
 	}
 
 	reqbody, err := convertStructToReqBody(tsigKey)
@@ -276,7 +302,7 @@ func (p *dns) TsigKeyBulkUpdate(ctx context.Context, tsigBulk *TSIGKeyBulkPost)
 	logger.Debug("TsigKeyBulkUpdate")
 
 	if err := tsigBulk.Validate(); err != nil {
-		return err
+		return fmt.Errorf("%w: %s", ErrStructValidation, err)
 	}
 
 	reqbody, err := convertStructToReqBody(tsigBulk)
@@ -307,6 +333,10 @@ func (p *dns) GetTsigKey(ctx context.Context, zone string) (*TSIGKeyResponse, er
 	logger := p.Log(ctx)
 	logger.Debug("GetTsigKey")
 
+	if err := validateZoneName(zone); err != nil {
+		return nil, err
+	}
+
 	var zonekey TSIGKeyResponse
 	getURL := fmt.Sprintf("/config-dns/v2/zones/%s/key", zone)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
@@ -354,9 +384,12 @@ func (p *dns) UpdateTsigKey(ctx context.Context, tsigKey *TSIGKey, zone string)
 	logger := p.Log(ctx)
 	logger.Debug("UpdateTsigKey")
 
-	if err := tsigKey.Validate(); err != nil {
+	if err := validateZoneName(zone); err != nil {
 		return err
 	}
+	if err := tsigKey.Validate(); err != nil {
+		return fmt.Errorf("%w: %s", ErrStructValidation, err)
+	}
 
 	reqbody, err := convertStructToReqBody(tsigKey)
 	if err != nil {
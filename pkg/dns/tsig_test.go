@@ -136,6 +136,14 @@ func TestDns_GetTsigKeyZones(t *testing.T) {
 				Zones: []string{"river.com", "stream.com"},
 			},
 		},
+		"malformed secret": {
+			key: TSIGKey{
+				Name:      "example.com.akamai.com.",
+				Algorithm: "hmac-sha512",
+				Secret:    "not base64!",
+			},
+			withError: ErrStructValidation,
+		},
 		"500 internal server error": {
 			key: TSIGKey{
 				Name:      "example.com.akamai.com.",
@@ -350,6 +358,10 @@ func TestDns_GetTsigKey(t *testing.T) {
 				ZoneCount: 7,
 			},
 		},
+		"invalid zone name": {
+			zone:      "example..com",
+			withError: ErrStructValidation,
+		},
 		"500 internal server error": {
 			zone:           "example.com",
 			responseStatus: http.StatusInternalServerError,
@@ -467,6 +479,43 @@ func TestDns_UpdateTsigKey(t *testing.T) {
 			responseStatus: http.StatusNoContent,
 			expectedPath:   "/config-dns/v2/zones/example.com/key",
 		},
+		"204 No Content - algorithm in upper case": {
+			key: TSIGKey{
+				Name:      "example.com.akamai.com.",
+				Algorithm: "HMAC-MD5.SIG-ALG.REG.INT",
+				Secret:    "bWQ1c2VjcmV0",
+			},
+			zone:           "example.com",
+			responseStatus: http.StatusNoContent,
+			expectedPath:   "/config-dns/v2/zones/example.com/key",
+		},
+		"unsupported algorithm": {
+			key: TSIGKey{
+				Name:      "example.com.akamai.com.",
+				Algorithm: "hmac-sha3",
+				Secret:    "Ok1qR5IW1ajVka5cHPEJQIXfLyx5V3PSkFBROAzOn21JumDq6nIpoj6H8rfj5Uo+Ok55ZWQ0Wgrf302fDscHLw==",
+			},
+			zone:      "example.com",
+			withError: ErrStructValidation,
+		},
+		"malformed secret": {
+			key: TSIGKey{
+				Name:      "example.com.akamai.com.",
+				Algorithm: "hmac-sha256",
+				Secret:    "Ok1qR5IW1ajVka5cHPEJQ",
+			},
+			zone:      "example.com",
+			withError: ErrStructValidation,
+		},
+		"invalid zone name": {
+			key: TSIGKey{
+				Name:      "example.com.akamai.com.",
+				Algorithm: "hmac-sha512",
+				Secret:    "Ok1qR5IW1ajVka5cHPEJQIXfLyx5V3PSkFBROAzOn21JumDq6nIpoj6H8rfj5Uo+Ok55ZWQ0Wgrf302fDscHLw==",
+			},
+			zone:      "",
+			withError: ErrStructValidation,
+		},
 		"500 internal server error": {
 			key: TSIGKey{
 				Name:      "example.com.akamai.com.",
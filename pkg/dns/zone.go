@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 var (
@@ -22,7 +23,8 @@ var (
 type (
 	// Zones contains operations available on Zone resources.
 	Zones interface {
-		// ListZones retrieves a list of all zones user can access.
+		// ListZones retrieves a list of all zones user can access. The list can be filtered, e.g. by contracts
+		// and zone types, and paginated with ZoneListQueryArgs.
 		//
 		// See: https://techdocs.akamai.com/edge-dns/reference/get-zones
 		ListZones(context.Context, ...ZoneListQueryArgs) (*ZoneListResponse, error)
@@ -34,10 +36,32 @@ type (
 		NewChangeListResponse(context.Context, string) *ChangeListResponse
 		// NewZoneQueryString returns a new ZoneQueryString object.
 		NewZoneQueryString(context.Context, string, string) *ZoneQueryString
-		// GetZone retrieves Zone metadata.
+		// GetZone retrieves Zone metadata, e.g. its type, contract and activation state.
+		// The error matches ErrNotFound if the zone does not exist.
 		//
 		// See: https://techdocs.akamai.com/edge-dns/reference/get-zone
 		GetZone(context.Context, string) (*ZoneResponse, error)
+		// GetZonesStatus retrieves activation status of the zones with at most the given number of concurrent
+		// requests, e.g. to find the zones which have changes pending. Errors are reported for each zone separately.
+		//
+		// See: https://techdocs.akamai.com/edge-dns/reference/get-zone
+		GetZonesStatus(context.Context, []string, int) (map[string]ZoneStatus, error)
+		// GetZoneDNSSECStatus retrieves DNSSEC signing state of the zone along with its DNSKEY and DS records,
+		// e.g. to publish the DS record at the registrar. Signed is false for zones which are not signed.
+		//
+		// See: https://techdocs.akamai.com/edge-dns/reference/post-zones-dns-sec-status
+		GetZoneDNSSECStatus(context.Context, string) (*DNSSECStatus, error)
+		// EnableDNSSEC turns on DNSSEC signing of the PRIMARY zone, e.g. after it was converted from a SECONDARY zone,
+		// and returns its DNSSEC status with the DS record to publish at the registrar. The error matches
+		// ErrStructValidation if the zone is not PRIMARY. The DS record may be missing until the zone is signed.
+		EnableDNSSEC(context.Context, string) (*DNSSECStatus, error)
+		// RotateDNSSECKey starts the rotation of the zone's KSK or ZSK and returns its DNSSEC status.
+		// The records of the new key are in NewRecords until the rotation completes.
+		RotateDNSSECKey(context.Context, RotateKeyRequest) (*DNSSECStatus, error)
+		// WaitForZoneActivation polls the zone with the given interval until its activation state is ACTIVE,
+		// e.g. after a change list was submitted. It returns ZoneActivationError if the activation failed
+		// and stops waiting when the context is done.
+		WaitForZoneActivation(context.Context, string, time.Duration) (*ZoneResponse, error)
 		//GetChangeList retrieves Zone changelist.
 		//
 		// See: https://techdocs.akamai.com/edge-dns/reference/get-changelists-zone
@@ -50,10 +74,27 @@ type (
 		//
 		// See: https://techdocs.akamai.com/edge-dns/reference/post-zones-zone-zone-file
 		PostMasterZoneFile(context.Context, string, string) error
+		// ExportZone writes all recordsets of the zone to the writer in the given format, e.g. for backups.
+		// Recordsets are fetched page by page and written as they are retrieved.
+		//
+		// See: https://techdocs.akamai.com/edge-dns/reference/get-zones-zone-recordsets
+		ExportZone(context.Context, string, io.Writer, Format) error
+		// ImportMasterFile reads recordsets from a BIND master file, e.g. when migrating a zone, and creates or
+		// replaces them in a single change list. $ORIGIN and $TTL directives are supported and the SOA record is skipped.
+		// Invalid records are skipped and reported in the result, unless ImportMasterFileOptions.Strict is set.
+		//
+		// See: https://techdocs.akamai.com/edge-dns/reference/post-changelists-zone-recordsets-add-change
+		ImportMasterFile(context.Context, string, io.Reader, ImportMasterFileOptions) (*ImportMasterFileResult, error)
 		// CreateZone creates new zone.
 		//
 		// See: https://techdocs.akamai.com/edge-dns/reference/post-zone
 		CreateZone(context.Context, *ZoneCreate, ZoneQueryString, ...bool) error
+		// CreateZones creates the zones with at most the given number of concurrent requests and returns
+		// a result for each of the requests, in the same order. Invalid requests are not sent and their
+		// results have errors matching ErrStructValidation.
+		//
+		// See: https://techdocs.akamai.com/edge-dns/reference/post-zone
+		CreateZones(context.Context, []CreateZoneRequest, int) ([]CreateZoneResult, error)
 		// SaveChangelist creates a new Change List based on the most recent version of a zone.
 		//
 		// See: https://techdocs.akamai.com/edge-dns/reference/post-changelists
@@ -62,6 +103,15 @@ type (
 		//
 		// See: https://techdocs.akamai.com/edge-dns/reference/post-changelists-zone-submit
 		SubmitChangelist(context.Context, *ZoneCreate) error
+		// GetZoneMasters retrieves the IP addresses of master name servers of a secondary zone.
+		//
+		// See: https://techdocs.akamai.com/edge-dns/reference/get-zone
+		GetZoneMasters(context.Context, string) ([]string, error)
+		// UpdateZoneMasters replaces the master name servers of a secondary zone, keeping its other settings.
+		// Each of the masters must be an IPv4 or IPv6 address. It returns ErrNotSecondaryZone for other zone types.
+		//
+		// See: https://techdocs.akamai.com/edge-dns/reference/put-zone
+		UpdateZoneMasters(context.Context, string, []string) error
 		// UpdateZone updates zone.
 		//
 		// See: https://techdocs.akamai.com/edge-dns/reference/put-zone
@@ -325,6 +375,10 @@ func (p *dns) GetZone(ctx context.Context, zonename string) (*ZoneResponse, erro
 	logger := p.Log(ctx)
 	logger.Debug("GetZone")
 
+	if err := validateZoneName(zonename); err != nil {
+		return nil, err
+	}
+
 	var zone ZoneResponse
 
 	getURL := fmt.Sprintf("/config-dns/v2/zones/%s", zonename)
@@ -438,6 +492,14 @@ func (p *dns) CreateZone(ctx context.Context, zone *ZoneCreate, zonequerystring
 	logger := p.Log(ctx)
 	logger.Debug("Zone Create")
 
+	return p.createZone(ctx, zone, zonequerystring, clearConn...)
+}
+
+// createZone creates the zone without taking zoneWriteLock, so that distinct zones can be created concurrently
+func (p *dns) createZone(ctx context.Context, zone *ZoneCreate, zonequerystring ZoneQueryString, clearConn ...bool) error {
+
+	logger := p.Log(ctx)
+
 	if err := p.ValidateZone(ctx, zone); err != nil {
 		return err
 	}
@@ -0,0 +1,73 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
+)
+
+const (
+	// ZoneActivationStateActive is the activation state of a zone which changes were propagated
+	ZoneActivationStateActive = "ACTIVE"
+	// ZoneActivationStatePending is the activation state of a zone which changes are being propagated
+	ZoneActivationStatePending = "PENDING"
+	// ZoneActivationStateFailed is the activation state of a zone which changes could not be propagated
+	ZoneActivationStateFailed = "FAILED"
+)
+
+var (
+	// ErrZoneActivationFailed is matched by ZoneActivationError
+	ErrZoneActivationFailed = errors.New("zone activation failed")
+)
+
+// ZoneActivationError is returned by WaitForZoneActivation when the zone reaches FAILED activation state
+type ZoneActivationError struct {
+	Zone  string
+	State string
+	// Response is the zone as returned by the server, e.g. to check who modified it last and when
+	Response *ZoneResponse
+}
+
+func (e *ZoneActivationError) Error() string {
+	return fmt.Sprintf("%s: zone %q is in %s state", ErrZoneActivationFailed, e.Zone, e.State)
+}
+
+// Is handles error comparisons
+func (e *ZoneActivationError) Is(target error) bool {
+	return target == ErrZoneActivationFailed
+}
+
+func (p *dns) WaitForZoneActivation(ctx context.Context, zone string, interval time.Duration) (*ZoneResponse, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("WaitForZoneActivation")
+
+	if interval <= 0 {
+		return nil, fmt.Errorf("%w: interval must be greater than 0", ErrStructValidation)
+	}
+
+	for {
+		resp, err := p.GetZone(ctx, zone)
+		if err != nil {
+			return nil, fmt.Errorf("WaitForZoneActivation failed to get zone: %w", err)
+		}
+
+		switch state := strings.ToUpper(resp.ActivationState); state {
+		case ZoneActivationStateActive:
+			return resp, nil
+		case ZoneActivationStateFailed:
+			return nil, &ZoneActivationError{Zone: zone, State: state, Response: resp}
+		default:
+			logger.Debugf("zone %s is in %s state, waiting %s", zone, state, interval)
+		}
+
+		// the poll is not waited for if it would happen after the deadline of the context
+		if err := session.Wait(ctx, interval); err != nil {
+			return nil, fmt.Errorf("WaitForZoneActivation: zone %q did not become active: %w", zone, err)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDns_WaitForZoneActivation(t *testing.T) {
+	tests := map[string]struct {
+		states        []string
+		timeout       time.Duration
+		interval      time.Duration
+		expectedCalls int
+		withError     func(*testing.T, error)
+	}{
+		"PENDING to ACTIVE": {
+			states:        []string{"PENDING", "PENDING", "ACTIVE"},
+			timeout:       time.Second,
+			interval:      time.Millisecond,
+			expectedCalls: 3,
+		},
+		"PENDING to FAILED": {
+			states:        []string{"PENDING", "FAILED"},
+			timeout:       time.Second,
+			interval:      time.Millisecond,
+			expectedCalls: 2,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrZoneActivationFailed), "want: %s; got: %s", ErrZoneActivationFailed, err)
+				var activationErr *ZoneActivationError
+				require.True(t, errors.As(err, &activationErr))
+				assert.Equal(t, "example.com", activationErr.Zone)
+				assert.Equal(t, "FAILED", activationErr.State)
+				assert.Equal(t, "jdoe", activationErr.Response.LastModifiedBy)
+			},
+		},
+		"timeout": {
+			states:   []string{"PENDING"},
+			timeout:  20 * time.Millisecond,
+			interval: 5 * time.Millisecond,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, context.DeadlineExceeded), "want: %s; got: %s", context.DeadlineExceeded, err)
+			},
+		},
+		"invalid interval": {
+			timeout: time.Second,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls int
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-dns/v2/zones/example.com", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				state := test.states[len(test.states)-1]
+				if calls < len(test.states) {
+					state = test.states[calls]
+				}
+				calls++
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(fmt.Sprintf(`
+{
+	"zone": "example.com",
+	"type": "PRIMARY",
+	"activationState": "%s",
+	"lastModifiedBy": "jdoe",
+	"versionId": "1d9c887c-49bb-4382-87a6-d1bf690aa58f"
+}`, state)))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			ctx, cancel := context.WithTimeout(context.Background(), test.timeout)
+			defer cancel()
+
+			result, err := client.WaitForZoneActivation(ctx, "example.com", test.interval)
+			if test.withError != nil {
+				test.withError(t, err)
+				if test.expectedCalls > 0 {
+					assert.Equal(t, test.expectedCalls, calls)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "ACTIVE", result.ActivationState)
+			assert.Equal(t, test.expectedCalls, calls)
+		})
+	}
+}
+
+func TestDns_SetRecordSetThenWaitDeadline(t *testing.T) {
+	var calls []string
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/config-dns/v2/changelists/example.com":
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`{"type": "https://problems.luna.akamaiapis.net/authoritative-dns/notFound", "title": "Not Found", "status": 404}`))
+			assert.NoError(t, err)
+		case r.Method == http.MethodGet && r.URL.Path == "/config-dns/v2/zones/example.com":
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{"zone": "example.com", "type": "PRIMARY", "activationState": "PENDING"}`))
+			assert.NoError(t, err)
+		case r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{"recordsets": []}`))
+			assert.NoError(t, err)
+		case r.URL.Path == "/config-dns/v2/changelists":
+			w.WriteHeader(http.StatusCreated)
+			_, err := w.Write([]byte(`{"zone": "example.com", "changeTag": "476754f4-d605-479f-853b-db854d7254fa", "stale": false}`))
+			assert.NoError(t, err)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	goroutines := runtime.NumGoroutine()
+
+	client := mockAPIClient(t, mockServer)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	recordset := Recordset{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2"}}
+	zone, err := client.SetRecordSet(ctx, "example.com", recordset, SetRecordSetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING", zone.ActivationState)
+
+	// the zone is polled once, the next poll would be past the deadline, so the wait is aborted right away
+	start := time.Now()
+	_, err = client.WaitForZoneActivation(ctx, "example.com", time.Minute)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "want: %s; got: %s", context.DeadlineExceeded, err)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second), "wait should be aborted before the deadline")
+	assert.NoError(t, ctx.Err())
+	assert.Equal(t, []string{
+		"GET /config-dns/v2/changelists/example.com",
+		"POST /config-dns/v2/changelists",
+		"GET /config-dns/v2/changelists/example.com/recordsets",
+		"POST /config-dns/v2/changelists/example.com/recordsets/add-change",
+		"POST /config-dns/v2/changelists/example.com/submit",
+		"GET /config-dns/v2/zones/example.com",
+		"GET /config-dns/v2/zones/example.com",
+	}, calls)
+
+	mockServer.Close()
+	assert.Eventually(t, func() bool {
+		return runtime.NumGoroutine() <= goroutines
+	}, time.Second, 10*time.Millisecond, "goroutines leaked: %d, expected at most %d", runtime.NumGoroutine(), goroutines)
+}
@@ -0,0 +1,119 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Format is a format of the zone exported with ExportZone
+type Format string
+
+const (
+	// FormatBIND is the BIND master file format, one resource record per line
+	FormatBIND Format = "bind"
+	// FormatJSON is a JSON object with the zone name and the list of its recordsets
+	FormatJSON Format = "json"
+)
+
+// exportPageSize is the number of recordsets fetched with a single request by ExportZone
+var exportPageSize = 500
+
+// zoneNameRegexp matches a domain name consisting of labels of letters, digits, hyphens and underscores
+var zoneNameRegexp = regexp.MustCompile(`^([a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?\.)*[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?$`)
+
+// validateZoneName checks that the zone is a valid domain name. A trailing dot is allowed.
+func validateZoneName(zone string) error {
+	name := strings.TrimSuffix(zone, ".")
+	if name == "" {
+		return fmt.Errorf("%w: zone name is required", ErrStructValidation)
+	}
+	if !isDomainName(zone) {
+		return fmt.Errorf("%w: invalid zone name %q", ErrStructValidation, zone)
+	}
+	return nil
+}
+
+// isDomainName reports whether the name is a valid domain name. A trailing dot is allowed.
+func isDomainName(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	return name != "" && len(name) <= 253 && zoneNameRegexp.MatchString(name)
+}
+
+func (p *dns) ExportZone(ctx context.Context, zone string, w io.Writer, format Format) error {
+
+	logger := p.Log(ctx)
+	logger.Debug("ExportZone")
+
+	if err := validateZoneName(zone); err != nil {
+		return err
+	}
+	zone = strings.TrimSuffix(zone, ".")
+
+	var write func(int, Recordset) error
+	switch format {
+	case FormatBIND:
+		if _, err := fmt.Fprintf(w, "$ORIGIN %s.\n", zone); err != nil {
+			return fmt.Errorf("ExportZone failed to write: %w", err)
+		}
+		write = func(_ int, rs Recordset) error {
+			for _, rdata := range rs.Rdata {
+				if _, err := fmt.Fprintf(w, "%s.\t%d\tIN\t%s\t%s\n", strings.TrimSuffix(rs.Name, "."), rs.TTL, rs.Type, rdata); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case FormatJSON:
+		zoneName, err := json.Marshal(zone)
+		if err != nil {
+			return fmt.Errorf("ExportZone failed to marshal zone name: %w", err)
+		}
+		if _, err := fmt.Fprintf(w, `{"zone":%s,"recordsets":[`, zoneName); err != nil {
+			return fmt.Errorf("ExportZone failed to write: %w", err)
+		}
+		write = func(i int, rs Recordset) error {
+			data, err := json.Marshal(rs)
+			if err != nil {
+				return err
+			}
+			if i > 0 {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			_, err = w.Write(data)
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: unsupported export format %q, supported formats are: %s, %s", ErrStructValidation, format, FormatBIND, FormatJSON)
+	}
+
+	var written int
+	for page := 1; ; page++ {
+		resp, err := p.GetRecordsets(ctx, zone, RecordsetQueryArgs{Page: page, PageSize: exportPageSize})
+		if err != nil {
+			return fmt.Errorf("ExportZone failed to get recordsets: %w", err)
+		}
+		for _, rs := range resp.Recordsets {
+			if err := write(written, rs); err != nil {
+				return fmt.Errorf("ExportZone failed to write: %w", err)
+			}
+			written++
+		}
+		if len(resp.Recordsets) == 0 || page >= resp.Metadata.LastPage {
+			break
+		}
+	}
+
+	if format == FormatJSON {
+		if _, err := io.WriteString(w, "]}\n"); err != nil {
+			return fmt.Errorf("ExportZone failed to write: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDns_ExportZone(t *testing.T) {
+	pages := map[string]string{
+		"1": `
+{
+	"metadata": {"zone": "example.com", "page": 1, "pageSize": 2, "lastPage": 2, "totalElements": 3},
+	"recordsets": [
+		{"name": "example.com", "type": "SOA", "ttl": 86400, "rdata": ["a1-1.akam.net. hostmaster.example.com. 2023010101 3600 600 604800 300"]},
+		{"name": "www.example.com", "type": "A", "ttl": 300, "rdata": ["10.0.0.2", "10.0.0.3"]}
+	]
+}`,
+		"2": `
+{
+	"metadata": {"zone": "example.com", "page": 2, "pageSize": 2, "lastPage": 2, "totalElements": 3},
+	"recordsets": [
+		{"name": "example.com", "type": "TXT", "ttl": 600, "rdata": ["\"v=spf1 -all\""]}
+	]
+}`,
+	}
+
+	tests := map[string]struct {
+		zone           string
+		format         Format
+		responseStatus int
+		expectedPages  []string
+		expectedOutput string
+		withError      func(*testing.T, error)
+	}{
+		"BIND format": {
+			zone:           "example.com",
+			format:         FormatBIND,
+			responseStatus: http.StatusOK,
+			expectedPages:  []string{"1", "2"},
+			expectedOutput: "$ORIGIN example.com.\n" +
+				"example.com.\t86400\tIN\tSOA\ta1-1.akam.net. hostmaster.example.com. 2023010101 3600 600 604800 300\n" +
+				"www.example.com.\t300\tIN\tA\t10.0.0.2\n" +
+				"www.example.com.\t300\tIN\tA\t10.0.0.3\n" +
+				"example.com.\t600\tIN\tTXT\t\"v=spf1 -all\"\n",
+		},
+		"JSON format with trailing dot": {
+			zone:           "example.com.",
+			format:         FormatJSON,
+			responseStatus: http.StatusOK,
+			expectedPages:  []string{"1", "2"},
+			expectedOutput: `{"zone":"example.com","recordsets":[` +
+				`{"name":"example.com","type":"SOA","ttl":86400,"rdata":["a1-1.akam.net. hostmaster.example.com. 2023010101 3600 600 604800 300"]},` +
+				`{"name":"www.example.com","type":"A","ttl":300,"rdata":["10.0.0.2","10.0.0.3"]},` +
+				`{"name":"example.com","type":"TXT","ttl":600,"rdata":["\"v=spf1 -all\""]}` +
+				"]}\n",
+		},
+		"invalid zone name": {
+			zone:   "-example..com",
+			format: FormatBIND,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+			},
+		},
+		"missing zone name": {
+			format: FormatJSON,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+			},
+		},
+		"unsupported format": {
+			zone:   "example.com",
+			format: "csv",
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+			},
+		},
+		"500 internal server error": {
+			zone:           "example.com",
+			format:         FormatBIND,
+			responseStatus: http.StatusInternalServerError,
+			expectedPages:  []string{"1"},
+			withError: func(t *testing.T, err error) {
+				want := &Error{
+					Type:       "internal_error",
+					Title:      "Internal Server Error",
+					Detail:     "Error fetching recordsets",
+					StatusCode: http.StatusInternalServerError,
+				}
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+	}
+
+	exportPageSize = 2
+	defer func() { exportPageSize = 500 }()
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requestedPages []string
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-dns/v2/zones/example.com/recordsets", r.URL.Path)
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "2", r.URL.Query().Get("pageSize"))
+				page := r.URL.Query().Get("page")
+				requestedPages = append(requestedPages, page)
+				w.WriteHeader(test.responseStatus)
+				body := pages[page]
+				if test.responseStatus != http.StatusOK {
+					body = `{"type": "internal_error", "title": "Internal Server Error", "detail": "Error fetching recordsets", "status": 500}`
+				}
+				_, err := w.Write([]byte(body))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			var buf bytes.Buffer
+			err := client.ExportZone(context.Background(), test.zone, &buf, test.format)
+			assert.Equal(t, test.expectedPages, requestedPages)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedOutput, buf.String())
+			if test.format == FormatJSON {
+				assert.True(t, json.Valid(buf.Bytes()))
+			}
+		})
+	}
+}
@@ -0,0 +1,419 @@
+package dns
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type (
+	// ImportMasterFileOptions contains options of ImportMasterFile
+	ImportMasterFileOptions struct {
+		// Strict fails the import if any of the records is invalid. By default, invalid records are skipped
+		// and reported in ImportMasterFileResult.Errors.
+		Strict bool
+		// Overwrite replaces a pending change list of the zone, see UpsertRecordsetsOptions.
+		Overwrite bool
+	}
+
+	// ImportMasterFileResult contains the outcome of ImportMasterFile
+	ImportMasterFileResult struct {
+		// Recordsets are the recordsets which were submitted
+		Recordsets []Recordset
+		// Errors lists the records which were skipped, because they are invalid
+		Errors MasterFileErrors
+	}
+
+	// MasterFileError describes an invalid line of a master file. It matches ErrStructValidation with errors.Is.
+	MasterFileError struct {
+		Line int
+		Err  error
+	}
+
+	// MasterFileErrors lists invalid lines of a master file. It matches ErrStructValidation with errors.Is.
+	MasterFileErrors []*MasterFileError
+
+	// masterFileRecord is a single resource record of a master file
+	masterFileRecord struct {
+		line      int
+		recordset Recordset
+	}
+
+	// masterFileParser reads resource records of a master file
+	masterFileParser struct {
+		scanner  *bufio.Scanner
+		line     int
+		origin   string
+		ttl      int
+		lastName string
+		lastTTL  int
+	}
+)
+
+// masterFileClasses are the classes which may precede the type of a record
+var masterFileClasses = map[string]struct{}{"IN": {}, "CH": {}, "HS": {}, "CS": {}}
+
+func (e *MasterFileError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+func (e *MasterFileError) Unwrap() error {
+	return e.Err
+}
+
+// Is handles error comparisons
+func (e *MasterFileError) Is(target error) bool {
+	return target == ErrStructValidation
+}
+
+func (e MasterFileErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("%s: invalid master file records:\n%s", ErrStructValidation, strings.Join(msgs, "\n"))
+}
+
+// Is handles error comparisons
+func (e MasterFileErrors) Is(target error) bool {
+	return target == ErrStructValidation
+}
+
+func (p *dns) ImportMasterFile(ctx context.Context, zone string, r io.Reader, opts ImportMasterFileOptions) (*ImportMasterFileResult, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("ImportMasterFile")
+
+	if err := validateZoneName(zone); err != nil {
+		return nil, err
+	}
+
+	recordsets, errs, err := parseMasterFile(strings.TrimSuffix(zone, "."), r)
+	if err != nil {
+		return nil, fmt.Errorf("ImportMasterFile failed to read master file: %w", err)
+	}
+	if len(errs) > 0 && opts.Strict {
+		return nil, errs
+	}
+	if len(recordsets) == 0 {
+		if len(errs) > 0 {
+			return nil, errs
+		}
+		return nil, fmt.Errorf("%w: master file has no records to import", ErrStructValidation)
+	}
+	for _, err := range errs {
+		logger.Warnf("ImportMasterFile skipped invalid record: %s", err)
+	}
+
+	if err := p.UpsertRecordsets(ctx, zone, recordsets, UpsertRecordsetsOptions{Overwrite: opts.Overwrite}); err != nil {
+		return nil, fmt.Errorf("ImportMasterFile failed to submit recordsets: %w", err)
+	}
+
+	return &ImportMasterFileResult{Recordsets: recordsets, Errors: errs}, nil
+}
+
+// parseMasterFile reads records of the master file and groups them into recordsets, in order of their first
+// occurrence. SOA record is skipped, as it is managed by Edge DNS. Invalid records are returned as errors.
+func parseMasterFile(zone string, r io.Reader) ([]Recordset, MasterFileErrors, error) {
+	parser := &masterFileParser{scanner: bufio.NewScanner(r), origin: zone}
+
+	var recordsets []Recordset
+	var lines [][]int
+	var errs MasterFileErrors
+	index := make(map[string]int)
+	for {
+		record, err := parser.next()
+		var lineErr *MasterFileError
+		if errors.As(err, &lineErr) {
+			errs = append(errs, lineErr)
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if record == nil {
+			break
+		}
+
+		rs := record.recordset
+		if rs.Type == "SOA" {
+			continue
+		}
+		field, err := validateRecordset(rs)
+		if err == nil {
+			field, err = validateApexAlias(zone, rs)
+		}
+		if err != nil {
+			errs = append(errs, &MasterFileError{Line: record.line, Err: fmt.Errorf("%s %s: %s: %w", rs.Name, rs.Type, field, err)})
+			continue
+		}
+		key := recordsetKey(rs.Name, rs.Type)
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(recordsets)
+			recordsets = append(recordsets, rs)
+			lines = append(lines, []int{record.line})
+			continue
+		}
+		// the first TTL of the recordset is used, as all records of a recordset share the same TTL
+		recordsets[i].Rdata = append(recordsets[i].Rdata, rs.Rdata...)
+		lines[i] = append(lines[i], record.line)
+	}
+
+	// rules on the whole recordset, e.g. a single CNAME value, are checked once all records are read
+	valid := recordsets[:0]
+	for i, rs := range recordsets {
+		if j, err := validateRecordRdata(rs.Type, rs.Rdata); err != nil {
+			line := lines[i][0]
+			if j >= 0 {
+				line = lines[i][j]
+			}
+			errs = append(errs, &MasterFileError{Line: line, Err: fmt.Errorf("%s %s: %w", rs.Name, rs.Type, err)})
+			continue
+		}
+		valid = append(valid, rs)
+	}
+
+	return valid, errs, nil
+}
+
+// next returns the next record of the master file, or nil at the end of the file.
+// Errors of *MasterFileError type are returned for invalid lines, which are skipped.
+func (p *masterFileParser) next() (*masterFileRecord, error) {
+	for {
+		tokens, inherited, line, err := p.readEntry()
+		if err != nil {
+			return nil, err
+		}
+		if tokens == nil {
+			return nil, nil
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+
+		if strings.HasPrefix(tokens[0], "$") && !inherited {
+			if err := p.directive(tokens); err != nil {
+				return nil, &MasterFileError{Line: line, Err: err}
+			}
+			continue
+		}
+
+		record, err := p.record(tokens, inherited)
+		if err != nil {
+			return nil, &MasterFileError{Line: line, Err: err}
+		}
+		return &masterFileRecord{line: line, recordset: *record}, nil
+	}
+}
+
+// readEntry reads lines until parentheses are closed and returns tokens of the entry, whether its owner name
+// is omitted, i.e. the entry starts with white space, and the number of the line it starts at.
+// It returns nil tokens at the end of the file.
+func (p *masterFileParser) readEntry() ([]string, bool, int, error) {
+	var tokens []string
+	var inherited bool
+	var start, depth int
+	for p.scanner.Scan() {
+		p.line++
+		text := p.scanner.Text()
+		if start == 0 {
+			start = p.line
+			inherited = text != "" && (text[0] == ' ' || text[0] == '\t')
+		}
+		lineTokens, err := tokenizeMasterFileLine(text, &depth)
+		if err != nil {
+			return []string{}, false, start, &MasterFileError{Line: p.line, Err: err}
+		}
+		tokens = append(tokens, lineTokens...)
+		if depth == 0 {
+			if tokens == nil {
+				tokens = []string{}
+			}
+			return tokens, inherited, start, nil
+		}
+	}
+	if err := p.scanner.Err(); err != nil {
+		return nil, false, start, err
+	}
+	if depth > 0 {
+		return []string{}, false, start, &MasterFileError{Line: start, Err: errors.New("unbalanced parentheses")}
+	}
+	return nil, false, start, nil
+}
+
+// directive handles $ORIGIN and $TTL directives
+func (p *masterFileParser) directive(tokens []string) error {
+	switch strings.ToUpper(tokens[0]) {
+	case "$ORIGIN":
+		if len(tokens) != 2 {
+			return errors.New("$ORIGIN requires a single domain name")
+		}
+		origin := p.absolute(tokens[1])
+		if !isDomainName(origin) {
+			return fmt.Errorf("$ORIGIN %q is not a valid domain name", tokens[1])
+		}
+		p.origin = origin
+	case "$TTL":
+		if len(tokens) != 2 {
+			return errors.New("$TTL requires a single value")
+		}
+		ttl, ok := parseMasterFileTTL(tokens[1])
+		if !ok {
+			return fmt.Errorf("$TTL %q is not a valid TTL", tokens[1])
+		}
+		p.ttl = ttl
+	default:
+		return fmt.Errorf("unsupported directive %s", tokens[0])
+	}
+	return nil
+}
+
+// record parses the resource record of the tokens. The owner name is omitted if inherited is set.
+func (p *masterFileParser) record(tokens []string, inherited bool) (*Recordset, error) {
+	name := p.lastName
+	if !inherited {
+		name = p.absolute(tokens[0])
+		tokens = tokens[1:]
+	}
+	if name == "" {
+		return nil, errors.New("owner name is missing")
+	}
+	p.lastName = name
+
+	ttl := 0
+	// TTL and class may both be given, in any order
+	for i := 0; i < 2 && len(tokens) > 0; i++ {
+		if value, ok := parseMasterFileTTL(tokens[0]); ok {
+			ttl = value
+			tokens = tokens[1:]
+		} else if _, ok := masterFileClasses[strings.ToUpper(tokens[0])]; ok {
+			tokens = tokens[1:]
+		}
+	}
+	if len(tokens) < 2 {
+		return nil, errors.New("record type and data are required")
+	}
+	switch {
+	case ttl > 0:
+		p.lastTTL = ttl
+	case p.ttl > 0:
+		ttl = p.ttl
+	case p.lastTTL > 0:
+		ttl = p.lastTTL
+	default:
+		return nil, errors.New("TTL is not set for the record and there is no $TTL directive")
+	}
+
+	recordType := strings.ToUpper(tokens[0])
+	if _, ok := recordTypes[recordType]; !ok {
+		return nil, fmt.Errorf("unsupported record type %q", tokens[0])
+	}
+	rdata := tokens[1:]
+	if field, ok := hostTargetField[recordType]; ok && field < len(rdata) {
+		if rdata[field] != "." {
+			rdata[field] = p.absolute(rdata[field]) + "."
+		}
+	}
+
+	return &Recordset{Name: name, Type: recordType, TTL: ttl, Rdata: []string{strings.Join(rdata, " ")}}, nil
+}
+
+// absolute returns the name qualified with the origin, unless it ends with a dot, without the trailing dot
+func (p *masterFileParser) absolute(name string) string {
+	switch {
+	case name == "@":
+		return p.origin
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	case p.origin == "":
+		return name
+	default:
+		return name + "." + p.origin
+	}
+}
+
+// tokenizeMasterFileLine splits the line into fields, keeping quoted strings as single fields and dropping comments.
+// Parentheses are dropped as well, depth is the number of parentheses which are open.
+func tokenizeMasterFileLine(line string, depth *int) ([]string, error) {
+	var tokens []string
+	var current strings.Builder
+	quoted, escaped := false, false
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			current.WriteRune(r)
+			escaped = true
+		case r == '"':
+			current.WriteRune(r)
+			quoted = !quoted
+		case quoted:
+			current.WriteRune(r)
+		case r == ';':
+			flush()
+			return tokens, nil
+		case r == '(':
+			flush()
+			*depth++
+		case r == ')':
+			flush()
+			if *depth == 0 {
+				return nil, errors.New("unbalanced parentheses")
+			}
+			*depth--
+		case r == ' ' || r == '\t':
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if quoted {
+		return nil, errors.New("unbalanced quotes")
+	}
+	flush()
+	return tokens, nil
+}
+
+// parseMasterFileTTL parses a TTL given in seconds or with units, e.g. 3600 or 1h30m
+func parseMasterFileTTL(value string) (int, bool) {
+	if value == "" || value[0] < '0' || value[0] > '9' {
+		return 0, false
+	}
+	if ttl, err := strconv.Atoi(value); err == nil {
+		return ttl, true
+	}
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	ttl, number := 0, 0
+	digits := false
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			number = number*10 + int(c-'0')
+			digits = true
+			continue
+		}
+		unit, ok := units[c|0x20]
+		if !ok || !digits {
+			return 0, false
+		}
+		ttl += number * unit
+		number, digits = 0, false
+	}
+	if digits {
+		return 0, false
+	}
+	return ttl, true
+}
@@ -0,0 +1,166 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMasterFile = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.com. hostmaster.example.com. (
+		2023010101 ; serial
+		3600       ; refresh
+		600        ; retry
+		604800     ; expire
+		300 )      ; minimum
+@		IN	NS	a1-1.akam.net.
+		IN	NS	a2-2.akam.net.
+@	600	IN	MX	10 mail
+www		IN	A	10.0.0.2
+		IN	A	10.0.0.3 ; second address
+txt	IN	300	TXT	"v=spf1 -all" "; not a comment"
+$ORIGIN sub.example.com.
+api		CNAME	www.example.com.
+`
+
+func TestParseMasterFile(t *testing.T) {
+	tests := map[string]struct {
+		masterFile         string
+		expectedRecordsets []Recordset
+		expectedLines      []int
+	}{
+		"records of several types": {
+			masterFile: testMasterFile,
+			expectedRecordsets: []Recordset{
+				{Name: "example.com", Type: "NS", TTL: 3600, Rdata: []string{"a1-1.akam.net.", "a2-2.akam.net."}},
+				{Name: "example.com", Type: "MX", TTL: 600, Rdata: []string{"10 mail.example.com."}},
+				{Name: "www.example.com", Type: "A", TTL: 3600, Rdata: []string{"10.0.0.2", "10.0.0.3"}},
+				{Name: "txt.example.com", Type: "TXT", TTL: 300, Rdata: []string{`"v=spf1 -all" "; not a comment"`}},
+				{Name: "api.sub.example.com", Type: "CNAME", TTL: 3600, Rdata: []string{"www.example.com."}},
+			},
+		},
+		"invalid records": {
+			masterFile: `www 300 IN A 10.0.0.2
+bad 300 IN A 10.0.0.256
+foo 300 IN FOO bar
+notl IN A 10.0.0.4
+$INCLUDE other.zone
+alias 300 IN CNAME a.example.com.
+alias 300 IN CNAME b.example.com.
+`,
+			expectedRecordsets: []Recordset{
+				{Name: "www.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.2"}},
+				{Name: "notl.example.com", Type: "A", TTL: 300, Rdata: []string{"10.0.0.4"}},
+			},
+			expectedLines: []int{2, 3, 5, 6},
+		},
+		"missing TTL": {
+			masterFile:    "www IN A 10.0.0.2\n",
+			expectedLines: []int{1},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			recordsets, errs, err := parseMasterFile("example.com", strings.NewReader(test.masterFile))
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedRecordsets, recordsets)
+			var lines []int
+			for _, e := range errs {
+				lines = append(lines, e.Line)
+			}
+			assert.Equal(t, test.expectedLines, lines, "errors: %v", errs)
+		})
+	}
+}
+
+func TestParseMasterFileTTL(t *testing.T) {
+	for value, expected := range map[string]int{"300": 300, "1h": 3600, "1H30m": 5400, "1w1d": 691200} {
+		ttl, ok := parseMasterFileTTL(value)
+		assert.True(t, ok, value)
+		assert.Equal(t, expected, ttl, value)
+	}
+	for _, value := range []string{"", "IN", "1x", "1h30", "h1"} {
+		_, ok := parseMasterFileTTL(value)
+		assert.False(t, ok, value)
+	}
+}
+
+func TestDns_ImportMasterFile(t *testing.T) {
+	tests := map[string]struct {
+		masterFile      string
+		opts            ImportMasterFileOptions
+		expectedChanges []recordsetChange
+		expectedErrors  int
+		withError       func(*testing.T, error)
+	}{
+		"import": {
+			masterFile: "$TTL 300\nwww IN A 10.0.0.2\nmail IN MX 10 mx.example.net.\nbad IN A 10.0.0.256\n",
+			expectedChanges: []recordsetChange{
+				{Name: "www.example.com", Type: "A", Op: "EDIT", TTL: 300, Rdata: []string{"10.0.0.2"}},
+				{Name: "mail.example.com", Type: "MX", Op: "ADD", TTL: 300, Rdata: []string{"10 mx.example.net."}},
+			},
+			expectedErrors: 1,
+		},
+		"strict": {
+			masterFile: "$TTL 300\nwww IN A 10.0.0.2\nbad IN A 10.0.0.256\n",
+			opts:       ImportMasterFileOptions{Strict: true},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				var errs MasterFileErrors
+				require.True(t, errors.As(err, &errs))
+				require.Len(t, errs, 1)
+				assert.Equal(t, 3, errs[0].Line)
+			},
+		},
+		"no records": {
+			masterFile: "; comment only\n$TTL 300\n",
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var changes []recordsetChange
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/config-dns/v2/changelists":
+					w.WriteHeader(http.StatusCreated)
+					_, err := w.Write([]byte(`{"zone": "example.com", "changeTag": "476754f4-d605-479f-853b-db854d7254fa", "stale": false}`))
+					assert.NoError(t, err)
+				case r.Method == http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(`{"recordsets": [{"name": "www.example.com", "type": "A", "ttl": 600, "rdata": ["10.0.0.1"]}]}`))
+					assert.NoError(t, err)
+				case strings.HasSuffix(r.URL.Path, "/add-change"):
+					var change recordsetChange
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&change))
+					changes = append(changes, change)
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					w.WriteHeader(http.StatusNoContent)
+				}
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.ImportMasterFile(context.Background(), "example.com", strings.NewReader(test.masterFile), test.opts)
+			assert.Equal(t, test.expectedChanges, changes)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, result.Recordsets, len(test.expectedChanges))
+			assert.Len(t, result.Errors, test.expectedErrors)
+		})
+	}
+}
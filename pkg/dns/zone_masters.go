@@ -0,0 +1,86 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+var (
+	// ErrNotSecondaryZone is returned when masters are managed for a zone which type is not SECONDARY
+	ErrNotSecondaryZone = errors.New("zone is not a secondary zone")
+)
+
+// validateMasters checks that each of the masters is an IPv4 or IPv6 address and returns them in canonical form
+func validateMasters(masters []string) ([]string, error) {
+	if len(masters) == 0 {
+		return nil, fmt.Errorf("%w: at least one master is required", ErrStructValidation)
+	}
+	parsed := make([]string, 0, len(masters))
+	for i, master := range masters {
+		ip := net.ParseIP(strings.TrimSpace(master))
+		if ip == nil {
+			return nil, fmt.Errorf("%w: masters[%d]: %q is not a valid IP address", ErrStructValidation, i, master)
+		}
+		parsed = append(parsed, ip.String())
+	}
+	return parsed, nil
+}
+
+func (p *dns) GetZoneMasters(ctx context.Context, zone string) ([]string, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("GetZoneMasters")
+
+	zoneResp, err := p.GetZone(ctx, zone)
+	if err != nil {
+		return nil, fmt.Errorf("GetZoneMasters failed to get zone: %w", err)
+	}
+	if !strings.EqualFold(zoneResp.Type, "SECONDARY") {
+		return nil, fmt.Errorf("%w: zone %q is of %s type", ErrNotSecondaryZone, zone, zoneResp.Type)
+	}
+
+	return zoneResp.Masters, nil
+}
+
+func (p *dns) UpdateZoneMasters(ctx context.Context, zone string, masters []string) error {
+
+	logger := p.Log(ctx)
+	logger.Debug("UpdateZoneMasters")
+
+	if err := validateZoneName(zone); err != nil {
+		return err
+	}
+	masters, err := validateMasters(masters)
+	if err != nil {
+		return err
+	}
+
+	zoneResp, err := p.GetZone(ctx, zone)
+	if err != nil {
+		return fmt.Errorf("UpdateZoneMasters failed to get zone: %w", err)
+	}
+	if !strings.EqualFold(zoneResp.Type, "SECONDARY") {
+		return fmt.Errorf("%w: zone %q is of %s type", ErrNotSecondaryZone, zone, zoneResp.Type)
+	}
+
+	// the zone is replaced as a whole, so all other settings are kept as they are
+	update := &ZoneCreate{
+		Zone:                  zoneResp.Zone,
+		Type:                  zoneResp.Type,
+		Masters:               masters,
+		Comment:               zoneResp.Comment,
+		SignAndServe:          zoneResp.SignAndServe,
+		SignAndServeAlgorithm: zoneResp.SignAndServeAlgorithm,
+		TsigKey:               zoneResp.TsigKey,
+		EndCustomerID:         zoneResp.EndCustomerID,
+		ContractID:            zoneResp.ContractID,
+	}
+	if err := p.UpdateZone(ctx, update, ZoneQueryString{}); err != nil {
+		return fmt.Errorf("UpdateZoneMasters failed to update zone: %w", err)
+	}
+
+	return nil
+}
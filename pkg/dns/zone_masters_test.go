@@ -0,0 +1,129 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDns_UpdateZoneMasters(t *testing.T) {
+	secondaryZone := `
+{
+	"zone": "example.com",
+	"type": "SECONDARY",
+	"masters": ["10.0.0.1"],
+	"comment": "secondary zone",
+	"signAndServe": false,
+	"tsigKey": {"name": "example.com.akamai.com.", "algorithm": "hmac-sha256", "secret": "bWQ1c2VjcmV0"},
+	"contractId": "1-2ABCD",
+	"activationState": "ACTIVE"
+}`
+
+	tests := map[string]struct {
+		masters         []string
+		zoneBody        string
+		expectedMasters []interface{}
+		expectedCalls   []string
+		withError       error
+	}{
+		"IPv4 and IPv6 masters": {
+			masters:         []string{"10.0.0.2", " 2001:0db8::0001 "},
+			zoneBody:        secondaryZone,
+			expectedMasters: []interface{}{"10.0.0.2", "2001:db8::1"},
+			expectedCalls: []string{
+				"GET /config-dns/v2/zones/example.com",
+				"PUT /config-dns/v2/zones/example.com",
+			},
+		},
+		"invalid address": {
+			masters:   []string{"10.0.0.2", "10.0.0.256"},
+			withError: ErrStructValidation,
+		},
+		"no masters": {
+			withError: ErrStructValidation,
+		},
+		"primary zone": {
+			masters:   []string{"10.0.0.2"},
+			zoneBody:  `{"zone": "example.com", "type": "PRIMARY", "activationState": "ACTIVE"}`,
+			withError: ErrNotSecondaryZone,
+			expectedCalls: []string{
+				"GET /config-dns/v2/zones/example.com",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.Method+" "+r.URL.String())
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(test.zoneBody))
+					assert.NoError(t, err)
+					return
+				}
+				var body map[string]interface{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, test.expectedMasters, body["masters"])
+				assert.Equal(t, "SECONDARY", body["type"])
+				assert.Equal(t, "secondary zone", body["comment"])
+				assert.NotNil(t, body["tsigKey"])
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(test.zoneBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			err := client.UpdateZoneMasters(context.Background(), "example.com", test.masters)
+			assert.Equal(t, test.expectedCalls, calls)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDns_GetZoneMasters(t *testing.T) {
+	tests := map[string]struct {
+		zoneBody        string
+		expectedMasters []string
+		withError       error
+	}{
+		"secondary zone": {
+			zoneBody:        `{"zone": "example.com", "type": "SECONDARY", "masters": ["10.0.0.1", "2001:db8::1"]}`,
+			expectedMasters: []string{"10.0.0.1", "2001:db8::1"},
+		},
+		"alias zone": {
+			zoneBody:  `{"zone": "example.com", "type": "ALIAS", "target": "example.org"}`,
+			withError: ErrNotSecondaryZone,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-dns/v2/zones/example.com", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(test.zoneBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			masters, err := client.GetZoneMasters(context.Background(), "example.com")
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedMasters, masters)
+		})
+	}
+}
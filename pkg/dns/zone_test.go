@@ -64,7 +64,7 @@ func TestDns_ListZones(t *testing.T) {
 					}
 				]
 			}`,
-			expectedPath: "/config-dns/v2/zones?contractIds=1-1ACYUM&search=org&sortBy=-contractId%2Czone&types=primary%2Calias&page=1&pageSize=25&showAll=false",
+			expectedPath: "/config-dns/v2/zones?contractIds=1-1ACYUM&page=1&pageSize=25&search=org&showAll=false&sortBy=-contractId%2Czone&types=primary%2Calias",
 			expectedResponse: &ZoneListResponse{
 				Metadata: &ListMetadata{
 					Page:          1,
@@ -89,6 +89,54 @@ func TestDns_ListZones(t *testing.T) {
 				},
 			},
 		},
+		"200 OK - second page of secondary zones of a contract": {
+			args: []ZoneListQueryArgs{
+				{
+					ContractIDs: "1-2ABCDE",
+					Types:       "secondary",
+					Page:        2,
+					PageSize:    1,
+				},
+			},
+			responseStatus: http.StatusOK,
+			responseBody: `
+			{
+				"metadata": {
+					"page": 2,
+					"pageSize": 1,
+					"showAll": false,
+					"totalElements": 2,
+					"contractIds": ["1-2ABCDE"]
+				},
+				"zones": [
+					{
+						"contractId": "1-2ABCDE",
+						"zone": "secondary.example.com",
+						"type": "secondary",
+						"masters": ["1.2.3.4"],
+						"activationState": "PENDING"
+					}
+				]
+			}`,
+			expectedPath: "/config-dns/v2/zones?contractIds=1-2ABCDE&page=2&pageSize=1&showAll=false&types=secondary",
+			expectedResponse: &ZoneListResponse{
+				Metadata: &ListMetadata{
+					Page:          2,
+					PageSize:      1,
+					TotalElements: 2,
+					ContractIDs:   []string{"1-2ABCDE"},
+				},
+				Zones: []*ZoneResponse{
+					{
+						ContractID:      "1-2ABCDE",
+						Zone:            "secondary.example.com",
+						Type:            "secondary",
+						Masters:         []string{"1.2.3.4"},
+						ActivationState: "PENDING",
+					},
+				},
+			},
+		},
 		"500 internal server error": {
 			args: []ZoneListQueryArgs{
 				{
@@ -108,7 +156,7 @@ func TestDns_ListZones(t *testing.T) {
     "detail": "Error fetching authorities",
     "status": 500
 }`,
-			expectedPath: "/config-dns/v2/zones?contractIds=1-1ACYUM&search=org&sortBy=-contractId%2Czone&types=primary%2Calias&page=1&pageSize=25&showAll=false",
+			expectedPath: "/config-dns/v2/zones?contractIds=1-1ACYUM&page=1&pageSize=25&search=org&showAll=false&sortBy=-contractId%2Czone&types=primary%2Calias",
 			withError: &Error{
 				Type:       "internal_error",
 				Title:      "Internal Server Error",
@@ -121,7 +169,7 @@ func TestDns_ListZones(t *testing.T) {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				//assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, test.expectedPath, r.URL.String())
 				assert.Equal(t, http.MethodGet, r.Method)
 				w.WriteHeader(test.responseStatus)
 				_, err := w.Write([]byte(test.responseBody))
@@ -239,12 +287,29 @@ func TestDns_GetZone(t *testing.T) {
 				StatusCode: http.StatusInternalServerError,
 			},
 		},
+		"404 not found": {
+			zone:           "missing.com",
+			responseStatus: http.StatusNotFound,
+			responseBody: `
+{
+	"type": "https://problems.luna.akamaiapis.net/config-dns/v2/not-found",
+	"title": "Not Found",
+	"detail": "Zone missing.com not found",
+	"status": 404
+}`,
+			expectedPath: "/config-dns/v2/zones/missing.com",
+			withError:    ErrNotFound,
+		},
+		"invalid zone name": {
+			zone:      "example..com",
+			withError: ErrStructValidation,
+		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				//assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, test.expectedPath, r.URL.String())
 				assert.Equal(t, http.MethodGet, r.Method)
 				w.WriteHeader(test.responseStatus)
 				_, err := w.Write([]byte(test.responseBody))
@@ -0,0 +1,131 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/internal/workerpool"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+)
+
+type (
+	// CreateZoneRequest contains a zone to create with CreateZones and the contract and group to create it in
+	CreateZoneRequest struct {
+		Zone     *ZoneCreate
+		Contract string
+		Group    string
+	}
+
+	// CreateZoneResult is the result of creating a single zone with CreateZones
+	CreateZoneResult struct {
+		Zone string
+		Err  error
+	}
+)
+
+var (
+	contractIDRegexp = regexp.MustCompile(`^(ctr_)?[A-Za-z0-9-]+$`)
+	groupIDRegexp    = regexp.MustCompile(`^(grp_)?[0-9]+$`)
+)
+
+// Validate validates CreateZoneRequest
+func (r CreateZoneRequest) Validate() error {
+	if r.Zone == nil {
+		return fmt.Errorf("%w: zone is required", ErrStructValidation)
+	}
+	if err := validateZoneName(r.Zone.Zone); err != nil {
+		return err
+	}
+	if !contractIDRegexp.MatchString(r.Contract) {
+		return fmt.Errorf("%w: invalid contract %q", ErrStructValidation, r.Contract)
+	}
+	if r.Group != "" && !groupIDRegexp.MatchString(r.Group) {
+		return fmt.Errorf("%w: invalid group %q", ErrStructValidation, r.Group)
+	}
+	if err := validateZoneType(r.Zone); err != nil {
+		return fmt.Errorf("%w: %s", ErrStructValidation, err)
+	}
+	return nil
+}
+
+// validateZoneType checks that the zone has the fields required by its type and none of the fields of other types:
+// only secondary zones have masters and a TSIG key, only alias zones have a target and alias zones cannot be signed
+func validateZoneType(zone *ZoneCreate) error {
+	zoneType := strings.ToUpper(zone.Type)
+	secondary := zoneType == "SECONDARY"
+	alias := zoneType == "ALIAS"
+
+	return validation.Errors{
+		"Type": validation.Validate(zoneType, validation.Required,
+			validation.In("PRIMARY", "SECONDARY", "ALIAS").Error("must be one of PRIMARY, SECONDARY or ALIAS")),
+		"Masters": validation.Validate(zone.Masters,
+			validation.When(secondary, validation.Required.Error("is required for SECONDARY zone"), validation.Each(is.IP)).
+				Else(validation.Empty.Error("is allowed only for SECONDARY zone"))),
+		"TsigKey": validation.Validate(zone.TsigKey,
+			validation.When(!secondary, validation.Nil.Error("is allowed only for SECONDARY zone"))),
+		"Target": validation.Validate(zone.Target,
+			validation.When(alias, validation.Required.Error("is required for ALIAS zone"), validation.By(validateZoneTarget)).
+				Else(validation.Empty.Error("is allowed only for ALIAS zone"))),
+		"SignAndServe": validation.Validate(zone.SignAndServe,
+			validation.When(alias, validation.Empty.Error("is not allowed for ALIAS zone"))),
+		"SignAndServeAlgorithm": validation.Validate(zone.SignAndServeAlgorithm,
+			validation.When(alias, validation.Empty.Error("is not allowed for ALIAS zone"))),
+	}.Filter()
+}
+
+// validateZoneTarget checks that the target of alias zone is a valid zone name
+func validateZoneTarget(value interface{}) error {
+	target, ok := value.(string)
+	if !ok || target == "" {
+		return nil
+	}
+	if !isDomainName(target) {
+		return errors.New("must be a valid zone name")
+	}
+	return nil
+}
+
+func (p *dns) CreateZones(ctx context.Context, reqs []CreateZoneRequest, concurrency int) ([]CreateZoneResult, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("CreateZones")
+
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("%w: zones list is empty", ErrStructValidation)
+	}
+
+	results := make([]CreateZoneResult, len(reqs))
+	valid := make([]int, 0, len(reqs))
+	for i, req := range reqs {
+		if req.Zone != nil {
+			results[i].Zone = req.Zone.Zone
+		}
+		if err := req.Validate(); err != nil {
+			results[i].Err = err
+			continue
+		}
+		if err := p.ValidateZone(ctx, req.Zone); err != nil {
+			results[i].Err = fmt.Errorf("%w: %s", ErrStructValidation, err)
+			continue
+		}
+		valid = append(valid, i)
+	}
+
+	// zones are distinct, so they are created without zoneWriteLock, which would serialize the requests
+	errs := workerpool.Run(ctx, len(valid), workerpool.Options{Concurrency: concurrency}, func(ctx context.Context, i int) error {
+		req := reqs[valid[i]]
+		return p.createZone(ctx, req.Zone, ZoneQueryString{Contract: req.Contract, Group: req.Group})
+	})
+	for i, err := range errs {
+		if errors.Is(err, workerpool.ErrNotStarted) {
+			err = fmt.Errorf("request not sent: %w", ctx.Err())
+		}
+		results[valid[i]].Err = err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,168 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDns_CreateZones(t *testing.T) {
+	var mu sync.Mutex
+	var created []string
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/config-dns/v2/zones/", r.URL.Path)
+		var zone ZoneCreate
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&zone))
+		mu.Lock()
+		created = append(created, zone.Zone+" "+r.URL.RawQuery)
+		mu.Unlock()
+
+		if zone.Zone == "exists.com" {
+			w.WriteHeader(http.StatusConflict)
+			_, err := w.Write([]byte(`{"type": "https://problems.luna.akamaiapis.net/config-dns/v2/conflict", "title": "Conflict", "detail": "Zone exists.com already exists", "status": 409}`))
+			assert.NoError(t, err)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, err := w.Write([]byte(`{"zone": "` + zone.Zone + `", "type": "` + zone.Type + `", "activationState": "NEW"}`))
+		assert.NoError(t, err)
+	}))
+	client := mockAPIClient(t, mockServer)
+
+	results, err := client.CreateZones(context.Background(), []CreateZoneRequest{
+		{Zone: &ZoneCreate{Zone: "one.com", Type: "PRIMARY"}, Contract: "1-2ABCD", Group: "12345"},
+		{Zone: &ZoneCreate{Zone: "two..com", Type: "PRIMARY"}, Contract: "1-2ABCD"},
+		{Zone: &ZoneCreate{Zone: "three.com", Type: "secondary", Masters: []string{"10.0.0.1"}}, Contract: "ctr_1-2ABCD"},
+		{Zone: &ZoneCreate{Zone: "four.com", Type: "PRIMARY"}, Contract: ""},
+		{Zone: &ZoneCreate{Zone: "five.com", Type: "FORWARD"}, Contract: "1-2ABCD"},
+		{Zone: &ZoneCreate{Zone: "exists.com", Type: "PRIMARY"}, Contract: "1-2ABCD"},
+		{Zone: &ZoneCreate{Zone: "six.com", Type: "PRIMARY"}, Contract: "1-2ABCD", Group: "grp 1"},
+		{Contract: "1-2ABCD"},
+	}, 3)
+	require.NoError(t, err)
+	require.Len(t, results, 8)
+
+	for i, zone := range []string{"one.com", "two..com", "three.com", "four.com", "five.com", "exists.com", "six.com", ""} {
+		assert.Equal(t, zone, results[i].Zone)
+	}
+	assert.NoError(t, results[0].Err)
+	assert.NoError(t, results[2].Err)
+	for _, i := range []int{1, 3, 4, 6, 7} {
+		assert.True(t, errors.Is(results[i].Err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, results[i].Err)
+	}
+	want := &Error{
+		Type:       "https://problems.luna.akamaiapis.net/config-dns/v2/conflict",
+		Title:      "Conflict",
+		Detail:     "Zone exists.com already exists",
+		StatusCode: http.StatusConflict,
+	}
+	assert.True(t, errors.Is(results[5].Err, want), "want: %s; got: %s", want, results[5].Err)
+
+	sort.Strings(created)
+	assert.Equal(t, []string{
+		"exists.com contractId=1-2ABCD",
+		"one.com contractId=1-2ABCD&gid=12345",
+		"three.com contractId=ctr_1-2ABCD",
+	}, created)
+}
+
+func TestDns_CreateZonesCancelled(t *testing.T) {
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent when context is cancelled")
+	}))
+	client := mockAPIClient(t, mockServer)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := client.CreateZones(ctx, []CreateZoneRequest{
+		{Zone: &ZoneCreate{Zone: "one.com", Type: "PRIMARY"}, Contract: "1-2ABCD"},
+		{Zone: &ZoneCreate{Zone: "two.com", Type: "PRIMARY"}, Contract: "1-2ABCD"},
+	}, 2)
+	require.NoError(t, err)
+	for _, result := range results {
+		assert.True(t, errors.Is(result.Err, context.Canceled), "want: %s; got: %s", context.Canceled, result.Err)
+	}
+
+	_, err = client.CreateZones(context.Background(), nil, 1)
+	assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+}
+
+func TestCreateZoneRequest_Validate(t *testing.T) {
+	tests := map[string]struct {
+		zone      ZoneCreate
+		withError string
+	}{
+		"primary": {
+			zone: ZoneCreate{Zone: "example.com", Type: "PRIMARY", SignAndServe: true, SignAndServeAlgorithm: "RSA_SHA256"},
+		},
+		"primary with masters": {
+			zone:      ZoneCreate{Zone: "example.com", Type: "primary", Masters: []string{"10.0.0.1"}},
+			withError: "Masters: is allowed only for SECONDARY zone.",
+		},
+		"primary with TSIG key": {
+			zone:      ZoneCreate{Zone: "example.com", Type: "PRIMARY", TsigKey: &TSIGKey{Name: "key", Algorithm: "hmac-sha256", Secret: "c2VjcmV0"}},
+			withError: "TsigKey: is allowed only for SECONDARY zone.",
+		},
+		"primary with target": {
+			zone:      ZoneCreate{Zone: "example.com", Type: "PRIMARY", Target: "example.net"},
+			withError: "Target: is allowed only for ALIAS zone.",
+		},
+		"secondary": {
+			zone: ZoneCreate{Zone: "example.com", Type: "SECONDARY", Masters: []string{"10.0.0.1", "2001:db8::1"},
+				TsigKey: &TSIGKey{Name: "key", Algorithm: "hmac-sha256", Secret: "c2VjcmV0"}},
+		},
+		"secondary without masters": {
+			zone:      ZoneCreate{Zone: "example.com", Type: "SECONDARY"},
+			withError: "Masters: is required for SECONDARY zone.",
+		},
+		"secondary with invalid master": {
+			zone:      ZoneCreate{Zone: "example.com", Type: "secondary", Masters: []string{"10.0.0.1", "ns1.example.net"}},
+			withError: "Masters: (1: must be a valid IP address.).",
+		},
+		"secondary with invalid TSIG key": {
+			zone:      ZoneCreate{Zone: "example.com", Type: "SECONDARY", Masters: []string{"10.0.0.1"}, TsigKey: &TSIGKey{Name: "key", Algorithm: "hmac-sha256"}},
+			withError: "TsigKey: (Secret: cannot be blank.).",
+		},
+		"alias": {
+			zone: ZoneCreate{Zone: "example.com", Type: "ALIAS", Target: "example.net"},
+		},
+		"alias without target": {
+			zone:      ZoneCreate{Zone: "example.com", Type: "ALIAS"},
+			withError: "Target: is required for ALIAS zone.",
+		},
+		"alias with invalid target": {
+			zone:      ZoneCreate{Zone: "example.com", Type: "ALIAS", Target: "example..net"},
+			withError: "Target: must be a valid zone name.",
+		},
+		"alias with secondary and signing fields": {
+			zone:      ZoneCreate{Zone: "example.com", Type: "ALIAS", Target: "example.net", Masters: []string{"10.0.0.1"}, SignAndServe: true, SignAndServeAlgorithm: "RSA_SHA256"},
+			withError: "Masters: is allowed only for SECONDARY zone; SignAndServe: is not allowed for ALIAS zone; SignAndServeAlgorithm: is not allowed for ALIAS zone.",
+		},
+		"unknown type": {
+			zone:      ZoneCreate{Zone: "example.com", Type: "FORWARD"},
+			withError: "Type: must be one of PRIMARY, SECONDARY or ALIAS.",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			zone := test.zone
+			err := CreateZoneRequest{Zone: &zone, Contract: "1-2ABCD"}.Validate()
+			if test.withError != "" {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				assert.Contains(t, err.Error(), test.withError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
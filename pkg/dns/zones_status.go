@@ -0,0 +1,71 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/internal/workerpool"
+)
+
+// ZoneStatus is the activation status of a zone retrieved with GetZonesStatus
+type ZoneStatus struct {
+	ActivationState    string
+	LastActivationDate string
+	LastModifiedDate   string
+	// Err is set if the status of the zone could not be retrieved
+	Err error
+}
+
+// Pending reports whether changes of the zone are still being activated
+func (s ZoneStatus) Pending() bool {
+	return strings.EqualFold(s.ActivationState, ZoneActivationStatePending)
+}
+
+func (p *dns) GetZonesStatus(ctx context.Context, zones []string, concurrency int) (map[string]ZoneStatus, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("GetZonesStatus")
+
+	if len(zones) == 0 {
+		return nil, fmt.Errorf("%w: zones list is empty", ErrStructValidation)
+	}
+
+	unique := make([]string, 0, len(zones))
+	seen := make(map[string]struct{}, len(zones))
+	for _, zone := range zones {
+		// duplicated zones are fetched only once
+		if _, ok := seen[zone]; ok {
+			continue
+		}
+		seen[zone] = struct{}{}
+		unique = append(unique, zone)
+	}
+
+	responses := make([]*ZoneResponse, len(unique))
+	errs := workerpool.Run(ctx, len(unique), workerpool.Options{Concurrency: concurrency}, func(ctx context.Context, i int) error {
+		var err error
+		responses[i], err = p.GetZone(ctx, unique[i])
+		return err
+	})
+
+	statuses := make(map[string]ZoneStatus, len(unique))
+	for i, zone := range unique {
+		err := errs[i]
+		if errors.Is(err, workerpool.ErrNotStarted) {
+			err = fmt.Errorf("request not sent: %w", ctx.Err())
+		}
+		if err != nil {
+			statuses[zone] = ZoneStatus{Err: err}
+			continue
+		}
+		statuses[zone] = ZoneStatus{
+			ActivationState:    responses[i].ActivationState,
+			LastActivationDate: responses[i].LastActivationDate,
+			LastModifiedDate:   responses[i].LastModifiedDate,
+		}
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,66 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDns_GetZonesStatus(t *testing.T) {
+	var calls int32
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		assert.Equal(t, http.MethodGet, r.Method)
+		switch strings.TrimPrefix(r.URL.Path, "/config-dns/v2/zones/") {
+		case "active.com":
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{"zone": "active.com", "type": "PRIMARY", "activationState": "ACTIVE", "lastActivationDate": "2023-09-21T14:38:05Z"}`))
+			assert.NoError(t, err)
+		case "pending.com":
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{"zone": "pending.com", "type": "PRIMARY", "activationState": "PENDING", "lastModifiedDate": "2023-09-22T10:00:00Z"}`))
+			assert.NoError(t, err)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, err := w.Write([]byte(`{"type": "https://problems.luna.akamaiapis.net/config-dns/v2/not-found", "title": "Not Found", "detail": "Zone missing.com not found", "status": 404}`))
+			assert.NoError(t, err)
+		}
+	}))
+	client := mockAPIClient(t, mockServer)
+
+	statuses, err := client.GetZonesStatus(context.Background(), []string{"active.com", "pending.com", "missing.com", "active.com"}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	require.Len(t, statuses, 3)
+
+	assert.Equal(t, ZoneStatus{ActivationState: "ACTIVE", LastActivationDate: "2023-09-21T14:38:05Z"}, statuses["active.com"])
+	assert.False(t, statuses["active.com"].Pending())
+	assert.Equal(t, ZoneStatus{ActivationState: "PENDING", LastModifiedDate: "2023-09-22T10:00:00Z"}, statuses["pending.com"])
+	assert.True(t, statuses["pending.com"].Pending())
+	assert.True(t, errors.Is(statuses["missing.com"].Err, ErrNotFound), "want: %s; got: %s", ErrNotFound, statuses["missing.com"].Err)
+}
+
+func TestDns_GetZonesStatusCancelled(t *testing.T) {
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent when context is cancelled")
+	}))
+	client := mockAPIClient(t, mockServer)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	statuses, err := client.GetZonesStatus(ctx, []string{"active.com", "pending.com"}, 2)
+	require.NoError(t, err)
+	for zone, status := range statuses {
+		assert.True(t, errors.Is(status.Err, context.Canceled), "%s: want: %s; got: %s", zone, context.Canceled, status.Err)
+	}
+
+	_, err = client.GetZonesStatus(context.Background(), nil, 2)
+	assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+}
@@ -20,7 +20,7 @@ const (
 	// DefaultSection is the .edgerc ini default section
 	DefaultSection = "default"
 
-	// MaxBodySize is the max payload size for client requests
+	// MaxBodySize is the default number of bytes of the request body covered by the content hash
 	MaxBodySize = 131072
 )
 
@@ -35,11 +35,19 @@ var (
 	ErrSectionDoesNotExist = errors.New("provided config section does not exist")
 	// ErrHostContainsSlashAtTheEnd is returned when host has unnecessary '/' at the end
 	ErrHostContainsSlashAtTheEnd = errors.New("host must not contain '/' at the end")
+	// ErrHostContainsScheme is returned when host is not a bare hostname, but contains the scheme, e.g. 'https://'
+	ErrHostContainsScheme = errors.New("host must not contain the scheme")
+	// ErrInvalidMaxBody is returned when max body is not a positive number
+	ErrInvalidMaxBody = errors.New("max body must be positive")
 )
 
 type (
 	// Config struct provides all the necessary fields to
 	// create authorization header, debug is optional
+	//
+	// MaxBody is the number of bytes of a POST body included in the content hash of the signature, MaxBodySize by default.
+	// It has to match the max body size expected by the API service, as EdgeGrid computes the content hash
+	// of the same number of bytes and rejects the request if it differs.
 	Config struct {
 		Host         string   `ini:"host"`
 		ClientToken  string   `ini:"client_token"`
@@ -70,21 +78,55 @@ func New(opts ...Option) (*Config, error) {
 	for _, opt := range opts {
 		opt(c)
 	}
+	maxBody := c.MaxBody
 
+	var fromEnv bool
 	if c.env {
 		if err := c.FromEnv(c.section); err == nil {
-			return c, nil
+			fromEnv = true
 		} else if !errors.Is(err, ErrRequiredOptionEnv) {
 			return nil, err
 		}
 	}
 
-	if c.file != "" {
+	if !fromEnv && c.file != "" {
 		if err := c.FromFile(c.file, c.section); err != nil {
 			return c, fmt.Errorf("unable to load config from environment or .edgerc file: %w", err)
 		}
 	}
 
+	if maxBody != 0 {
+		c.MaxBody = maxBody
+	}
+	if c.MaxBody == 0 {
+		c.MaxBody = MaxBodySize
+	}
+	if c.MaxBody < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMaxBody, c.MaxBody)
+	}
+
+	return c, nil
+}
+
+// NewFromEnv returns new configuration loaded from the environment variables of the given section, see FromEnv.
+// Unlike New with WithEnv option, it does not fall back to .edgerc file, which makes it handy in CI and containers.
+// The error names the first missing variable.
+func NewFromEnv(section string) (*Config, error) {
+	if section == "" {
+		section = DefaultSection
+	}
+	c := &Config{
+		section: section,
+		env:     true,
+	}
+
+	if err := c.FromEnv(section); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
 	return c, nil
 }
 
@@ -118,6 +160,14 @@ func WithEnv(env bool) Option {
 	}
 }
 
+// WithMaxBody sets the number of bytes of a POST body included in the content hash of the signature,
+// overriding the value loaded from the environment or .edgerc file
+func WithMaxBody(maxBody int) Option {
+	return func(c *Config) {
+		c.MaxBody = maxBody
+	}
+}
+
 // FromFile creates a config the configuration in standard INI format
 func (c *Config) FromFile(file string, section string) error {
 	var (
@@ -166,6 +216,8 @@ func (c *Config) FromFile(file string, section string) error {
 // passing "ccu" will cause it to look for AKAMAI_CCU_HOST, etc.
 //
 // If AKAMAI_{SECTION} does not exist, it will fall back to just AKAMAI_.
+//
+// ErrInvalidMaxBody is returned if AKAMAI_MAX_BODY is negative or not a number, zero falls back to MaxBodySize.
 func (c *Config) FromEnv(section string) error {
 	var (
 		requiredOptions = []string{"HOST", "CLIENT_TOKEN", "CLIENT_SECRET", "ACCESS_TOKEN"}
@@ -197,8 +249,11 @@ func (c *Config) FromEnv(section string) error {
 		}
 	}
 
-	val := os.Getenv(fmt.Sprintf("%s_%s", prefix, "MAX_BODY"))
-	if i, err := strconv.Atoi(val); err == nil {
+	if val, ok := os.LookupEnv(fmt.Sprintf("%s_%s", prefix, "MAX_BODY")); ok {
+		i, err := strconv.Atoi(val)
+		if err != nil || i < 0 {
+			return fmt.Errorf("%w: %q", ErrInvalidMaxBody, val)
+		}
 		c.MaxBody = i
 	}
 
@@ -221,10 +276,17 @@ func Timestamp(t time.Time) string {
 	return t.Format("20060102T15:04:05-0700")
 }
 
-// Validate verifies that the host is not ending with the slash character
+// Validate verifies that the host is a bare hostname without the scheme, not ending with the slash character,
+// and that max body is not negative
 func (c *Config) Validate() error {
+	if strings.Contains(c.Host, "://") {
+		return fmt.Errorf("%w: %q", ErrHostContainsScheme, c.Host)
+	}
 	if strings.HasSuffix(c.Host, "/") {
 		return fmt.Errorf("%w: %q", ErrHostContainsSlashAtTheEnd, c.Host)
 	}
+	if c.MaxBody < 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidMaxBody, c.MaxBody)
+	}
 	return nil
 }
@@ -132,6 +132,45 @@ func TestConfig_FromEnv(t *testing.T) {
 				AccountKey:   "account-key-123",
 			},
 		},
+		"default section, zero max body": {
+			section: "default",
+			envs: map[string]string{
+				"AKAMAI_HOST":          "test-host",
+				"AKAMAI_CLIENT_TOKEN":  "test-client-token",
+				"AKAMAI_CLIENT_SECRET": "test-client-secret",
+				"AKAMAI_ACCESS_TOKEN":  "test-access-token",
+				"AKAMAI_MAX_BODY":      "0",
+			},
+			expected: Config{
+				Host:         "test-host",
+				ClientToken:  "test-client-token",
+				ClientSecret: "test-client-secret",
+				AccessToken:  "test-access-token",
+				MaxBody:      131072,
+			},
+		},
+		"default section, negative max body": {
+			section: "default",
+			envs: map[string]string{
+				"AKAMAI_HOST":          "test-host",
+				"AKAMAI_CLIENT_TOKEN":  "test-client-token",
+				"AKAMAI_CLIENT_SECRET": "test-client-secret",
+				"AKAMAI_ACCESS_TOKEN":  "test-access-token",
+				"AKAMAI_MAX_BODY":      "-1",
+			},
+			withError: ErrInvalidMaxBody,
+		},
+		"default section, max body not a number": {
+			section: "default",
+			envs: map[string]string{
+				"AKAMAI_HOST":          "test-host",
+				"AKAMAI_CLIENT_TOKEN":  "test-client-token",
+				"AKAMAI_CLIENT_SECRET": "test-client-secret",
+				"AKAMAI_ACCESS_TOKEN":  "test-access-token",
+				"AKAMAI_MAX_BODY":      "128kB",
+			},
+			withError: ErrInvalidMaxBody,
+		},
 		"custom section, valid envs": {
 			section: "test",
 			envs: map[string]string{
@@ -207,6 +246,51 @@ func TestConfig_FromEnv(t *testing.T) {
 	}
 }
 
+func TestNew_MaxBody(t *testing.T) {
+	tests := map[string]struct {
+		opts      []Option
+		expected  int
+		withError error
+	}{
+		"default max body": {
+			opts:     []Option{WithFile("test/edgerc"), WithSection("test")},
+			expected: MaxBodySize,
+		},
+		"max body from file": {
+			opts:     []Option{WithFile("test/edgerc"), WithSection("max-body")},
+			expected: 262144,
+		},
+		"max body option overrides file": {
+			opts:     []Option{WithFile("test/edgerc"), WithSection("max-body"), WithMaxBody(524288)},
+			expected: 524288,
+		},
+		"max body option without file": {
+			opts:     []Option{WithMaxBody(524288)},
+			expected: 524288,
+		},
+		"negative max body option": {
+			opts:      []Option{WithMaxBody(-1)},
+			withError: ErrInvalidMaxBody,
+		},
+		"negative max body from file": {
+			opts:      []Option{WithFile("test/edgerc"), WithSection("negative-max-body")},
+			withError: ErrInvalidMaxBody,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := New(test.opts...)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %v; got: %v", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, cfg.MaxBody)
+		})
+	}
+}
+
 func TestConfig_Validate(t *testing.T) {
 	tests := map[string]struct {
 		fileName        string
@@ -254,3 +338,129 @@ func TestConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_ValidateMaxBody(t *testing.T) {
+	cfg := Config{Host: "xxxx-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna.akamaiapis.net", MaxBody: -1}
+	err := cfg.Validate()
+	assert.True(t, errors.Is(err, ErrInvalidMaxBody), "want: %v; got: %v", ErrInvalidMaxBody, err)
+
+	cfg.MaxBody = MaxBodySize
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestNewFromEnv(t *testing.T) {
+	tests := map[string]struct {
+		section       string
+		envs          map[string]string
+		expected      *Config
+		withError     error
+		withErrorText string
+	}{
+		"default section": {
+			section: "default",
+			envs: map[string]string{
+				"AKAMAI_HOST":          "test-host.luna.akamaiapis.net",
+				"AKAMAI_CLIENT_TOKEN":  "test-client-token",
+				"AKAMAI_CLIENT_SECRET": "test-client-secret",
+				"AKAMAI_ACCESS_TOKEN":  "test-access-token",
+			},
+			expected: &Config{
+				Host:         "test-host.luna.akamaiapis.net",
+				ClientToken:  "test-client-token",
+				ClientSecret: "test-client-secret",
+				AccessToken:  "test-access-token",
+				MaxBody:      MaxBodySize,
+				section:      DefaultSection,
+				env:          true,
+			},
+		},
+		"empty section uses default": {
+			envs: map[string]string{
+				"AKAMAI_HOST":          "test-host.luna.akamaiapis.net",
+				"AKAMAI_CLIENT_TOKEN":  "test-client-token",
+				"AKAMAI_CLIENT_SECRET": "test-client-secret",
+				"AKAMAI_ACCESS_TOKEN":  "test-access-token",
+				"AKAMAI_ACCOUNT_KEY":   "account-key-123",
+			},
+			expected: &Config{
+				Host:         "test-host.luna.akamaiapis.net",
+				ClientToken:  "test-client-token",
+				ClientSecret: "test-client-secret",
+				AccessToken:  "test-access-token",
+				AccountKey:   "account-key-123",
+				MaxBody:      MaxBodySize,
+				section:      DefaultSection,
+				env:          true,
+			},
+		},
+		"custom section": {
+			section: "ccu",
+			envs: map[string]string{
+				"AKAMAI_CCU_HOST":          "test-host.luna.akamaiapis.net",
+				"AKAMAI_CCU_CLIENT_TOKEN":  "test-client-token",
+				"AKAMAI_CCU_CLIENT_SECRET": "test-client-secret",
+				"AKAMAI_CCU_ACCESS_TOKEN":  "test-access-token",
+				"AKAMAI_CCU_ACCOUNT_KEY":   "account-key-123",
+			},
+			expected: &Config{
+				Host:         "test-host.luna.akamaiapis.net",
+				ClientToken:  "test-client-token",
+				ClientSecret: "test-client-secret",
+				AccessToken:  "test-access-token",
+				AccountKey:   "account-key-123",
+				MaxBody:      MaxBodySize,
+				section:      "ccu",
+				env:          true,
+			},
+		},
+		"missing client secret": {
+			section: "ccu",
+			envs: map[string]string{
+				"AKAMAI_CCU_HOST":         "test-host.luna.akamaiapis.net",
+				"AKAMAI_CCU_CLIENT_TOKEN": "test-client-token",
+			},
+			withError:     ErrRequiredOptionEnv,
+			withErrorText: `"AKAMAI_CCU_CLIENT_SECRET"`,
+		},
+		"host with scheme": {
+			section: "ccu",
+			envs: map[string]string{
+				"AKAMAI_CCU_HOST":          "https://test-host.luna.akamaiapis.net",
+				"AKAMAI_CCU_CLIENT_TOKEN":  "test-client-token",
+				"AKAMAI_CCU_CLIENT_SECRET": "test-client-secret",
+				"AKAMAI_CCU_ACCESS_TOKEN":  "test-access-token",
+			},
+			withError: ErrHostContainsScheme,
+		},
+		"host with slash at the end": {
+			section: "ccu",
+			envs: map[string]string{
+				"AKAMAI_CCU_HOST":          "test-host.luna.akamaiapis.net/",
+				"AKAMAI_CCU_CLIENT_TOKEN":  "test-client-token",
+				"AKAMAI_CCU_CLIENT_SECRET": "test-client-secret",
+				"AKAMAI_CCU_ACCESS_TOKEN":  "test-access-token",
+			},
+			withError: ErrHostContainsSlashAtTheEnd,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for k, v := range test.envs {
+				require.NoError(t, os.Setenv(k, v))
+			}
+			defer func() {
+				for k := range test.envs {
+					require.NoError(t, os.Unsetenv(k))
+				}
+			}()
+			cfg, err := NewFromEnv(test.section)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %v; got: %v", test.withError, err)
+				assert.Contains(t, err.Error(), test.withErrorText)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, cfg)
+		})
+	}
+}
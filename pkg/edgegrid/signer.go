@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"sort"
@@ -119,30 +120,32 @@ func canonicalizeHeaders(requestHeaders http.Header, headersToSign []string) str
 // The size of the POST body must be less than or equal to the value specified by the service.
 // Any request that does not meet this criteria SHOULD be rejected during the signing process,
 // as the request will be rejected by EdgeGrid.
+// If maxBody is not positive, MaxBodySize is used.
 func createContentHash(r *http.Request, maxBody int) string {
-	var (
-		contentHash  string
-		preparedBody string
-		bodyBytes    []byte
-	)
-
-	if r.Body != nil {
-		bodyBytes, _ = ioutil.ReadAll(r.Body)
-		r.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
-		preparedBody = string(bodyBytes)
+	if maxBody <= 0 {
+		maxBody = MaxBodySize
 	}
 
-	if r.Method == http.MethodPost && len(preparedBody) > 0 {
-		if len(preparedBody) > maxBody {
-			preparedBody = preparedBody[0:maxBody]
-		}
-
-		sum := sha256.Sum256([]byte(preparedBody))
+	if r.Method != http.MethodPost || r.Body == nil {
+		return ""
+	}
 
-		contentHash = base64.StdEncoding.EncodeToString(sum[:])
+	// only the hashed part of the body is read, the rest is streamed when the request is sent,
+	// so that large bodies, e.g. EdgeWorkers bundles, are not held in memory
+	prefix, _ := ioutil.ReadAll(io.LimitReader(r.Body, int64(maxBody)))
+	r.Body = prefixedBody{Reader: io.MultiReader(bytes.NewReader(prefix), r.Body), Closer: r.Body}
+	if len(prefix) == 0 {
+		return ""
 	}
 
-	return contentHash
+	sum := sha256.Sum256(prefix)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// prefixedBody is a request body which part was already read
+type prefixedBody struct {
+	io.Reader
+	io.Closer
 }
 
 func (a authHeader) String() string {
@@ -158,11 +161,14 @@ func (a authHeader) String() string {
 	return auth
 }
 
+// addAccountSwitchKey adds the account key to the query, unless the request already has an account switch key
 func (c Config) addAccountSwitchKey(r *http.Request) string {
 	if c.AccountKey != "" {
 		values := r.URL.Query()
-		values.Add("accountSwitchKey", c.AccountKey)
-		r.URL.RawQuery = values.Encode()
+		if values.Get("accountSwitchKey") == "" {
+			values.Add("accountSwitchKey", c.AccountKey)
+			r.URL.RawQuery = values.Encode()
+		}
 	}
 	return r.URL.RawQuery
 }
@@ -1,7 +1,9 @@
 package edgegrid
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"io/ioutil"
 	"net/http"
 	"strings"
 	"testing"
@@ -55,6 +57,55 @@ func TestConfig_createAuthHeader(t *testing.T) {
 	}
 }
 
+func TestConfig_createAuthHeaderMaxBody(t *testing.T) {
+	body := strings.Repeat("a", MaxBodySize+1024)
+	fullHash := sha256.Sum256([]byte(body))
+	truncatedHash := sha256.Sum256([]byte(body[:MaxBodySize]))
+
+	tests := map[string]struct {
+		maxBody     int
+		contentHash string
+	}{
+		"default max body signs truncated body": {
+			maxBody:     MaxBodySize,
+			contentHash: base64.StdEncoding.EncodeToString(truncatedHash[:]),
+		},
+		"zero max body falls back to default": {
+			maxBody:     0,
+			contentHash: base64.StdEncoding.EncodeToString(truncatedHash[:]),
+		},
+		"raised max body signs whole body": {
+			maxBody:     2 * MaxBodySize,
+			contentHash: base64.StdEncoding.EncodeToString(fullHash[:]),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := Config{
+				ClientToken:  "12345",
+				ClientSecret: "secret",
+				AccessToken:  "54321",
+				MaxBody:      test.maxBody,
+			}
+			req, err := http.NewRequest(http.MethodPost, "https://akamai.com/test/path", strings.NewReader(body))
+			require.NoError(t, err)
+
+			res := config.createAuthHeader(req)
+
+			unsigned := res
+			unsigned.signature = ""
+			msg := strings.Join([]string{http.MethodPost, "https", "akamai.com", "/test/path", "", test.contentHash, unsigned.String()}, "\t")
+			expected := createSignature(msg, createSignature(res.timestamp, config.ClientSecret))
+			assert.Equal(t, expected, res.signature)
+
+			reqBody, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.Equal(t, body, string(reqBody), "request body should be preserved")
+		})
+	}
+}
+
 func TestCanonicalizeHeaders(t *testing.T) {
 	tests := map[string]struct {
 		requestHeaders http.Header
@@ -128,6 +179,22 @@ func TestCreateContentHash(t *testing.T) {
 	}
 }
 
+func TestCreateContentHash_LargeBody(t *testing.T) {
+	body := strings.Repeat("a", 3*MaxBodySize)
+	reader := strings.NewReader(body)
+	req, err := http.NewRequest(http.MethodPost, "", reader)
+	require.NoError(t, err)
+
+	res := createContentHash(req, MaxBodySize)
+	sum := sha256.Sum256([]byte(body[:MaxBodySize]))
+	assert.Equal(t, base64.StdEncoding.EncodeToString(sum[:]), res)
+	assert.Equal(t, 2*MaxBodySize, reader.Len(), "only the hashed part of the body should be read")
+
+	sent, err := ioutil.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(sent))
+}
+
 func TestAuthHeader_String(t *testing.T) {
 	tests := map[string]struct {
 		given    authHeader
@@ -213,6 +280,20 @@ func TestAddAccountSwitchKey(t *testing.T) {
 			}(),
 			expected: "accountSwitchKey=test_switch",
 		},
+		"test account switch key already in query GET": {
+			config: Config{
+				ClientToken: "12345",
+				AccessToken: "54321",
+				AccountKey:  "test_switch",
+				MaxBody:     MaxBodySize,
+			},
+			request: func() *http.Request {
+				req, err := http.NewRequest(http.MethodGet, "http://akamai.com/test/path?accountSwitchKey=other_switch&query=test", nil)
+				require.NoError(t, err)
+				return req
+			}(),
+			expected: "accountSwitchKey=other_switch&query=test",
+		},
 	}
 
 	for name, test := range tests {
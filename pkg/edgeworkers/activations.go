@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/tools"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -49,6 +51,7 @@ type (
 	// ActivateVersion represents the request body used to activate a version
 	ActivateVersion struct {
 		Network ActivationNetwork `json:"network"`
+		Note    string            `json:"note,omitempty"`
 		Version string            `json:"version"`
 	}
 
@@ -81,6 +84,7 @@ type (
 		EdgeWorkerID     int    `json:"edgeWorkerId"`
 		LastModifiedTime string `json:"lastModifiedTime"`
 		Network          string `json:"network"`
+		Note             string `json:"note,omitempty"`
 		Status           string `json:"status"`
 		Version          string `json:"version"`
 	}
@@ -120,12 +124,30 @@ func (r ActivateVersionRequest) Validate() error {
 // Validate validates ActivateVersion
 func (r ActivateVersion) Validate() error {
 	return validation.Errors{
-		"Network": validation.Validate(r.Network, validation.Required, validation.In(ActivationNetworkStaging, ActivationNetworkProduction).Error(
-			fmt.Sprintf("value '%s' is invalid. Must be one of: '%s' or '%s'", r.Network, ActivationNetworkStaging, ActivationNetworkProduction))),
+		"Network": validation.Validate(r.Network, validation.Required, validation.By(validateNetwork)),
 		"Version": validation.Validate(r.Version, validation.Required),
 	}.Filter()
 }
 
+// validateNetwork accepts any representation of staging or production network recognized by tools.ParseActivationNetwork
+func validateNetwork(value interface{}) error {
+	network, _ := value.(ActivationNetwork)
+	if _, err := tools.ParseActivationNetwork(string(network)); err != nil {
+		return fmt.Errorf("value '%s' is invalid. Must be one of: '%s' or '%s'", network, ActivationNetworkStaging, ActivationNetworkProduction)
+	}
+	return nil
+}
+
+// normalize returns ActivationNetworkStaging or ActivationNetworkProduction for any representation of the network,
+// e.g. "staging" or "prod", as the API accepts only the upper case values
+func (n ActivationNetwork) normalize() ActivationNetwork {
+	network, err := tools.ParseActivationNetwork(string(n))
+	if err != nil {
+		return n
+	}
+	return ActivationNetwork(network)
+}
+
 // Validate validates CancelActivationRequest
 func (r CancelActivationRequest) Validate() error {
 	return validation.Errors{
@@ -164,19 +186,9 @@ func (e edgeworkers) ListActivations(ctx context.Context, params ListActivations
 	}
 	uri.RawQuery = q.Encode()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListActivations, err)
-	}
-
 	var result ListActivationsResponse
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrListActivations, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrListActivations, e.Error(resp))
+	if _, err := session.DoRequest(ctx, &e, http.MethodGet, uri.String(), nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrListActivations, err: err}
 	}
 
 	return &result, nil
@@ -192,19 +204,9 @@ func (e edgeworkers) GetActivation(ctx context.Context, params GetActivationRequ
 
 	uri := fmt.Sprintf("/edgeworkers/v1/ids/%d/activations/%d", params.EdgeWorkerID, params.ActivationID)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetActivation, err)
-	}
-
 	var result Activation
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrGetActivation, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrGetActivation, e.Error(resp))
+	if _, err := session.DoRequest(ctx, &e, http.MethodGet, uri, nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrGetActivation, err: err}
 	}
 
 	return &result, nil
@@ -219,21 +221,11 @@ func (e edgeworkers) ActivateVersion(ctx context.Context, params ActivateVersion
 	}
 
 	uri := fmt.Sprintf("/edgeworkers/v1/ids/%d/activations", params.EdgeWorkerID)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrActivateVersion, err)
-	}
+	params.ActivateVersion.Network = params.ActivateVersion.Network.normalize()
 
 	var result Activation
-
-	resp, err := e.Exec(req, &result, params.ActivateVersion)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrActivateVersion, err)
-	}
-
-	if resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("%s: %w", ErrActivateVersion, e.Error(resp))
+	if _, err := session.DoRequest(ctx, &e, http.MethodPost, uri, params.ActivateVersion, &result, http.StatusCreated); err != nil {
+		return nil, &requestError{operation: ErrActivateVersion, err: err}
 	}
 
 	return &result, nil
@@ -249,20 +241,9 @@ func (e edgeworkers) CancelPendingActivation(ctx context.Context, params CancelA
 
 	uri := fmt.Sprintf("/edgeworkers/v1/ids/%d/activations/%d", params.EdgeWorkerID, params.ActivationID)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCancelActivation, err)
-	}
-
 	var result Activation
-
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrCancelActivation, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrCancelActivation, e.Error(resp))
+	if _, err := session.DoRequest(ctx, &e, http.MethodDelete, uri, nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrCancelActivation, err: err}
 	}
 
 	return &result, nil
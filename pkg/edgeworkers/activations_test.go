@@ -3,6 +3,7 @@ package edgeworkers
 import (
 	"context"
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"regexp"
@@ -267,12 +268,13 @@ func TestGetActivation(t *testing.T) {
 
 func TestActivateVersion(t *testing.T) {
 	tests := map[string]struct {
-		params           ActivateVersionRequest
-		responseStatus   int
-		responseBody     string
-		expectedPath     string
-		expectedResponse *Activation
-		withError        error
+		params              ActivateVersionRequest
+		expectedRequestBody string
+		responseStatus      int
+		responseBody        string
+		expectedPath        string
+		expectedResponse    *Activation
+		withError           error
 	}{
 		"200 OK": {
 			params: ActivateVersionRequest{
@@ -308,6 +310,79 @@ func TestActivateVersion(t *testing.T) {
 				Version:          "1",
 			},
 		},
+		"201 Created with note": {
+			params: ActivateVersionRequest{
+				EdgeWorkerID: 42,
+				ActivateVersion: ActivateVersion{
+					Network: ActivationNetworkProduction,
+					Note:    "release 1.2",
+					Version: "2",
+				},
+			},
+			expectedRequestBody: `{"network":"PRODUCTION","note":"release 1.2","version":"2"}`,
+			responseStatus:      http.StatusCreated,
+			responseBody: `
+{
+	"edgeWorkerId": 42,
+	"version": "2",
+	"activationId": 3,
+	"accountId": "B-M-1KQK3WU",
+	"status": "PRESUBMIT",
+	"network": "PRODUCTION",
+	"note": "release 1.2",
+	"createdBy": "jsmith",
+	"createdTime": "2018-07-09T08:13:54Z",
+	"lastModifiedTime": "2018-07-09T08:13:54Z"
+}`,
+			expectedPath: "/edgeworkers/v1/ids/42/activations",
+			expectedResponse: &Activation{
+				AccountID:        "B-M-1KQK3WU",
+				ActivationID:     3,
+				CreatedBy:        "jsmith",
+				CreatedTime:      "2018-07-09T08:13:54Z",
+				EdgeWorkerID:     42,
+				LastModifiedTime: "2018-07-09T08:13:54Z",
+				Network:          "PRODUCTION",
+				Note:             "release 1.2",
+				Status:           "PRESUBMIT",
+				Version:          "2",
+			},
+		},
+		"201 Created with short production network": {
+			params: ActivateVersionRequest{
+				EdgeWorkerID: 42,
+				ActivateVersion: ActivateVersion{
+					Network: "prod",
+					Version: "2",
+				},
+			},
+			expectedRequestBody: `{"network":"PRODUCTION","version":"2"}`,
+			responseStatus:      http.StatusCreated,
+			responseBody: `
+{
+	"edgeWorkerId": 42,
+	"version": "2",
+	"activationId": 3,
+	"accountId": "B-M-1KQK3WU",
+	"status": "PRESUBMIT",
+	"network": "PRODUCTION",
+	"createdBy": "jsmith",
+	"createdTime": "2018-07-09T08:13:54Z",
+	"lastModifiedTime": "2018-07-09T08:13:54Z"
+}`,
+			expectedPath: "/edgeworkers/v1/ids/42/activations",
+			expectedResponse: &Activation{
+				AccountID:        "B-M-1KQK3WU",
+				ActivationID:     3,
+				CreatedBy:        "jsmith",
+				CreatedTime:      "2018-07-09T08:13:54Z",
+				EdgeWorkerID:     42,
+				LastModifiedTime: "2018-07-09T08:13:54Z",
+				Network:          "PRODUCTION",
+				Status:           "PRESUBMIT",
+				Version:          "2",
+			},
+		},
 		"500 internal server error": {
 			params: ActivateVersionRequest{
 				EdgeWorkerID: 42,
@@ -354,6 +429,15 @@ func TestActivateVersion(t *testing.T) {
 			},
 			withError: ErrStructValidation,
 		},
+		"missing version": {
+			params: ActivateVersionRequest{
+				EdgeWorkerID: 42,
+				ActivateVersion: ActivateVersion{
+					Network: ActivationNetworkStaging,
+				},
+			},
+			withError: ErrStructValidation,
+		},
 	}
 
 	for name, test := range tests {
@@ -361,6 +445,11 @@ func TestActivateVersion(t *testing.T) {
 			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				assert.Equal(t, test.expectedPath, r.URL.String())
 				assert.Equal(t, http.MethodPost, r.Method)
+				if test.expectedRequestBody != "" {
+					body, err := ioutil.ReadAll(r.Body)
+					assert.NoError(t, err)
+					assert.JSONEq(t, test.expectedRequestBody, string(body))
+				}
 				w.WriteHeader(test.responseStatus)
 				_, err := w.Write([]byte(test.responseBody))
 				assert.NoError(t, err)
@@ -3,8 +3,9 @@ package edgeworkers
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
 
 type (
@@ -32,19 +33,9 @@ func (e *edgeworkers) ListContracts(ctx context.Context) (*ListContractsResponse
 	logger.Debug("ListContracts")
 
 	uri := "/edgeworkers/v1/contracts"
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListContracts, err)
-	}
-
 	var result ListContractsResponse
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrListContracts, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrListContracts, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri, nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrListContracts, err: err}
 	}
 
 	return &result, nil
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -92,10 +93,7 @@ func (r *DeactivateVersionRequest) Validate() error {
 // Validate validates DeactivateVersion
 func (r *DeactivateVersion) Validate() error {
 	return validation.Errors{
-		"Network": validation.Validate(r.Network, validation.Required, validation.In(
-			ActivationNetworkProduction, ActivationNetworkStaging,
-		).Error(fmt.Sprintf("value '%s' is invalid. Must be one of: '%s' or '%s'",
-			r.Network, ActivationNetworkStaging, ActivationNetworkProduction))),
+		"Network": validation.Validate(r.Network, validation.Required, validation.By(validateNetwork)),
 		"Version": validation.Validate(r.Version, validation.Required),
 	}.Filter()
 }
@@ -136,19 +134,9 @@ func (e *edgeworkers) ListDeactivations(ctx context.Context, params ListDeactiva
 	}
 	uri.RawQuery = q.Encode()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListDeactivations, err.Error())
-	}
-
 	var result ListDeactivationsResponse
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrListDeactivations, err.Error())
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrListDeactivations, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri.String(), nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrListDeactivations, err: err}
 	}
 
 	return &result, nil
@@ -167,19 +155,11 @@ func (e *edgeworkers) DeactivateVersion(ctx context.Context, params DeactivateVe
 		return nil, fmt.Errorf("%w: failed to parse URL: %s", ErrDeactivateVersion, err.Error())
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrDeactivateVersion, err.Error())
-	}
+	params.DeactivateVersion.Network = params.DeactivateVersion.Network.normalize()
 
 	var result Deactivation
-	resp, err := e.Exec(req, &result, params.DeactivateVersion)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrDeactivateVersion, err.Error())
-	}
-
-	if resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("%s: %w", ErrDeactivateVersion, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodPost, uri.String(), params.DeactivateVersion, &result, http.StatusCreated); err != nil {
+		return nil, &requestError{operation: ErrDeactivateVersion, err: err}
 	}
 
 	return &result, nil
@@ -198,16 +178,9 @@ func (e *edgeworkers) GetDeactivation(ctx context.Context, params GetDeactivatio
 		return nil, fmt.Errorf("%w: failed to parse URL: %s", ErrGetDeactivation, err.Error())
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
-
 	var result Deactivation
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrGetDeactivation, err.Error())
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrGetDeactivation, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri.String(), nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrGetDeactivation, err: err}
 	}
 
 	return &result, nil
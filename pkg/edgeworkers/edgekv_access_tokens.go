@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strconv"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -176,19 +177,9 @@ func (e *edgeworkers) CreateEdgeKVAccessToken(ctx context.Context, params Create
 
 	uri := "/edgekv/v1/tokens"
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateEdgeKVAccessToken, err)
-	}
-
 	var result CreateEdgeKVAccessTokenResponse
-	resp, err := e.Exec(req, &result, params)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateEdgeKVAccessToken, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrCreateEdgeKVAccessToken, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodPost, uri, params, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrCreateEdgeKVAccessToken, err: err}
 	}
 
 	return &result, nil
@@ -206,19 +197,9 @@ func (e *edgeworkers) GetEdgeKVAccessToken(ctx context.Context, params GetEdgeKV
 	if err != nil {
 		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrGetEdgeKVAccessToken, err)
 	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetEdgeKVAccessToken, err)
-	}
-
 	var result GetEdgeKVAccessTokenResponse
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrGetEdgeKVAccessToken, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrGetEdgeKVAccessToken, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri.String(), nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrGetEdgeKVAccessToken, err: err}
 	}
 
 	return &result, nil
@@ -239,19 +220,9 @@ func (e *edgeworkers) ListEdgeKVAccessTokens(ctx context.Context, params ListEdg
 	}
 	uri.RawQuery = q.Encode()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListEdgeKVAccessToken, err)
-	}
-
 	var result ListEdgeKVAccessTokensResponse
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrListEdgeKVAccessToken, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrListEdgeKVAccessToken, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri.String(), nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrListEdgeKVAccessToken, err: err}
 	}
 
 	return &result, nil
@@ -269,19 +240,9 @@ func (e *edgeworkers) DeleteEdgeKVAccessToken(ctx context.Context, params Delete
 		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrDeleteEdgeKVAccessToken, err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri.String(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrDeleteEdgeKVAccessToken, err)
-	}
-
 	var result DeleteEdgeKVAccessTokenResponse
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrDeleteEdgeKVAccessToken, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrDeleteEdgeKVAccessToken, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodDelete, uri.String(), nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrDeleteEdgeKVAccessToken, err: err}
 	}
 
 	return &result, nil
@@ -7,7 +7,7 @@ import (
 	"net/http"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/edgegriderr"
-
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -48,19 +48,9 @@ func (e *edgeworkers) ListGroupsWithinNamespace(ctx context.Context, params List
 
 	uri := fmt.Sprintf("/edgekv/v1/networks/%s/namespaces/%s/groups", params.Network, params.NamespaceID)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListGroupsWithinNamespace, err)
-	}
-
 	var result []string
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrListGroupsWithinNamespace, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrListGroupsWithinNamespace, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri, nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrListGroupsWithinNamespace, err: err}
 	}
 
 	return result, nil
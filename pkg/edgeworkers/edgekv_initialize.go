@@ -3,8 +3,9 @@ package edgeworkers
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
 
 // EdgeKVInitialize is EdgeKV Initialize API interface
@@ -43,19 +44,9 @@ func (e *edgeworkers) InitializeEdgeKV(ctx context.Context) (*EdgeKVInitializati
 	logger.Debug("InitializeEdgeKV")
 
 	uri := "/edgekv/v1/initialize"
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrInitializeEdgeKV, err)
-	}
-
 	var result EdgeKVInitializationStatus
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrInitializeEdgeKV, err)
-	}
-
-	if resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("%s: %w", ErrInitializeEdgeKV, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodPut, uri, nil, &result, http.StatusCreated); err != nil {
+		return nil, &requestError{operation: ErrInitializeEdgeKV, err: err}
 	}
 
 	return &result, nil
@@ -66,19 +57,9 @@ func (e *edgeworkers) GetEdgeKVInitializationStatus(ctx context.Context) (*EdgeK
 	logger.Debug("GetEdgeKVInitializationStatus")
 
 	uri := "/edgekv/v1/initialize"
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetEdgeKVInitialize, err)
-	}
-
 	var result EdgeKVInitializationStatus
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrGetEdgeKVInitialize, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrGetEdgeKVInitialize, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri, nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrGetEdgeKVInitialize, err: err}
 	}
 
 	return &result, nil
@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -154,19 +155,9 @@ func (e *edgeworkers) ListItems(ctx context.Context, params ListItemsRequest) (*
 
 	uri := fmt.Sprintf("/edgekv/v1/networks/%s/namespaces/%s/groups/%s", params.Network, params.NamespaceID, params.GroupID)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListItems, err)
-	}
-
 	var result ListItemsResponse
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrListItems, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrListItems, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri, nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrListItems, err: err}
 	}
 
 	return &result, nil
@@ -183,18 +174,9 @@ func (e *edgeworkers) GetItem(ctx context.Context, params GetItemRequest) (*Item
 	uri := fmt.Sprintf("/edgekv/v1/networks/%s/namespaces/%s/groups/%s/items/%s", params.Network,
 		params.NamespaceID, params.GroupID, params.ItemID)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetItem, err)
-	}
-
-	resp, err := e.Exec(req, nil)
+	resp, err := session.DoRequest(ctx, e, http.MethodGet, uri, nil, nil, http.StatusOK)
 	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrGetItem, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrGetItem, e.Error(resp))
+		return nil, &requestError{operation: ErrGetItem, err: err}
 	}
 
 	data, err := ioutil.ReadAll(resp.Body)
@@ -257,18 +239,9 @@ func (e *edgeworkers) DeleteItem(ctx context.Context, params DeleteItemRequest)
 	uri := fmt.Sprintf("/edgekv/v1/networks/%s/namespaces/%s/groups/%s/items/%s", params.Network,
 		params.NamespaceID, params.GroupID, params.ItemID)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrDeleteItem, err)
-	}
-
-	resp, err := e.Exec(req, nil)
+	resp, err := session.DoRequest(ctx, e, http.MethodDelete, uri, nil, nil, http.StatusOK)
 	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrDeleteItem, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrDeleteItem, e.Error(resp))
+		return nil, &requestError{operation: ErrDeleteItem, err: err}
 	}
 
 	data, err := ioutil.ReadAll(resp.Body)
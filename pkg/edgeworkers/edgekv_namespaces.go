@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -186,19 +187,9 @@ func (e *edgeworkers) ListEdgeKVNamespaces(ctx context.Context, params ListEdgeK
 		uri.RawQuery = q.Encode()
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListEdgeKVNamespace, err.Error())
-	}
-
 	var result ListEdgeKVNamespacesResponse
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrListEdgeKVNamespace, err.Error())
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrListEdgeKVNamespace, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri.String(), nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrListEdgeKVNamespace, err: err}
 	}
 
 	return &result, nil
@@ -213,19 +204,9 @@ func (e *edgeworkers) GetEdgeKVNamespace(ctx context.Context, params GetEdgeKVNa
 	}
 
 	uri := fmt.Sprintf("/edgekv/v1/networks/%s/namespaces/%s", params.Network, params.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetEdgeKVNamespace, err.Error())
-	}
-
 	var result Namespace
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrGetEdgeKVNamespace, err.Error())
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrGetEdgeKVNamespace, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri, nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrGetEdgeKVNamespace, err: err}
 	}
 
 	return &result, nil
@@ -240,19 +221,9 @@ func (e *edgeworkers) CreateEdgeKVNamespace(ctx context.Context, params CreateEd
 	}
 
 	uri := fmt.Sprintf("/edgekv/v1/networks/%s/namespaces", params.Network)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateEdgeKVNamespace, err.Error())
-	}
-
 	var result Namespace
-	resp, err := e.Exec(req, &result, params.Namespace)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateEdgeKVNamespace, err.Error())
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrCreateEdgeKVNamespace, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodPost, uri, params.Namespace, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrCreateEdgeKVNamespace, err: err}
 	}
 
 	return &result, nil
@@ -267,19 +238,9 @@ func (e *edgeworkers) UpdateEdgeKVNamespace(ctx context.Context, params UpdateEd
 	}
 
 	uri := fmt.Sprintf("/edgekv/v1/networks/%s/namespaces/%s", params.Network, params.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrUpdateEdgeKVNamespace, err.Error())
-	}
-
 	var result Namespace
-	resp, err := e.Exec(req, &result, params.UpdateNamespace)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrUpdateEdgeKVNamespace, err.Error())
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrUpdateEdgeKVNamespace, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodPut, uri, params.UpdateNamespace, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrUpdateEdgeKVNamespace, err: err}
 	}
 
 	return &result, nil
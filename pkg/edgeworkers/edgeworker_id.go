@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -174,19 +175,9 @@ func (e *edgeworkers) GetEdgeWorkerID(ctx context.Context, params GetEdgeWorkerI
 
 	uri := fmt.Sprintf("/edgeworkers/v1/ids/%d", params.EdgeWorkerID)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetEdgeWorkerID, err)
-	}
-
 	var result EdgeWorkerID
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrGetEdgeWorkerID, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrGetEdgeWorkerID, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri, nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrGetEdgeWorkerID, err: err}
 	}
 
 	return &result, nil
@@ -209,19 +200,9 @@ func (e *edgeworkers) ListEdgeWorkersID(ctx context.Context, params ListEdgeWork
 	}
 	uri.RawQuery = q.Encode()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListEdgeWorkersID, err)
-	}
-
 	var result ListEdgeWorkersIDResponse
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrListEdgeWorkersID, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrListEdgeWorkersID, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri.String(), nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrListEdgeWorkersID, err: err}
 	}
 
 	return &result, nil
@@ -240,19 +221,9 @@ func (e *edgeworkers) CreateEdgeWorkerID(ctx context.Context, params CreateEdgeW
 		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrCreateEdgeWorkerID, err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateEdgeWorkerID, err)
-	}
-
 	var result EdgeWorkerID
-	resp, err := e.Exec(req, &result, params)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateEdgeWorkerID, err)
-	}
-
-	if resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("%s: %w", ErrCreateEdgeWorkerID, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodPost, uri.String(), params, &result, http.StatusCreated); err != nil {
+		return nil, &requestError{operation: ErrCreateEdgeWorkerID, err: err}
 	}
 
 	return &result, nil
@@ -271,19 +242,9 @@ func (e *edgeworkers) UpdateEdgeWorkerID(ctx context.Context, params UpdateEdgeW
 		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrUpdateEdgeWorkerID, err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri.String(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrUpdateEdgeWorkerID, err)
-	}
-
 	var result EdgeWorkerID
-	resp, err := e.Exec(req, &result, params.EdgeWorkerIDBodyRequest)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrUpdateEdgeWorkerID, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrUpdateEdgeWorkerID, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodPut, uri.String(), params.EdgeWorkerIDBodyRequest, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrUpdateEdgeWorkerID, err: err}
 	}
 
 	return &result, nil
@@ -302,19 +263,9 @@ func (e *edgeworkers) CloneEdgeWorkerID(ctx context.Context, params CloneEdgeWor
 		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrCloneEdgeWorkerID, err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCloneEdgeWorkerID, err)
-	}
-
 	var result EdgeWorkerID
-	resp, err := e.Exec(req, &result, params.EdgeWorkerIDBodyRequest)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrCloneEdgeWorkerID, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrCloneEdgeWorkerID, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodPost, uri.String(), params.EdgeWorkerIDBodyRequest, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrCloneEdgeWorkerID, err: err}
 	}
 
 	return &result, nil
@@ -332,18 +283,8 @@ func (e *edgeworkers) DeleteEdgeWorkerID(ctx context.Context, params DeleteEdgeW
 		return fmt.Errorf("%w: failed to parse url: %s", ErrDeleteEdgeWorkerID, err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri.String(), nil)
-	if err != nil {
-		return fmt.Errorf("%w: failed to create request: %s", ErrDeleteEdgeWorkerID, err)
-	}
-
-	resp, err := e.Exec(req, nil)
-	if err != nil {
-		return fmt.Errorf("%w: request failed: %s", ErrDeleteEdgeWorkerID, err)
-	}
-
-	if resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("%s: %w", ErrDeleteEdgeWorkerID, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodDelete, uri.String(), nil, nil, http.StatusNoContent); err != nil {
+		return &requestError{operation: ErrDeleteEdgeWorkerID, err: err}
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package edgeworkers
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
@@ -8,7 +9,12 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -128,6 +134,46 @@ func (g DeleteEdgeWorkerVersionRequest) Validate() error {
 	}.Filter()
 }
 
+// SortByCreatedTime sorts EdgeWorkerVersions from the oldest to the newest one, based on their CreatedTime
+func (r *ListEdgeWorkerVersionsResponse) SortByCreatedTime() {
+	sort.SliceStable(r.EdgeWorkerVersions, func(i, j int) bool {
+		return createdBefore(r.EdgeWorkerVersions[i].CreatedTime, r.EdgeWorkerVersions[j].CreatedTime)
+	})
+}
+
+// SortByVersion sorts EdgeWorkerVersions from the lowest to the highest version.
+// Versions are compared by their dot-separated numeric parts, e.g. "1.10" is higher than "1.9"
+func (r *ListEdgeWorkerVersionsResponse) SortByVersion() {
+	sort.SliceStable(r.EdgeWorkerVersions, func(i, j int) bool {
+		return versionLess(r.EdgeWorkerVersions[i].Version, r.EdgeWorkerVersions[j].Version)
+	})
+}
+
+func createdBefore(a, b string) bool {
+	timeA, errA := time.Parse(time.RFC3339, a)
+	timeB, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return a < b
+	}
+	return timeA.Before(timeB)
+}
+
+func versionLess(a, b string) bool {
+	partsA, partsB := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		if partsA[i] == partsB[i] {
+			continue
+		}
+		numA, errA := strconv.Atoi(partsA[i])
+		numB, errB := strconv.Atoi(partsB[i])
+		if errA != nil || errB != nil {
+			return partsA[i] < partsB[i]
+		}
+		return numA < numB
+	}
+	return len(partsA) < len(partsB)
+}
+
 var (
 	// ErrGetEdgeWorkerVersion is returned in case an error occurs on GetEdgeWorkerVersion operation
 	ErrGetEdgeWorkerVersion = errors.New("get an EdgeWorker Version")
@@ -150,19 +196,9 @@ func (e *edgeworkers) GetEdgeWorkerVersion(ctx context.Context, params GetEdgeWo
 	}
 
 	uri := fmt.Sprintf("/edgeworkers/v1/ids/%d/versions/%s", params.EdgeWorkerID, params.Version)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetEdgeWorkerVersion, err)
-	}
-
 	var result EdgeWorkerVersion
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrGetEdgeWorkerVersion, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrGetEdgeWorkerVersion, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri, nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrGetEdgeWorkerVersion, err: err}
 	}
 
 	return &result, nil
@@ -177,19 +213,9 @@ func (e *edgeworkers) ListEdgeWorkerVersions(ctx context.Context, params ListEdg
 	}
 
 	uri := fmt.Sprintf("/edgeworkers/v1/ids/%d/versions", params.EdgeWorkerID)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListEdgeWorkerVersions, err)
-	}
-
 	var result ListEdgeWorkerVersionsResponse
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrListEdgeWorkerVersions, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrListEdgeWorkerVersions, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri, nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrListEdgeWorkerVersions, err: err}
 	}
 
 	return &result, nil
@@ -238,8 +264,17 @@ func (e *edgeworkers) CreateEdgeWorkerVersion(ctx context.Context, params Create
 		return nil, fmt.Errorf("%s: %w:\n%s", ErrCreateEdgeWorkerVersion, ErrStructValidation, err)
 	}
 
+	// peek a single byte so an empty bundle is rejected without reading the whole archive upfront
+	bundle := bufio.NewReader(params.ContentBundle)
+	if _, err := bundle.Peek(1); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%s: %w:\nContentBundle: cannot be empty", ErrCreateEdgeWorkerVersion, ErrStructValidation)
+		}
+		return nil, fmt.Errorf("%w: failed to read content bundle: %s", ErrCreateEdgeWorkerVersion, err)
+	}
+
 	uri := fmt.Sprintf("/edgeworkers/v1/ids/%d/versions", params.EdgeWorkerID)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, ioutil.NopCloser(params.ContentBundle))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, ioutil.NopCloser(bundle))
 	if err != nil {
 		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateEdgeWorkerVersion, err)
 	}
@@ -266,18 +301,8 @@ func (e *edgeworkers) DeleteEdgeWorkerVersion(ctx context.Context, params Delete
 	}
 
 	uri := fmt.Sprintf("/edgeworkers/v1/ids/%d/versions/%s", params.EdgeWorkerID, params.Version)
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri, nil)
-	if err != nil {
-		return fmt.Errorf("%w: failed to create request: %s", ErrDeleteEdgeWorkerVersion, err)
-	}
-
-	resp, err := e.Exec(req, nil)
-	if err != nil {
-		return fmt.Errorf("%w: request failed: %s", ErrDeleteEdgeWorkerVersion, err)
-	}
-
-	if resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("%s: %w", ErrDeleteEdgeWorkerVersion, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodDelete, uri, nil, nil, http.StatusNoContent); err != nil {
+		return &requestError{operation: ErrDeleteEdgeWorkerVersion, err: err}
 	}
 
 	return nil
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
@@ -251,6 +252,23 @@ func TestListEdgeWorkerVersions(t *testing.T) {
 			params:    ListEdgeWorkerVersionsRequest{},
 			withError: ErrStructValidation,
 		},
+		"404 Not Found - EdgeWorkerID doesn't exist": {
+			params: ListEdgeWorkerVersionsRequest{
+				EdgeWorkerID: 88334,
+			},
+			responseStatus: http.StatusNotFound,
+			responseBody: `
+{
+    "type": "/edgeworkers/error-types/edgeworkers-not-found",
+    "title": "The given resource could not be found.",
+    "detail": "Unable to find the requested EdgeWorker ID",
+    "instance": "/edgeworkers/error-instances/86d1cc10-4baf-49e1-b81a-075b72a2f6a4",
+    "status": 404,
+    "errorCode": "EW2002"
+}`,
+			expectedPath: "/edgeworkers/v1/ids/88334/versions",
+			withError:    ErrEdgeWorkerNotFound,
+		},
 		"500 internal server error": {
 			params: ListEdgeWorkerVersionsRequest{
 				EdgeWorkerID: 88334,
@@ -337,6 +355,36 @@ func TestListEdgeWorkerVersions(t *testing.T) {
 	}
 }
 
+func TestListEdgeWorkerVersionsResponse_Sort(t *testing.T) {
+	versions := func() *ListEdgeWorkerVersionsResponse {
+		return &ListEdgeWorkerVersionsResponse{[]EdgeWorkerVersion{
+			{Version: "1.10", CreatedTime: "2021-12-20T09:39:48Z"},
+			{Version: "1.9.1", CreatedTime: "2021-12-21T08:28:37Z"},
+			{Version: "0.1", CreatedTime: "2021-12-19T10:00:00Z"},
+			{Version: "1.9", CreatedTime: "2021-12-20T08:28:37Z"},
+		}}
+	}
+	versionNames := func(r *ListEdgeWorkerVersionsResponse) []string {
+		var names []string
+		for _, v := range r.EdgeWorkerVersions {
+			names = append(names, v.Version)
+		}
+		return names
+	}
+
+	t.Run("by created time", func(t *testing.T) {
+		res := versions()
+		res.SortByCreatedTime()
+		assert.Equal(t, []string{"0.1", "1.9", "1.10", "1.9.1"}, versionNames(res))
+	})
+
+	t.Run("by version", func(t *testing.T) {
+		res := versions()
+		res.SortByVersion()
+		assert.Equal(t, []string{"0.1", "1.9", "1.9.1", "1.10"}, versionNames(res))
+	})
+}
+
 func TestGetEdgeWorkerVersionContent(t *testing.T) {
 	tests := map[string]struct {
 		params         GetEdgeWorkerVersionContentRequest
@@ -530,6 +578,13 @@ func TestCreateEdgeWorkerVersion(t *testing.T) {
 			},
 			withError: ErrStructValidation,
 		},
+		"empty ContentBundle": {
+			params: CreateEdgeWorkerVersionRequest{
+				EdgeWorkerID:  88334,
+				ContentBundle: Bundle{bytes.NewBuffer(nil)},
+			},
+			withError: ErrStructValidation,
+		},
 		"500 internal server error": {
 			params: CreateEdgeWorkerVersionRequest{
 				EdgeWorkerID:  88334,
@@ -602,8 +657,12 @@ func TestCreateEdgeWorkerVersion(t *testing.T) {
 			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				assert.Equal(t, test.expectedPath, r.URL.String())
 				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/gzip", r.Header.Get("Content-Type"))
+				body, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, "testing create", string(body))
 				w.WriteHeader(test.responseStatus)
-				_, err := w.Write([]byte(test.responseBody))
+				_, err = w.Write([]byte(test.responseBody))
 				assert.NoError(t, err)
 			}))
 			client := mockAPIClient(t, mockServer)
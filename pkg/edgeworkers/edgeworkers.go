@@ -2,7 +2,10 @@
 package edgeworkers
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net/http"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
@@ -10,8 +13,14 @@ import (
 var (
 	// ErrStructValidation is returned when given struct validation failed
 	ErrStructValidation = errors.New("struct validation")
+
+	// ErrCheckAPIVersion is returned in case an error occurs on CheckAPIVersion operation
+	ErrCheckAPIVersion = errors.New("check API version")
 )
 
+// APIVersion is the version of the EdgeWorkers and EdgeKV APIs used by the client, as in /edgeworkers/v1 paths
+const APIVersion = "v1"
+
 type (
 	// Edgeworkers is the api interface for EdgeWorkers and EdgeKV
 	Edgeworkers interface {
@@ -31,6 +40,12 @@ type (
 		ResourceTiers
 		SecureTokens
 		Validations
+
+		// CheckAPIVersion verifies that APIVersion of the EdgeWorkers API is available for the account.
+		// If it is not, the returned error wraps *session.APIVersionError.
+		//
+		// See: https://techdocs.akamai.com/edgeworkers/reference/get-limits
+		CheckAPIVersion(context.Context) error
 	}
 
 	edgeworkers struct {
@@ -55,3 +70,23 @@ func Client(sess session.Session, opts ...Option) Edgeworkers {
 	}
 	return e
 }
+
+func (e *edgeworkers) CheckAPIVersion(ctx context.Context) error {
+	logger := e.Log(ctx)
+	logger.Debug("CheckAPIVersion")
+
+	uri := fmt.Sprintf("/edgeworkers/%s/limits", APIVersion)
+	if err := session.CheckAPIVersion(ctx, e, "edgeworkers", APIVersion, uri); err != nil {
+		return fmt.Errorf("%s: %w", ErrCheckAPIVersion, err)
+	}
+
+	return nil
+}
+
+// WithDefaultHeaders adds the headers to every request made by the client, e.g. a static team identifier.
+// Headers set by the client methods and with session.WithContextHeaders take precedence, see session.WithDefaultHeaders.
+func WithDefaultHeaders(h http.Header) Option {
+	return func(c *edgeworkers) {
+		c.Session = session.WithDefaultHeaders(c.Session, h)
+	}
+}
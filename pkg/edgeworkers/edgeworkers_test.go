@@ -1,8 +1,10 @@
 package edgeworkers
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -60,3 +62,102 @@ func TestClient(t *testing.T) {
 		})
 	}
 }
+
+func TestEdgeworkers_CheckAPIVersion(t *testing.T) {
+	tests := map[string]struct {
+		responseStatus int
+		responseBody   string
+		withError      error
+	}{
+		"200 OK - compatible": {
+			responseStatus: http.StatusOK,
+			responseBody:   `{"limits": []}`,
+		},
+		"404 not found - incompatible": {
+			responseStatus: http.StatusNotFound,
+			responseBody:   `{"type": "not_found", "title": "Not Found", "status": 404}`,
+			withError:      session.ErrUnsupportedAPIVersion,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/edgeworkers/v1/limits", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			err := client.CheckAPIVersion(context.Background())
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				var versionErr *session.APIVersionError
+				require.True(t, errors.As(err, &versionErr))
+				assert.Equal(t, session.APIVersionError{API: "edgeworkers", Version: APIVersion, StatusCode: http.StatusNotFound}, *versionErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestEdgeworkers_RequestErrorMatchesOperation(t *testing.T) {
+	tests := map[string]struct {
+		call      func(Edgeworkers) error
+		withError error
+	}{
+		"ListDeactivations": {
+			call: func(c Edgeworkers) error {
+				_, err := c.ListDeactivations(context.Background(), ListDeactivationsRequest{EdgeWorkerID: 42})
+				return err
+			},
+			withError: ErrListDeactivations,
+		},
+		"GetEdgeWorkerID": {
+			call: func(c Edgeworkers) error {
+				_, err := c.GetEdgeWorkerID(context.Background(), GetEdgeWorkerIDRequest{EdgeWorkerID: 42})
+				return err
+			},
+			withError: ErrGetEdgeWorkerID,
+		},
+		"ActivateVersion": {
+			call: func(c Edgeworkers) error {
+				_, err := c.ActivateVersion(context.Background(), ActivateVersionRequest{
+					EdgeWorkerID:    42,
+					ActivateVersion: ActivateVersion{Network: ActivationNetworkStaging, Version: "1.0"},
+				})
+				return err
+			},
+			withError: ErrActivateVersion,
+		},
+		"GetReport": {
+			call: func(c Edgeworkers) error {
+				_, err := c.GetReport(context.Background(), GetReportRequest{
+					ReportID:   2,
+					Start:      "2021-12-04T00:00:00.000Z",
+					EdgeWorker: "42",
+				})
+				return err
+			},
+			withError: ErrGetReport,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Error("request should not reach the closed server")
+			}))
+			client := mockAPIClient(t, mockServer)
+			mockServer.Close()
+
+			err := test.call(client)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+			var urlErr *url.Error
+			assert.True(t, errors.As(err, &urlErr), "want: *url.Error; got: %s", err)
+		})
+	}
+}
@@ -42,6 +42,8 @@ const (
 var (
 	// ErrNotFound is returned when edgeKV resource does not exist
 	ErrNotFound = errors.New("specified edgeKV resource does not exist")
+	// ErrEdgeWorkerNotFound is returned when edgeworkers resource, e.g. an EdgeWorker ID or its version, does not exist
+	ErrEdgeWorkerNotFound = errors.New("specified edgeworkers resource does not exist")
 	// ErrVersionBeingDeactivated is returned when edgeworkers version is currently being deactivated
 	ErrVersionBeingDeactivated = errors.New("version is being deactivated")
 	// ErrVersionAlreadyDeactivated is returned when edgeworkers version is already deactivated
@@ -82,6 +84,10 @@ func (e *Error) Is(target error) bool {
 	if errors.Is(target, ErrNotFound) {
 		return e.Status == http.StatusNotFound && e.ErrorCode == errorCodeNotFound
 	}
+	// compared directly, as errors.Is would match any 404 *Error target and break comparisons between API errors
+	if target == ErrEdgeWorkerNotFound {
+		return e.Status == http.StatusNotFound && e.ErrorCode != errorCodeNotFound
+	}
 	if errors.Is(target, ErrVersionBeingDeactivated) {
 		return e.ErrorCode == errorCodeVersionIsBeingDeactivated
 	}
@@ -104,3 +110,25 @@ func (e *Error) Is(target error) bool {
 
 	return e.Error() == t.Error()
 }
+
+// requestError is returned when a request of an operation fails. It matches the sentinel error of the operation,
+// e.g. ErrListDeactivations, with errors.Is, while the cause, e.g. context.DeadlineExceeded or *Error,
+// can still be checked with errors.Is and errors.As.
+type requestError struct {
+	operation error
+	err       error
+}
+
+func (e *requestError) Error() string {
+	return fmt.Sprintf("%s: %s", e.operation, e.err)
+}
+
+// Is allows the error to be matched with the sentinel error of the operation
+func (e *requestError) Is(target error) bool {
+	return target == e.operation
+}
+
+// Unwrap returns the cause of the error
+func (e *requestError) Unwrap() error {
+	return e.err
+}
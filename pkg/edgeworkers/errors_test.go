@@ -1,6 +1,7 @@
 package edgeworkers
 
 import (
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -99,3 +100,9 @@ func TestIs(t *testing.T) {
 		})
 	}
 }
+
+func TestIsEdgeWorkerNotFound(t *testing.T) {
+	assert.True(t, errors.Is(&Error{Status: http.StatusNotFound, ErrorCode: "EW2002"}, ErrEdgeWorkerNotFound))
+	assert.False(t, errors.Is(&Error{Status: http.StatusNotFound, ErrorCode: errorCodeNotFound}, ErrEdgeWorkerNotFound))
+	assert.False(t, errors.Is(&Error{Status: http.StatusForbidden}, ErrEdgeWorkerNotFound))
+}
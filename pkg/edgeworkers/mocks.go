@@ -14,6 +14,11 @@ type Mock struct {
 
 var _ Edgeworkers = &Mock{}
 
+func (m *Mock) CheckAPIVersion(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
 // Activations
 
 func (m *Mock) ListActivations(ctx context.Context, req ListActivationsRequest) (*ListActivationsResponse, error) {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -65,19 +66,9 @@ func (e *edgeworkers) GetPermissionGroup(ctx context.Context, params GetPermissi
 
 	uri := fmt.Sprintf("/edgeworkers/v1/groups/%s", params.GroupID)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetPermissionGroup, err)
-	}
-
 	var result PermissionGroup
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrGetPermissionGroup, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrGetPermissionGroup, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri, nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrGetPermissionGroup, err: err}
 	}
 
 	return &result, nil
@@ -89,19 +80,9 @@ func (e *edgeworkers) ListPermissionGroups(ctx context.Context) (*ListPermission
 
 	uri := fmt.Sprintf("/edgeworkers/v1/groups")
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrListPermissionGroups, err)
-	}
-
 	var result ListPermissionGroupsResponse
-	resp, err := e.Exec(req, &result)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrListPermissionGroups, err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s: %w", ErrListPermissionGroups, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodGet, uri, nil, &result, http.StatusOK); err != nil {
+		return nil, &requestError{operation: ErrListPermissionGroups, err: err}
 	}
 
 	return &result, nil
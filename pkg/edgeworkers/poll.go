@@ -0,0 +1,158 @@
+package edgeworkers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
+)
+
+type (
+	// PollOptions configures polling of PollActivation and PollDeactivation
+	PollOptions struct {
+		// Interval is the time between consecutive status checks. DefaultPollInterval is used if it is not set.
+		Interval time.Duration
+	}
+
+	// ActivationFailedError is returned by PollActivation and PollDeactivation when the activation or deactivation
+	// ends in a failed state
+	ActivationFailedError struct {
+		// Operation is either "activation" or "deactivation"
+		Operation    string
+		ID           int
+		EdgeWorkerID int
+		Version      string
+		Status       string
+	}
+)
+
+const (
+	// ActivationStatusPresubmit is the status of an activation or deactivation which was not submitted yet
+	ActivationStatusPresubmit = "PRESUBMIT"
+	// ActivationStatusPending is the status of an activation or deactivation which is waiting to be processed
+	ActivationStatusPending = "PENDING"
+	// ActivationStatusInProgress is the status of an activation or deactivation which is being processed
+	ActivationStatusInProgress = "IN_PROGRESS"
+	// ActivationStatusComplete is the status of a successful activation or deactivation
+	ActivationStatusComplete = "COMPLETE"
+	// ActivationStatusAborted is the status of a cancelled activation or deactivation
+	ActivationStatusAborted = "ABORTED"
+	// ActivationStatusError is the status of a failed activation or deactivation
+	ActivationStatusError = "ERROR"
+
+	// DefaultPollInterval is the interval used by PollActivation and PollDeactivation if none is given
+	DefaultPollInterval = 30 * time.Second
+)
+
+var (
+	// ErrActivationFailed is returned when an activation or deactivation ends in a failed state
+	ErrActivationFailed = errors.New("activation failed")
+	// ErrPollActivation is returned when PollActivation fails
+	ErrPollActivation = errors.New("poll activation")
+	// ErrPollDeactivation is returned when PollDeactivation fails
+	ErrPollDeactivation = errors.New("poll deactivation")
+)
+
+// Error returns the error message
+func (e *ActivationFailedError) Error() string {
+	return fmt.Sprintf("%s %d of EdgeWorker %d version %s ended with status %s", e.Operation, e.ID, e.EdgeWorkerID, e.Version, e.Status)
+}
+
+// Is allows the error to be matched with ErrActivationFailed
+func (e *ActivationFailedError) Is(target error) bool {
+	return target == ErrActivationFailed
+}
+
+// PollActivation gets the activation using the client every PollOptions.Interval until its status is terminal.
+// It returns the completed activation, or *ActivationFailedError if it was aborted or failed.
+// Server errors are treated as transient and polling continues; it stops with an error when the context is done
+// or the next check could not be made before its deadline.
+func PollActivation(ctx context.Context, client Activations, params GetActivationRequest, opts PollOptions) (*Activation, error) {
+	var activation *Activation
+	status, err := pollStatus(ctx, opts, func(ctx context.Context) (string, error) {
+		a, err := client.GetActivation(ctx, params)
+		if err != nil {
+			return "", err
+		}
+		activation = a
+		return a.Status, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrPollActivation, err)
+	}
+	if status != ActivationStatusComplete {
+		return nil, fmt.Errorf("%s: %w", ErrPollActivation, &ActivationFailedError{
+			Operation:    "activation",
+			ID:           activation.ActivationID,
+			EdgeWorkerID: activation.EdgeWorkerID,
+			Version:      activation.Version,
+			Status:       status,
+		})
+	}
+
+	return activation, nil
+}
+
+// PollDeactivation gets the deactivation using the client every PollOptions.Interval until its status is terminal.
+// It returns the completed deactivation, or *ActivationFailedError if it was aborted or failed.
+// Server errors are treated as transient and polling continues; it stops with an error when the context is done
+// or the next check could not be made before its deadline.
+func PollDeactivation(ctx context.Context, client Deactivations, params GetDeactivationRequest, opts PollOptions) (*Deactivation, error) {
+	var deactivation *Deactivation
+	status, err := pollStatus(ctx, opts, func(ctx context.Context) (string, error) {
+		d, err := client.GetDeactivation(ctx, params)
+		if err != nil {
+			return "", err
+		}
+		deactivation = d
+		return d.Status, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrPollDeactivation, err)
+	}
+	if status != ActivationStatusComplete {
+		return nil, fmt.Errorf("%s: %w", ErrPollDeactivation, &ActivationFailedError{
+			Operation:    "deactivation",
+			ID:           deactivation.DeactivationID,
+			EdgeWorkerID: deactivation.EdgeWorkerID,
+			Version:      deactivation.Version,
+			Status:       status,
+		})
+	}
+
+	return deactivation, nil
+}
+
+// pollStatus calls get every interval until it returns a terminal status or a non-transient error
+func pollStatus(ctx context.Context, opts PollOptions, get func(context.Context) (string, error)) (string, error) {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	for {
+		status, err := get(ctx)
+		if err != nil && !isServerError(err) {
+			return "", err
+		}
+		if err == nil {
+			switch status {
+			case ActivationStatusComplete, ActivationStatusAborted, ActivationStatusError:
+				return status, nil
+			}
+		}
+
+		if err := session.Wait(ctx, interval); err != nil {
+			return "", err
+		}
+	}
+}
+
+// isServerError reports whether the error is an API error with 5xx status, which may succeed when retried
+func isServerError(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.Status >= http.StatusInternalServerError
+}
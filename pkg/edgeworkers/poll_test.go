@@ -0,0 +1,141 @@
+package edgeworkers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPollDeactivation(t *testing.T) {
+	params := GetDeactivationRequest{EdgeWorkerID: 42, DeactivationID: 3}
+	deactivation := func(status string) *Deactivation {
+		return &Deactivation{EdgeWorkerID: 42, DeactivationID: 3, Version: "1.0", Status: status}
+	}
+	serverErr := &Error{Type: "/edgeworkers/error-types/internal-server-error", Title: "Internal Server Error", Status: http.StatusInternalServerError}
+	notFoundErr := &Error{Type: "/edgeworkers/error-types/not-found", Title: "Not Found", Status: http.StatusNotFound}
+
+	type result struct {
+		deactivation *Deactivation
+		err          error
+	}
+	tests := map[string]struct {
+		results          []result
+		timeout          time.Duration
+		expectedCalls    int
+		expectedResponse *Deactivation
+		withError        func(*testing.T, error)
+	}{
+		"pending, then complete": {
+			results: []result{
+				{deactivation: deactivation(ActivationStatusPending)},
+				{deactivation: deactivation(ActivationStatusInProgress)},
+				{deactivation: deactivation(ActivationStatusComplete)},
+			},
+			expectedCalls:    3,
+			expectedResponse: deactivation(ActivationStatusComplete),
+		},
+		"transient server error is retried": {
+			results: []result{
+				{err: serverErr},
+				{deactivation: deactivation(ActivationStatusComplete)},
+			},
+			expectedCalls:    2,
+			expectedResponse: deactivation(ActivationStatusComplete),
+		},
+		"aborted": {
+			results: []result{
+				{deactivation: deactivation(ActivationStatusPending)},
+				{deactivation: deactivation(ActivationStatusAborted)},
+			},
+			expectedCalls: 2,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrActivationFailed), "want: %s; got: %s", ErrActivationFailed, err)
+				var failedErr *ActivationFailedError
+				require.True(t, errors.As(err, &failedErr))
+				assert.Equal(t, ActivationFailedError{Operation: "deactivation", ID: 3, EdgeWorkerID: 42, Version: "1.0", Status: ActivationStatusAborted}, *failedErr)
+				assert.Equal(t, "poll deactivation: deactivation 3 of EdgeWorker 42 version 1.0 ended with status ABORTED", err.Error())
+			},
+		},
+		"not found error is not retried": {
+			results:       []result{{err: notFoundErr}},
+			expectedCalls: 1,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, notFoundErr), "want: %s; got: %s", notFoundErr, err)
+			},
+		},
+		"context timeout": {
+			results: []result{
+				{deactivation: deactivation(ActivationStatusPending)},
+				{deactivation: deactivation(ActivationStatusPending)},
+				{deactivation: deactivation(ActivationStatusPending)},
+			},
+			timeout: 50 * time.Millisecond,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, context.DeadlineExceeded), "want: %s; got: %s", context.DeadlineExceeded, err)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &Mock{}
+			for _, r := range test.results {
+				if r.err != nil {
+					client.On("GetDeactivation", mock.Anything, params).Return(nil, r.err).Once()
+					continue
+				}
+				client.On("GetDeactivation", mock.Anything, params).Return(r.deactivation, nil).Once()
+			}
+
+			ctx := context.Background()
+			if test.timeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.timeout)
+				defer cancel()
+			}
+			result, err := PollDeactivation(ctx, client, params, PollOptions{Interval: 30 * time.Millisecond})
+			if test.expectedCalls != 0 {
+				client.AssertNumberOfCalls(t, "GetDeactivation", test.expectedCalls)
+			}
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestPollActivation(t *testing.T) {
+	params := GetActivationRequest{EdgeWorkerID: 42, ActivationID: 7}
+	activation := func(status string) *Activation {
+		return &Activation{EdgeWorkerID: 42, ActivationID: 7, Version: "2.0", Status: status}
+	}
+
+	t.Run("pending, then complete", func(t *testing.T) {
+		client := &Mock{}
+		client.On("GetActivation", mock.Anything, params).Return(activation(ActivationStatusPresubmit), nil).Once()
+		client.On("GetActivation", mock.Anything, params).Return(activation(ActivationStatusComplete), nil).Once()
+
+		result, err := PollActivation(context.Background(), client, params, PollOptions{Interval: time.Millisecond})
+		require.NoError(t, err)
+		assert.Equal(t, activation(ActivationStatusComplete), result)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("error state", func(t *testing.T) {
+		client := &Mock{}
+		client.On("GetActivation", mock.Anything, params).Return(activation(ActivationStatusError), nil).Once()
+
+		_, err := PollActivation(context.Background(), client, params, PollOptions{Interval: time.Millisecond})
+		assert.True(t, errors.Is(err, ErrActivationFailed), "want: %s; got: %s", ErrActivationFailed, err)
+		client.AssertExpectations(t)
+	})
+}
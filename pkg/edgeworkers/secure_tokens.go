@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -34,6 +35,16 @@ type (
 	}
 )
 
+// TraceHeader is the request header carrying the secure token, which enables EdgeWorkers debug headers in the response
+const TraceHeader = "Akamai-EW-Trace"
+
+// Header returns the header with the secure token, which can be added to requests sent to the hostname of the token
+func (r CreateSecureTokenResponse) Header() http.Header {
+	header := make(http.Header)
+	header.Set(TraceHeader, r.AkamaiEWTrace)
+	return header
+}
+
 // Validate validates CreateSecureTokenRequest
 func (c CreateSecureTokenRequest) Validate() error {
 	return validation.Errors{
@@ -61,19 +72,9 @@ func (e *edgeworkers) CreateSecureToken(ctx context.Context, params CreateSecure
 	}
 
 	uri := "/edgeworkers/v1/secure-token"
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, nil)
-	if err != nil {
-		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateSecureToken, err)
-	}
-
 	var result CreateSecureTokenResponse
-	resp, err := e.Exec(req, &result, params)
-	if err != nil {
-		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateSecureToken, err)
-	}
-
-	if resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("%s: %w", ErrCreateSecureToken, e.Error(resp))
+	if _, err := session.DoRequest(ctx, e, http.MethodPost, uri, params, &result, http.StatusCreated); err != nil {
+		return nil, &requestError{operation: ErrCreateSecureToken, err: err}
 	}
 
 	return &result, nil
@@ -91,6 +91,14 @@ func TestCreateSecureToken(t *testing.T) {
 			},
 			withError: ErrStructValidation,
 		},
+		"validation error - negative expiry": {
+			params: CreateSecureTokenRequest{
+				URL:      "/path",
+				Expiry:   -5,
+				Hostname: "test.devexp.akamai.com",
+			},
+			withError: ErrStructValidation,
+		},
 		"401 unauthorized": {
 			params: CreateSecureTokenRequest{
 				ACL:      "/*",
@@ -249,3 +257,9 @@ func TestCreateSecureToken(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateSecureTokenResponse_Header(t *testing.T) {
+	token := CreateSecureTokenResponse{AkamaiEWTrace: "st=1641295764~exp=1641296664~acl=/*~hmac=f6d18857"}
+	header := token.Header()
+	assert.Equal(t, "st=1641295764~exp=1641296664~acl=/*~hmac=f6d18857", header.Get("Akamai-EW-Trace"))
+}
@@ -1,9 +1,11 @@
 package edgeworkers
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 
@@ -13,7 +15,8 @@ import (
 type (
 	// Validations is an edgeworkers validations API interface
 	Validations interface {
-		// ValidateBundle given bundle validates it and returns a list of errors and/or warnings
+		// ValidateBundle given bundle validates it and returns a list of errors and/or warnings.
+		// The gzip tarball is streamed from the reader, so it is not held in memory as a whole.
 		//
 		// See: https://techdocs.akamai.com/edgeworkers/reference/post-validations
 		ValidateBundle(context.Context, ValidateBundleRequest) (*ValidateBundleResponse, error)
@@ -57,8 +60,17 @@ func (e *edgeworkers) ValidateBundle(ctx context.Context, params ValidateBundleR
 		return nil, fmt.Errorf("%s: %w: %s", ErrValidateBundle, ErrStructValidation, err)
 	}
 
+	// same as in CreateEdgeWorkerVersion, an empty bundle is rejected without reading the whole archive upfront
+	bundle := bufio.NewReader(params.Bundle)
+	if _, err := bundle.Peek(1); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%s: %w: Bundle: cannot be empty", ErrValidateBundle, ErrStructValidation)
+		}
+		return nil, fmt.Errorf("%w: failed to read bundle: %s", ErrValidateBundle, err)
+	}
+
 	uri := "/edgeworkers/v1/validations"
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, ioutil.NopCloser(params.Bundle))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, ioutil.NopCloser(bundle))
 	if err != nil {
 		return nil, fmt.Errorf("%w: failed to create request: %s", ErrValidateBundle, err)
 	}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -14,12 +15,14 @@ import (
 )
 
 func TestValidateBundle(t *testing.T) {
+	largeBundle := strings.Repeat("bundle", 50000)
 	tests := map[string]struct {
 		params           ValidateBundleRequest
 		responseStatus   int
 		responseBody     string
 		expectedPath     string
 		expectedResponse *ValidateBundleResponse
+		requestBody      string
 		withError        error
 	}{
 		"200 OK": {
@@ -109,6 +112,18 @@ func TestValidateBundle(t *testing.T) {
 			params:    ValidateBundleRequest{},
 			withError: ErrStructValidation,
 		},
+		"empty bundle": {
+			params:    ValidateBundleRequest{Bundle{strings.NewReader("")}},
+			withError: ErrStructValidation,
+		},
+		"bundle larger than signed body": {
+			params:           ValidateBundleRequest{Bundle{strings.NewReader(largeBundle)}},
+			requestBody:      largeBundle,
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"errors": [], "warnings": []}`,
+			expectedPath:     "/edgeworkers/v1/validations",
+			expectedResponse: &ValidateBundleResponse{Errors: []ValidationIssue{}, Warnings: []ValidationIssue{}},
+		},
 	}
 
 	for name, test := range tests {
@@ -116,6 +131,12 @@ func TestValidateBundle(t *testing.T) {
 			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				assert.Equal(t, test.expectedPath, r.URL.String())
 				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/gzip", r.Header.Get("Content-Type"))
+				if test.requestBody != "" {
+					body, err := ioutil.ReadAll(r.Body)
+					assert.NoError(t, err)
+					assert.Equal(t, test.requestBody, string(body))
+				}
 				w.WriteHeader(test.responseStatus)
 				_, err := w.Write([]byte(test.responseBody))
 				assert.NoError(t, err)
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
 
 //
@@ -62,10 +64,10 @@ type AsMapList struct {
 func (asm *AsMap) Validate() error {
 
 	if len(asm.Name) < 1 {
-		return fmt.Errorf("AsMap is missing Name")
+		return fmt.Errorf("%w: AsMap is missing Name", ErrStructValidation)
 	}
 	if asm.DefaultDatacenter == nil {
-		return fmt.Errorf("AsMap is missing DefaultDatacenter")
+		return fmt.Errorf("%w: AsMap is missing DefaultDatacenter", ErrStructValidation)
 	}
 
 	return nil
@@ -87,18 +89,8 @@ func (p *gtm) ListAsMaps(ctx context.Context, domainName string) ([]*AsMap, erro
 
 	var aslist AsMapList
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/as-maps", domainName)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create ListAsMaps request: %w", err)
-	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &aslist)
-	if err != nil {
-		return nil, fmt.Errorf("ListAsMaps request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &aslist, http.StatusOK); err != nil {
+		return nil, requestError("ListAsMaps", err)
 	}
 
 	return aslist.AsMapItems, nil
@@ -111,18 +103,8 @@ func (p *gtm) GetAsMap(ctx context.Context, name, domainName string) (*AsMap, er
 
 	var as AsMap
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/as-maps/%s", domainName, name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create GetAsMap request: %w", err)
-	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &as)
-	if err != nil {
-		return nil, fmt.Errorf("GetAsMap request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &as, http.StatusOK); err != nil {
+		return nil, requestError("GetAsMap", err)
 	}
 
 	return &as, nil
@@ -165,25 +147,14 @@ func (p *gtm) UpdateAsMap(ctx context.Context, as *AsMap, domainName string) (*R
 // save AsMap in given domain. Common path for Create and Update.
 func (asm *AsMap) save(ctx context.Context, p *gtm, domainName string) (*AsMapResponse, error) {
 
-	if err := asm.Validate(); err != nil {
+	if err := p.validate(ctx, asm); err != nil {
 		return nil, fmt.Errorf("AsMap validation failed. %w", err)
 	}
 
 	putURL := fmt.Sprintf("/config-gtm/v1/domains/%s/as-maps/%s", domainName, asm.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, putURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create AsMap request: %w", err)
-	}
-
 	var mapresp AsMapResponse
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &mapresp, asm)
-	if err != nil {
-		return nil, fmt.Errorf("AsMap request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodPut, putURL, asm, &mapresp, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, requestError("AsMap", err)
 	}
 
 	return &mapresp, nil
@@ -194,25 +165,14 @@ func (p *gtm) DeleteAsMap(ctx context.Context, as *AsMap, domainName string) (*R
 	logger := p.Log(ctx)
 	logger.Debug("DeleteAsMap")
 
-	if err := as.Validate(); err != nil {
+	if err := p.validate(ctx, as); err != nil {
 		return nil, fmt.Errorf("Resource validation failed. %w", err)
 	}
 
 	delURL := fmt.Sprintf("/config-gtm/v1/domains/%s/as-maps/%s", domainName, as.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, delURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Delete request: %w", err)
-	}
-
 	var mapresp ResponseBody
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &mapresp)
-	if err != nil {
-		return nil, fmt.Errorf("AsMap request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodDelete, delURL, nil, &mapresp, http.StatusOK); err != nil {
+		return nil, requestError("AsMap", err)
 	}
 
 	return mapresp.Status, nil
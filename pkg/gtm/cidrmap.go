@@ -3,7 +3,11 @@ package gtm
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
 
 //
@@ -21,10 +25,19 @@ type CidrMaps interface {
 	//
 	// See: https://techdocs.akamai.com/gtm/reference/get-cidr-maps
 	ListCidrMaps(context.Context, string) ([]*CidrMap, error)
+	// ListCidrMapNames retrieves names of all CidrMaps, extracted from the ListCidrMaps response.
+	//
+	// See: https://techdocs.akamai.com/gtm/reference/get-cidr-maps
+	ListCidrMapNames(context.Context, string) ([]string, error)
 	// GetCidrMap retrieves a CidrMap with the given name.
 	//
 	// See: https://techdocs.akamai.com/gtm/reference/get-cidr-map
 	GetCidrMap(context.Context, string, string) (*CidrMap, error)
+	// GetCidrMapIfModifiedSince retrieves a CidrMap with the given name if it was modified after the given time,
+	// e.g. LastModified of the previously retrieved CidrMap. ErrNotModified is returned if it was not modified.
+	//
+	// See: https://techdocs.akamai.com/gtm/reference/get-cidr-map
+	GetCidrMapIfModifiedSince(context.Context, string, string, time.Time) (*CidrMap, error)
 	// CreateCidrMap creates the datacenter identified by the receiver argument in the specified domain.
 	//
 	// See: https://techdocs.akamai.com/gtm/reference/put-cidr-map
@@ -37,6 +50,11 @@ type CidrMaps interface {
 	//
 	// See: https://techdocs.akamai.com/gtm/reference/put-cidr-map
 	UpdateCidrMap(context.Context, *CidrMap, string) (*ResponseStatus, error)
+	// TouchCidrMap retrieves the CidrMap with the given name and saves it back unchanged, e.g. to force its re-propagation
+	// after datacenters of the domain changed.
+	//
+	// See: https://techdocs.akamai.com/gtm/reference/put-cidr-map
+	TouchCidrMap(context.Context, string, string) (*ResponseStatus, error)
 }
 
 // CidrAssignment represents a GTM cidr assignment element
@@ -51,6 +69,8 @@ type CidrMap struct {
 	Assignments       []*CidrAssignment `json:"assignments,omitempty"`
 	Name              string            `json:"name"`
 	Links             []*Link           `json:"links,omitempty"`
+	// LastModified is the value of the Last-Modified header of the response the CidrMap was retrieved with, if any
+	LastModified time.Time `json:"-"`
 }
 
 // CidrMapList represents a GTM returned cidrmap list body
@@ -58,16 +78,86 @@ type CidrMapList struct {
 	CidrMapItems []*CidrMap `json:"items"`
 }
 
-// Validate validates CidrMap
+// Validate validates CidrMap. Besides name and default datacenter, every datacenter can be assigned only once,
+// every block of every assignment has to be a CIDR block or a single IP address, and blocks assigned to different
+// datacenters must not overlap. The first problem found is returned, see ValidateMaps for all of them.
 func (cidr *CidrMap) Validate() error {
-	if len(cidr.Name) < 1 {
-		return fmt.Errorf("CidrMap is missing Name")
+	if errs := cidr.validationErrors(); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// validationErrors returns all problems found in the CidrMap
+func (cidr *CidrMap) validationErrors() []error {
+	var errs []error
+	if cidr.Name == "" {
+		errs = append(errs, fmt.Errorf("%w: CidrMap is missing Name", ErrStructValidation))
 	}
 	if cidr.DefaultDatacenter == nil {
-		return fmt.Errorf("CidrMap is missing DefaultDatacenter")
+		errs = append(errs, fmt.Errorf("%w: CidrMap is missing DefaultDatacenter", ErrStructValidation))
 	}
 
-	return nil
+	type assignedBlock struct {
+		block string
+		dcID  int
+		net   *net.IPNet
+	}
+	datacenters := make(map[int]struct{}, len(cidr.Assignments))
+	var assigned []assignedBlock
+	for i, assignment := range cidr.Assignments {
+		if assignment == nil {
+			errs = append(errs, fmt.Errorf("%w: CidrMap assignment %d is nil", ErrStructValidation, i))
+			continue
+		}
+		if _, ok := datacenters[assignment.DatacenterId]; ok {
+			errs = append(errs, fmt.Errorf("%w: CidrMap has duplicate assignment for datacenter %d", ErrStructValidation, assignment.DatacenterId))
+		}
+		datacenters[assignment.DatacenterId] = struct{}{}
+
+		for _, block := range assignment.Blocks {
+			ipNet, err := parseCidrBlock(block)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%w: CidrMap assignment for datacenter %d has invalid block %q: %s", ErrStructValidation, assignment.DatacenterId, block, err))
+				continue
+			}
+			for _, other := range assigned {
+				if other.dcID != assignment.DatacenterId && cidrBlocksOverlap(other.net, ipNet) {
+					errs = append(errs, fmt.Errorf("%w: CidrMap block %q of datacenter %d overlaps block %q of datacenter %d",
+						ErrStructValidation, block, assignment.DatacenterId, other.block, other.dcID))
+				}
+			}
+			assigned = append(assigned, assignedBlock{block: block, dcID: assignment.DatacenterId, net: ipNet})
+		}
+	}
+	return errs
+}
+
+// parseCidrBlock parses a block of a CidrAssignment, which is either a CIDR block or a single IP address
+func parseCidrBlock(block string) (*net.IPNet, error) {
+	if ip := net.ParseIP(block); ip != nil {
+		if ip4 := ip.To4(); ip4 != nil {
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+	_, ipNet, err := net.ParseCIDR(block)
+	return ipNet, err
+}
+
+// cidrBlocksOverlap reports whether the blocks share any address, i.e. one of them contains the other
+func cidrBlocksOverlap(a, b *net.IPNet) bool {
+	if len(a.IP) != len(b.IP) {
+		return false
+	}
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// MarshalCanonical returns deterministic JSON representation of the CidrMap, e.g. for storing it in version control.
+// Object keys are sorted, lists like assignments and their blocks are sorted, and links are omitted,
+// so the output does not depend on the order in which the API returned elements.
+func (cidr *CidrMap) MarshalCanonical() ([]byte, error) {
+	return marshalCanonical(cidr)
 }
 
 func (p *gtm) NewCidrMap(ctx context.Context, name string) *CidrMap {
@@ -86,21 +176,29 @@ func (p *gtm) ListCidrMaps(ctx context.Context, domainName string) ([]*CidrMap,
 
 	var cidrs CidrMapList
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/cidr-maps", domainName)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create ListCidrMaps request: %w", err)
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &cidrs, http.StatusOK); err != nil {
+		return nil, requestError("ListCidrMaps", err)
 	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &cidrs)
+
+	return cidrs.CidrMapItems, nil
+}
+
+func (p *gtm) ListCidrMapNames(ctx context.Context, domainName string) ([]string, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("ListCidrMapNames")
+
+	maps, err := p.ListCidrMaps(ctx, domainName)
 	if err != nil {
-		return nil, fmt.Errorf("ListCidrMaps request failed: %w", err)
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	names := make([]string, 0, len(maps))
+	for _, m := range maps {
+		names = append(names, m.Name)
 	}
 
-	return cidrs.CidrMapItems, nil
+	return names, nil
 }
 
 func (p *gtm) GetCidrMap(ctx context.Context, name, domainName string) (*CidrMap, error) {
@@ -110,19 +208,27 @@ func (p *gtm) GetCidrMap(ctx context.Context, name, domainName string) (*CidrMap
 
 	var cidr CidrMap
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/cidr-maps/%s", domainName, name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create GetCidrMap request: %w", err)
-	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &cidr)
+	resp, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &cidr, http.StatusOK)
 	if err != nil {
-		return nil, fmt.Errorf("GetCidrMap request failed: %w", err)
+		return nil, requestError("GetCidrMap", err)
 	}
+	cidr.LastModified = lastModified(resp)
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	return &cidr, nil
+}
+
+func (p *gtm) GetCidrMapIfModifiedSince(ctx context.Context, name, domainName string, since time.Time) (*CidrMap, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("GetCidrMapIfModifiedSince")
+
+	var cidr CidrMap
+	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/cidr-maps/%s", domainName, name)
+	resp, err := p.getIfModifiedSince(ctx, getURL, since, &cidr)
+	if err != nil {
+		return nil, requestError("GetCidrMapIfModifiedSince", err)
 	}
+	cidr.LastModified = lastModified(resp)
 
 	return &cidr, nil
 }
@@ -161,28 +267,39 @@ func (p *gtm) UpdateCidrMap(ctx context.Context, cidr *CidrMap, domainName strin
 	return stat.Status, err
 }
 
-// Save CidrMap in given domain. Common path for Create and Update.
-func (cidr *CidrMap) save(ctx context.Context, p *gtm, domainName string) (*CidrMapResponse, error) {
+func (p *gtm) TouchCidrMap(ctx context.Context, name, domainName string) (*ResponseStatus, error) {
 
-	if err := cidr.Validate(); err != nil {
-		return nil, fmt.Errorf("CidrMap validation failed. %w", err)
-	}
+	logger := p.Log(ctx)
+	logger.Debug("TouchCidrMap")
 
-	putURL := fmt.Sprintf("/config-gtm/v1/domains/%s/cidr-maps/%s", domainName, cidr.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, putURL, nil)
+	cidr, err := p.GetCidrMap(ctx, name, domainName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create AsMap request: %w", err)
+		return nil, fmt.Errorf("TouchCidrMap failed: %w", err)
 	}
 
-	var mapresp CidrMapResponse
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &mapresp, cidr)
+	stat, err := cidr.save(ctx, p, domainName)
 	if err != nil {
-		return nil, fmt.Errorf("CidrMap request failed: %w", err)
+		return nil, fmt.Errorf("TouchCidrMap failed: %w", err)
+	}
+	return stat.Status, nil
+}
+
+// Save CidrMap in given domain. Common path for Create and Update.
+func (cidr *CidrMap) save(ctx context.Context, p *gtm, domainName string) (*CidrMapResponse, error) {
+
+	if err := p.validate(ctx, cidr); err != nil {
+		return nil, fmt.Errorf("CidrMap validation failed. %w", err)
+	}
+	if p.datacenterPreflight {
+		if err := p.checkMapDatacenters(ctx, domainName, cidr.Name, cidr.datacenterIDs()); err != nil {
+			return nil, fmt.Errorf("CidrMap datacenter preflight failed: %w", err)
+		}
 	}
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return nil, p.Error(resp)
+	putURL := fmt.Sprintf("/config-gtm/v1/domains/%s/cidr-maps/%s", domainName, cidr.Name)
+	var mapresp CidrMapResponse
+	if _, err := session.DoRequest(ctx, p, http.MethodPut, putURL, cidr, &mapresp, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, requestError("CidrMap", err)
 	}
 
 	return &mapresp, nil
@@ -193,26 +310,15 @@ func (p *gtm) DeleteCidrMap(ctx context.Context, cidr *CidrMap, domainName strin
 	logger := p.Log(ctx)
 	logger.Debug("DeleteCidrMap")
 
-	if err := cidr.Validate(); err != nil {
+	if err := p.validate(ctx, cidr); err != nil {
 		logger.Errorf("CidrMap validation failed. %w", err)
 		return nil, fmt.Errorf("CidrMap validation failed. %w", err)
 	}
 
 	delURL := fmt.Sprintf("/config-gtm/v1/domains/%s/cidr-maps/%s", domainName, cidr.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, delURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Delete request: %w", err)
-	}
-
 	var mapresp ResponseBody
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &mapresp)
-	if err != nil {
-		return nil, fmt.Errorf("CidrMap request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodDelete, delURL, nil, &mapresp, http.StatusOK); err != nil {
+		return nil, requestError("CidrMap", err)
 	}
 
 	return mapresp.Status, nil
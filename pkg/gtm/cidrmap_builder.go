@@ -0,0 +1,80 @@
+package gtm
+
+//
+// Fluent construction of gtm cidrmaps
+// Based on 1.4 schema
+//
+
+// CidrMapBuilder constructs a CidrMap step by step. The CidrMap is validated when calling Build.
+type CidrMapBuilder struct {
+	name              string
+	defaultDatacenter *DatacenterBase
+	assignments       []*CidrAssignment
+}
+
+// NewCidrMapBuilder returns an empty CidrMapBuilder
+func NewCidrMapBuilder() *CidrMapBuilder {
+	return &CidrMapBuilder{}
+}
+
+// WithName sets the name of the CidrMap
+func (b *CidrMapBuilder) WithName(name string) *CidrMapBuilder {
+	b.name = name
+	return b
+}
+
+// WithDefaultDatacenter sets the datacenter used for blocks which are not assigned to any other datacenter
+func (b *CidrMapBuilder) WithDefaultDatacenter(dcID int, nickname string) *CidrMapBuilder {
+	b.defaultDatacenter = &DatacenterBase{DatacenterId: dcID, Nickname: nickname}
+	return b
+}
+
+// AddAssignment assigns CIDR blocks, e.g. 1.2.3.0/24 or 2001:db8::/32, or single IP addresses to the datacenter
+func (b *CidrMapBuilder) AddAssignment(dcID int, nickname string, blocks ...string) *CidrMapBuilder {
+	assignment := &CidrAssignment{Blocks: append([]string{}, blocks...)}
+	assignment.DatacenterId = dcID
+	assignment.Nickname = nickname
+	b.assignments = append(b.assignments, assignment)
+	return b
+}
+
+// Build validates and returns the CidrMap. It fails if the CidrMap is missing name or default datacenter,
+// if a datacenter is assigned more than once, if any of the blocks is not a valid CIDR or IP address or if blocks
+// of different datacenters overlap, same as CidrMap.Validate.
+// Blocks are canonicalized, e.g. 10.1.2.3/8 becomes 10.0.0.0/8 and 192.0.2.1 becomes 192.0.2.1/32, and duplicates
+// within an assignment are removed.
+func (b *CidrMapBuilder) Build() (*CidrMap, error) {
+	cidr := &CidrMap{
+		Name: b.name,
+	}
+	if b.defaultDatacenter != nil {
+		dc := *b.defaultDatacenter
+		cidr.DefaultDatacenter = &dc
+	}
+	for _, assignment := range b.assignments {
+		asn := *assignment
+		asn.Blocks = append([]string{}, assignment.Blocks...)
+		cidr.Assignments = append(cidr.Assignments, &asn)
+	}
+	if err := cidr.Validate(); err != nil {
+		return nil, err
+	}
+
+	// blocks are valid, so they can be canonicalized without errors
+	for _, asn := range cidr.Assignments {
+		blocks := make([]string, 0, len(asn.Blocks))
+		seen := make(map[string]struct{}, len(asn.Blocks))
+		for _, block := range asn.Blocks {
+			ipNet, _ := parseCidrBlock(block)
+			canonical := ipNet.String()
+			if _, ok := seen[canonical]; ok {
+				continue
+			}
+			seen[canonical] = struct{}{}
+			blocks = append(blocks, canonical)
+		}
+		asn.Blocks = blocks
+	}
+
+	return cidr, nil
+}
@@ -0,0 +1,85 @@
+package gtm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCidrMapBuilder_Build(t *testing.T) {
+	tests := map[string]struct {
+		builder   *CidrMapBuilder
+		expected  *CidrMap
+		withError error
+	}{
+		"ok": {
+			builder: NewCidrMapBuilder().
+				WithName("The North").
+				WithDefaultDatacenter(5400, "All Other CIDR Blocks").
+				AddAssignment(3134, "Frostfangs and the Fist of First Men", "1.2.3.0/24", "1.2.3.4/24").
+				AddAssignment(3133, "Winterfell", "2001:DB8::1/32", "10.1.2.3/8"),
+			expected: &CidrMap{
+				Name:              "The North",
+				DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "All Other CIDR Blocks"},
+				Assignments: []*CidrAssignment{
+					{DatacenterBase: DatacenterBase{DatacenterId: 3134, Nickname: "Frostfangs and the Fist of First Men"}, Blocks: []string{"1.2.3.0/24"}},
+					{DatacenterBase: DatacenterBase{DatacenterId: 3133, Nickname: "Winterfell"}, Blocks: []string{"2001:db8::/32", "10.0.0.0/8"}},
+				},
+			},
+		},
+		"single IP address": {
+			builder: NewCidrMapBuilder().
+				WithName("The North").
+				WithDefaultDatacenter(5400, "All Other CIDR Blocks").
+				AddAssignment(3133, "Winterfell", "192.0.2.1", "2001:db8::1"),
+			expected: &CidrMap{
+				Name:              "The North",
+				DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "All Other CIDR Blocks"},
+				Assignments: []*CidrAssignment{
+					{DatacenterBase: DatacenterBase{DatacenterId: 3133, Nickname: "Winterfell"}, Blocks: []string{"192.0.2.1/32", "2001:db8::1/128"}},
+				},
+			},
+		},
+		"missing name": {
+			builder:   NewCidrMapBuilder().WithDefaultDatacenter(5400, "All Other CIDR Blocks"),
+			withError: ErrStructValidation,
+		},
+		"invalid CIDR": {
+			builder: NewCidrMapBuilder().
+				WithName("The North").
+				WithDefaultDatacenter(5400, "All Other CIDR Blocks").
+				AddAssignment(3133, "Winterfell", "1.2.3.0/33"),
+			withError: ErrStructValidation,
+		},
+		"duplicate datacenter": {
+			builder: NewCidrMapBuilder().
+				WithName("The North").
+				WithDefaultDatacenter(5400, "All Other CIDR Blocks").
+				AddAssignment(3133, "Winterfell", "1.2.3.0/24").
+				AddAssignment(3133, "Winterfell", "1.2.4.0/24"),
+			withError: ErrStructValidation,
+		},
+		"overlapping blocks": {
+			builder: NewCidrMapBuilder().
+				WithName("The North").
+				WithDefaultDatacenter(5400, "All Other CIDR Blocks").
+				AddAssignment(3133, "Winterfell", "10.0.0.0/8").
+				AddAssignment(3134, "Frostfangs", "10.1.0.0/16"),
+			withError: ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := test.builder.Build()
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
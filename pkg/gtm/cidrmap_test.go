@@ -5,9 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 	"github.com/stretchr/testify/assert"
@@ -187,7 +189,7 @@ func TestGtm_NewCidrAssignment(t *testing.T) {
 }
 
 func TestGtm_CreateCidrMap(t *testing.T) {
-	var result CidrMapResponse
+	var result, resultWithWarnings CidrMapResponse
 	var req CidrMap
 
 	respData, err := loadTestData("TestGtm_CreateCidrMap.resp.json")
@@ -199,6 +201,22 @@ func TestGtm_CreateCidrMap(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	warningsData, err := loadTestData("TestGtm_CreateCidrMapWarnings.resp.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := json.NewDecoder(bytes.NewBuffer(warningsData)).Decode(&resultWithWarnings); err != nil {
+		t.Fatal(err)
+	}
+	require.Len(t, resultWithWarnings.Warnings, 1)
+	assert.Equal(t, &Warning{
+		Type:          "https://problems.luna.akamaiapis.net/config-gtm/v1/unusedDatacenter",
+		Title:         "Unused datacenter",
+		Detail:        "Datacenter 3132 is not used by any property",
+		ErrorLocation: "/assignments/0",
+	}, resultWithWarnings.Warnings[0])
+
 	reqData, err := loadTestData("TestGtm_CreateCidrMap.req.json")
 	if err != nil {
 		t.Fatal(err)
@@ -229,6 +247,17 @@ func TestGtm_CreateCidrMap(t *testing.T) {
 			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/cidr-maps/The%20North",
 			expectedResponse: &result,
 		},
+		"200 OK with warnings": {
+			cmap:       &req,
+			domainName: "example.akadns.net",
+			headers: http.Header{
+				"Content-Type": []string{"application/vnd.config-gtm.v1.4+json;charset=UTF-8"},
+			},
+			responseStatus:   http.StatusOK,
+			responseBody:     string(warningsData),
+			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/cidr-maps/The%20North",
+			expectedResponse: &resultWithWarnings,
+		},
 		"500 internal server error": {
 			cmap:           &req,
 			domainName:     "example.akadns.net",
@@ -248,6 +277,13 @@ func TestGtm_CreateCidrMap(t *testing.T) {
 				StatusCode: http.StatusInternalServerError,
 			},
 		},
+		"missing name": {
+			cmap: &CidrMap{
+				DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "All Other CIDR Blocks"},
+			},
+			domainName: "example.akadns.net",
+			withError:  ErrStructValidation,
+		},
 	}
 
 	for name, test := range tests {
@@ -449,3 +485,293 @@ func TestGtm_DeleteCidrMap(t *testing.T) {
 		})
 	}
 }
+
+func TestGtm_CreateCidrMapSkipValidation(t *testing.T) {
+	cmap := &CidrMap{
+		Name: "The North",
+	}
+
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/config-gtm/v1/domains/example.akadns.net/cidr-maps/The%20North", r.URL.String())
+		assert.Equal(t, http.MethodPut, r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"defaultDatacenter":null,"name":"The North"}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		_, err = w.Write([]byte(`{"resource":{"name":"The North"},"status":{"changeId":"93a48b86-4fc3-4a5f-9ca2-036835034cc6"}}`))
+		assert.NoError(t, err)
+	}))
+	client := mockAPIClient(t, mockServer)
+
+	_, err := client.CreateCidrMap(context.Background(), cmap, "example.akadns.net")
+	assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+
+	result, err := client.CreateCidrMap(ContextWithSkipValidation(context.Background()), cmap, "example.akadns.net")
+	require.NoError(t, err)
+	assert.Equal(t, "The North", result.Resource.Name)
+	assert.Equal(t, "93a48b86-4fc3-4a5f-9ca2-036835034cc6", result.Status.ChangeId)
+}
+
+func TestCidrMap_Validate(t *testing.T) {
+	defaultDC := &DatacenterBase{DatacenterId: 5400, Nickname: "All Other CIDR Blocks"}
+	assignment := func(dcID int, blocks ...string) *CidrAssignment {
+		return &CidrAssignment{DatacenterBase: DatacenterBase{DatacenterId: dcID}, Blocks: blocks}
+	}
+
+	tests := map[string]struct {
+		cidr      *CidrMap
+		withError string
+	}{
+		"valid": {
+			cidr: &CidrMap{Name: "The North", DefaultDatacenter: defaultDC, Assignments: []*CidrAssignment{
+				assignment(3133, "1.2.4.0/24", "2001:db8::/32"),
+				assignment(3134, "1.3.5.9", "1.2.3.0/24", "2001:db9::1"),
+			}},
+		},
+		"overlapping blocks of the same datacenter": {
+			cidr: &CidrMap{Name: "The North", DefaultDatacenter: defaultDC, Assignments: []*CidrAssignment{
+				assignment(3133, "10.0.0.0/8", "10.1.0.0/16"),
+			}},
+		},
+		"missing name": {
+			cidr:      &CidrMap{DefaultDatacenter: defaultDC},
+			withError: "struct validation: CidrMap is missing Name",
+		},
+		"malformed block": {
+			cidr: &CidrMap{Name: "The North", DefaultDatacenter: defaultDC, Assignments: []*CidrAssignment{
+				assignment(3133, "1.2.4.0/24"),
+				assignment(3134, "1.2.3.0/33"),
+			}},
+			withError: `struct validation: CidrMap assignment for datacenter 3134 has invalid block "1.2.3.0/33": invalid CIDR address: 1.2.3.0/33`,
+		},
+		"overlapping blocks": {
+			cidr: &CidrMap{Name: "The North", DefaultDatacenter: defaultDC, Assignments: []*CidrAssignment{
+				assignment(3133, "1.2.4.0/24", "10.0.0.0/8"),
+				assignment(3134, "10.1.2.0/24"),
+			}},
+			withError: `struct validation: CidrMap block "10.1.2.0/24" of datacenter 3134 overlaps block "10.0.0.0/8" of datacenter 3133`,
+		},
+		"single address in block": {
+			cidr: &CidrMap{Name: "The North", DefaultDatacenter: defaultDC, Assignments: []*CidrAssignment{
+				assignment(3133, "2001:db8::1"),
+				assignment(3134, "2001:db8::/64"),
+			}},
+			withError: `struct validation: CidrMap block "2001:db8::/64" of datacenter 3134 overlaps block "2001:db8::1" of datacenter 3133`,
+		},
+		"nil assignment": {
+			cidr:      &CidrMap{Name: "The North", DefaultDatacenter: defaultDC, Assignments: []*CidrAssignment{nil}},
+			withError: "struct validation: CidrMap assignment 0 is nil",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.cidr.Validate()
+			if test.withError != "" {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				assert.EqualError(t, err, test.withError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGtm_UpdateCidrMapOverlappingBlocks(t *testing.T) {
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+	}))
+	client := mockAPIClient(t, mockServer)
+
+	_, err := client.UpdateCidrMap(context.Background(), &CidrMap{
+		Name:              "The North",
+		DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "All Other CIDR Blocks"},
+		Assignments: []*CidrAssignment{
+			{DatacenterBase: DatacenterBase{DatacenterId: 3133, Nickname: "Winterfell"}, Blocks: []string{"1.2.0.0/16"}},
+			{DatacenterBase: DatacenterBase{DatacenterId: 3134, Nickname: "Frostfangs"}, Blocks: []string{"1.2.3.0/24"}},
+		},
+	}, "example.akadns.net")
+	assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+}
+
+func TestCidrMap_MarshalCanonical(t *testing.T) {
+	cidr := &CidrMap{
+		Name:              "The North",
+		DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "All Other CIDR Blocks"},
+		Assignments: []*CidrAssignment{
+			{DatacenterBase: DatacenterBase{DatacenterId: 3134, Nickname: "Frostfangs"}, Blocks: []string{"1.3.5.9", "1.2.3.0/24"}},
+			{DatacenterBase: DatacenterBase{DatacenterId: 3133, Nickname: "Winterfell"}, Blocks: []string{"1.2.4.0/24"}},
+		},
+		Links: []*Link{{Rel: "self", Href: "/config-gtm/v1/domains/example.akadns.net/cidr-maps/The%20North"}},
+	}
+	reordered := &CidrMap{
+		Name:              "The North",
+		DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "All Other CIDR Blocks"},
+		Assignments: []*CidrAssignment{
+			{DatacenterBase: DatacenterBase{DatacenterId: 3133, Nickname: "Winterfell"}, Blocks: []string{"1.2.4.0/24"}},
+			{DatacenterBase: DatacenterBase{DatacenterId: 3134, Nickname: "Frostfangs"}, Blocks: []string{"1.2.3.0/24", "1.3.5.9"}},
+		},
+	}
+
+	expected := `{"assignments":[{"blocks":["1.2.3.0/24","1.3.5.9"],"datacenterId":3134,"nickname":"Frostfangs"},` +
+		`{"blocks":["1.2.4.0/24"],"datacenterId":3133,"nickname":"Winterfell"}],` +
+		`"defaultDatacenter":{"datacenterId":5400,"nickname":"All Other CIDR Blocks"},"name":"The North"}`
+
+	for i := 0; i < 10; i++ {
+		data, err := cidr.MarshalCanonical()
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(data))
+
+		data, err = reordered.MarshalCanonical()
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(data))
+	}
+}
+
+func TestGtm_GetCidrMapIfModifiedSince(t *testing.T) {
+	var result CidrMap
+
+	respData, err := loadTestData("TestGtm_GetCidrMap.resp.json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(respData, &result))
+	result.LastModified = time.Date(2023, 9, 21, 14, 38, 5, 0, time.UTC)
+
+	tests := map[string]struct {
+		since                   time.Time
+		responseStatus          int
+		responseBody            string
+		expectedIfModifiedSince string
+		expectedResponse        *CidrMap
+		withError               error
+	}{
+		"200 OK": {
+			since:                   time.Date(2023, 9, 20, 10, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+			responseStatus:          http.StatusOK,
+			responseBody:            string(respData),
+			expectedIfModifiedSince: "Wed, 20 Sep 2023 08:00:00 GMT",
+			expectedResponse:        &result,
+		},
+		"200 OK - zero time sends no condition": {
+			responseStatus:   http.StatusOK,
+			responseBody:     string(respData),
+			expectedResponse: &result,
+		},
+		"304 Not Modified": {
+			since:                   time.Date(2023, 9, 21, 14, 38, 5, 0, time.UTC),
+			responseStatus:          http.StatusNotModified,
+			expectedIfModifiedSince: "Thu, 21 Sep 2023 14:38:05 GMT",
+			withError:               ErrNotModified,
+		},
+		"500 internal server error": {
+			since:          time.Date(2023, 9, 21, 14, 38, 5, 0, time.UTC),
+			responseStatus: http.StatusInternalServerError,
+			responseBody: `
+{
+    "type": "internal_error",
+    "title": "Internal Server Error",
+    "detail": "Error fetching cidrmap",
+    "status": 500
+}`,
+			expectedIfModifiedSince: "Thu, 21 Sep 2023 14:38:05 GMT",
+			withError: &Error{
+				Type:       "internal_error",
+				Title:      "Internal Server Error",
+				Detail:     "Error fetching cidrmap",
+				StatusCode: http.StatusInternalServerError,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-gtm/v1/domains/example.akadns.net/cidr-maps/Software-rollout", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, test.expectedIfModifiedSince, r.Header.Get("If-Modified-Since"))
+				w.Header().Set("Last-Modified", "Thu, 21 Sep 2023 14:38:05 GMT")
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.GetCidrMapIfModifiedSince(context.Background(), "Software-rollout", "example.akadns.net", test.since)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestGtm_TouchCidrMap(t *testing.T) {
+	respData, err := loadTestData("TestGtm_GetCidrMap.resp.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fetched CidrMap
+	if err := json.NewDecoder(bytes.NewBuffer(respData)).Decode(&fetched); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		getStatus        int
+		getBody          string
+		expectedMethods  []string
+		expectedResponse *ResponseStatus
+		withError        error
+	}{
+		"200 OK": {
+			getStatus:       http.StatusOK,
+			getBody:         string(respData),
+			expectedMethods: []string{http.MethodGet, http.MethodPut},
+			expectedResponse: &ResponseStatus{
+				Message:               "Change Pending",
+				ChangeId:              "5beb11ae-8908-4bfe-8459-e88efc4d2fdc",
+				PropagationStatus:     "PENDING",
+				PropagationStatusDate: "2019-04-25T14:54:00.000+00:00",
+				PassingValidation:     true,
+			},
+		},
+		"404 not found": {
+			getStatus:       http.StatusNotFound,
+			getBody:         `{"type": "https://problems.luna.akamaiapis.net/config-gtm/v1/notFound", "title": "Not Found", "detail": "CidrMap not found"}`,
+			expectedMethods: []string{http.MethodGet},
+			withError:       ErrNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var methods []string
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-gtm/v1/domains/example.akadns.net/cidr-maps/The%20North", r.URL.String())
+				methods = append(methods, r.Method)
+				if r.Method == http.MethodGet {
+					w.WriteHeader(test.getStatus)
+					_, err := w.Write([]byte(test.getBody))
+					assert.NoError(t, err)
+					return
+				}
+
+				var saved CidrMap
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&saved))
+				assert.Equal(t, fetched, saved)
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`{"resource": ` + test.getBody + `, "status": {"message": "Change Pending", "changeId": "5beb11ae-8908-4bfe-8459-e88efc4d2fdc", "propagationStatus": "PENDING", "propagationStatusDate": "2019-04-25T14:54:00.000+00:00", "passingValidation": true}}`))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.TouchCidrMap(context.Background(), "The North", "example.akadns.net")
+			assert.Equal(t, test.expectedMethods, methods)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package gtm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 )
 
 //
@@ -28,8 +32,60 @@ func appendReqArgs(req *http.Request, queryArgs map[string]string) {
 // TODO: retrieve from environment or elsewhere in Service Init
 var schemaVersion = "1.4"
 
-// internal method to set version. passed in as string
+// supportedSchemaVersions are the schema versions which can be requested with ContextWithSchemaVersion
+var supportedSchemaVersions = []string{"1.3", "1.4", "1.5", "1.6"}
+
+type schemaVersionContextKey struct{}
+
+// ContextWithSchemaVersion returns a copy of the context which makes calls using it request the given schema version
+// instead of the default one, e.g. to migrate some of the calls to a newer schema. Note that request and response
+// bodies are still based on the 1.4 schema. Calls fail with ErrUnsupportedSchemaVersion if the version is not supported.
+func ContextWithSchemaVersion(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, schemaVersionContextKey{}, version)
+}
+
+// contextSchemaVersion returns the schema version set on the context with ContextWithSchemaVersion
+func contextSchemaVersion(ctx context.Context) (string, bool) {
+	version, ok := ctx.Value(schemaVersionContextKey{}).(string)
+	return version, ok
+}
+
+type skipValidationContextKey struct{}
+
+// ContextWithSkipValidation returns a copy of the context which disables client-side validation of the requests
+// made with it. It is meant for advanced users who intentionally send payloads which the local Validate() rejects,
+// e.g. fields recently added to the API. Invalid requests are then sent as-is and it is up to the API to reject them,
+// so errors are reported later, with less detail, or an unexpected request may be made (e.g. with empty path
+// parameters). Validation is enabled by default.
+func ContextWithSkipValidation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipValidationContextKey{}, true)
+}
+
+// contextSkipValidation reports whether validation was disabled for the context with ContextWithSkipValidation
+func contextSkipValidation(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipValidationContextKey{}).(bool)
+	return skip
+}
+
+// validateSchemaVersion checks that the schema version set on the context, if any, is supported
+func validateSchemaVersion(ctx context.Context) error {
+	version, ok := contextSchemaVersion(ctx)
+	if !ok {
+		return nil
+	}
+	for _, supported := range supportedSchemaVersions {
+		if version == supported {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q, supported versions are: %s", ErrUnsupportedSchemaVersion, version, strings.Join(supportedSchemaVersions, ", "))
+}
+
+// internal method to set version. passed in as string, unless it is overridden on the request context
 func setVersionHeader(req *http.Request, version string) {
+	if override, ok := contextSchemaVersion(req.Context()); ok {
+		version = override
+	}
 
 	req.Header.Set("Accept", fmt.Sprintf("application/vnd.config-gtm.v%s+json", version))
 
@@ -56,6 +112,73 @@ type ResponseStatus struct {
 	PropagationStatusDate string  `json:"propagationStatusDate,omitempty"`
 }
 
+// ChangeID identifies a single change made in a GTM domain
+type ChangeID string
+
+// ChangeIDCurrent refers to the latest change made in a domain
+const ChangeIDCurrent ChangeID = "current"
+
+// ChangeID returns the identifier of the change the status refers to, e.g. to track the change with GetChangeStatus
+func (r *ResponseStatus) ChangeID() ChangeID {
+	if r == nil {
+		return ""
+	}
+	return ChangeID(r.ChangeId)
+}
+
+const (
+	// PropagationStatusPending indicates that a change is being propagated
+	PropagationStatusPending = "PENDING"
+	// PropagationStatusComplete indicates that a change was propagated to all GTM name servers
+	PropagationStatusComplete = "COMPLETE"
+	// PropagationStatusDenied indicates that a change was rejected
+	PropagationStatusDenied = "DENIED"
+
+	propagationStatusDateLayout = "2006-01-02T15:04:05.000-0700"
+)
+
+// AveragePropagationTime is the typical time it takes to propagate a GTM change, measured from the
+// propagation status date. It is used by ResponseStatus.EstimatedCompletion for pending changes.
+const AveragePropagationTime = 5 * time.Minute
+
+// EstimatedCompletion returns an estimated time at which the change is propagated. For completed changes,
+// it is the time of completion reported by the server. For pending changes, it is derived from the
+// propagation status date and AveragePropagationTime. The second value is false when the estimate is unknown,
+// e.g. the status date is missing or the change was denied.
+func (r *ResponseStatus) EstimatedCompletion() (time.Time, bool) {
+	if r == nil || r.PropagationStatusDate == "" {
+		return time.Time{}, false
+	}
+
+	date, err := time.Parse(propagationStatusDateLayout, r.PropagationStatusDate)
+	if err != nil {
+		if date, err = time.Parse(time.RFC3339, r.PropagationStatusDate); err != nil {
+			return time.Time{}, false
+		}
+	}
+
+	switch r.PropagationStatus {
+	case PropagationStatusComplete:
+		return date, true
+	case PropagationStatusPending:
+		return date.Add(AveragePropagationTime), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// StatusLink returns the link to the current status of the domain, e.g. to follow the propagation of the change
+func (r *ResponseStatus) StatusLink() (*Link, bool) {
+	if r == nil || r.Links == nil {
+		return nil, false
+	}
+	links := make([]*Link, 0, len(*r.Links))
+	for i := range *r.Links {
+		links = append(links, &(*r.Links)[i])
+	}
+	return FindLink(links, LinkRelSelf)
+}
+
 // NewResponseStatus returns a new ResponseStatus struct
 func NewResponseStatus() *ResponseStatus {
 
@@ -97,12 +220,14 @@ type ResourceResponse struct {
 type CidrMapResponse struct {
 	Resource *CidrMap        `json:"resource"`
 	Status   *ResponseStatus `json:"status"`
+	Warnings []*Warning      `json:"warnings,omitempty"`
 }
 
 // GeoMapResponse contains a response after creating or updating GeoMap
 type GeoMapResponse struct {
 	Resource *GeoMap         `json:"resource"`
 	Status   *ResponseStatus `json:"status"`
+	Warnings []*Warning      `json:"warnings,omitempty"`
 }
 
 // AsMapResponse contains a response after creating or updating AsMap
@@ -111,12 +236,55 @@ type AsMapResponse struct {
 	Status   *ResponseStatus `json:"status"`
 }
 
+// Warning describes a non-fatal issue reported alongside a successful create or update.
+// Warnings do not cause the operation to fail, they are meant to be logged or shown to the user.
+type Warning struct {
+	Type          string `json:"type,omitempty"`
+	Title         string `json:"title,omitempty"`
+	Detail        string `json:"detail,omitempty"`
+	ErrorLocation string `json:"errorLocation,omitempty"`
+}
+
 // Link is Probably THE most common type
 type Link struct {
 	Rel  string `json:"rel"`
 	Href string `json:"href"`
 }
 
+// LinkRelSelf is the relation of the link pointing to the resource itself
+const LinkRelSelf = "self"
+
+// AbsoluteHref resolves the href of the link, which is usually relative to the API host, against the base URL,
+// e.g. "https://akab-xxx.luna.akamaiapis.net". Hrefs which are already absolute are returned unchanged.
+func (l Link) AbsoluteHref(base string) (string, error) {
+	href, err := url.Parse(l.Href)
+	if err != nil {
+		return "", fmt.Errorf("invalid link href %q: %s", l.Href, err)
+	}
+	if href.IsAbs() {
+		return href.String(), nil
+	}
+
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL %q: %s", base, err)
+	}
+	if !baseURL.IsAbs() {
+		return "", fmt.Errorf("base URL %q is not absolute", base)
+	}
+	return baseURL.ResolveReference(href).String(), nil
+}
+
+// FindLink returns the first of the links with the given relation, e.g. LinkRelSelf
+func FindLink(links []*Link, rel string) (*Link, bool) {
+	for _, link := range links {
+		if link != nil && link.Rel == rel {
+			return link, true
+		}
+	}
+	return nil, false
+}
+
 // LoadObject contains information about the load reporting interface
 type LoadObject struct {
 	LoadObject     string   `json:"loadObject,omitempty"`
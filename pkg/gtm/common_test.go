@@ -0,0 +1,145 @@
+package gtm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseStatus_EstimatedCompletion(t *testing.T) {
+	tests := map[string]struct {
+		status       *ResponseStatus
+		expectedTime time.Time
+		expectedOK   bool
+	}{
+		"pending change": {
+			status: &ResponseStatus{
+				PropagationStatus:     PropagationStatusPending,
+				PropagationStatusDate: "2014-04-15T11:30:27.000+0000",
+			},
+			expectedTime: time.Date(2014, 4, 15, 11, 35, 27, 0, time.UTC),
+			expectedOK:   true,
+		},
+		"completed change": {
+			status: &ResponseStatus{
+				PropagationStatus:     PropagationStatusComplete,
+				PropagationStatusDate: "2014-04-15T11:30:27.000+0000",
+			},
+			expectedTime: time.Date(2014, 4, 15, 11, 30, 27, 0, time.UTC),
+			expectedOK:   true,
+		},
+		"RFC3339 date": {
+			status: &ResponseStatus{
+				PropagationStatus:     PropagationStatusPending,
+				PropagationStatusDate: "2014-04-15T11:30:27Z",
+			},
+			expectedTime: time.Date(2014, 4, 15, 11, 35, 27, 0, time.UTC),
+			expectedOK:   true,
+		},
+		"denied change": {
+			status: &ResponseStatus{
+				PropagationStatus:     PropagationStatusDenied,
+				PropagationStatusDate: "2014-04-15T11:30:27.000+0000",
+			},
+		},
+		"no status date": {
+			status: &ResponseStatus{
+				PropagationStatus: PropagationStatusPending,
+			},
+		},
+		"invalid status date": {
+			status: &ResponseStatus{
+				PropagationStatus:     PropagationStatusPending,
+				PropagationStatusDate: "yesterday",
+			},
+		},
+		"nil status": {},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			estimate, ok := test.status.EstimatedCompletion()
+			assert.Equal(t, test.expectedOK, ok)
+			assert.True(t, test.expectedTime.Equal(estimate), "want: %s; got: %s", test.expectedTime, estimate)
+		})
+	}
+}
+
+func TestLink_AbsoluteHref(t *testing.T) {
+	tests := map[string]struct {
+		href      string
+		base      string
+		expected  string
+		withError bool
+	}{
+		"relative href": {
+			href:     "/config-gtm/v1/domains/example.akadns.net/geographic-maps/UK%20Delivery",
+			base:     "https://akab-xxx.luna.akamaiapis.net",
+			expected: "https://akab-xxx.luna.akamaiapis.net/config-gtm/v1/domains/example.akadns.net/geographic-maps/UK%20Delivery",
+		},
+		"relative href with base path": {
+			href:     "/config-gtm/v1/domains/example.akadns.net/status/current",
+			base:     "https://akab-xxx.luna.akamaiapis.net/config-gtm/v1/domains",
+			expected: "https://akab-xxx.luna.akamaiapis.net/config-gtm/v1/domains/example.akadns.net/status/current",
+		},
+		"absolute href": {
+			href:     "https://akab-yyy.luna.akamaiapis.net/config-gtm/v1/domains/example.akadns.net",
+			base:     "https://akab-xxx.luna.akamaiapis.net",
+			expected: "https://akab-yyy.luna.akamaiapis.net/config-gtm/v1/domains/example.akadns.net",
+		},
+		"absolute href without base": {
+			href:     "https://akab-yyy.luna.akamaiapis.net/config-gtm/v1/domains/example.akadns.net",
+			expected: "https://akab-yyy.luna.akamaiapis.net/config-gtm/v1/domains/example.akadns.net",
+		},
+		"relative href with relative base": {
+			href:      "/config-gtm/v1/domains/example.akadns.net",
+			base:      "akab-xxx.luna.akamaiapis.net",
+			withError: true,
+		},
+		"invalid href": {
+			href:      "%zz",
+			base:      "https://akab-xxx.luna.akamaiapis.net",
+			withError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := Link{Rel: LinkRelSelf, Href: test.href}.AbsoluteHref(test.base)
+			if test.withError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestFindLink(t *testing.T) {
+	links := []*Link{
+		{Rel: "parent", Href: "/config-gtm/v1/domains/example.akadns.net"},
+		nil,
+		{Rel: LinkRelSelf, Href: "/config-gtm/v1/domains/example.akadns.net/geographic-maps/UK%20Delivery"},
+	}
+	link, ok := FindLink(links, LinkRelSelf)
+	require.True(t, ok)
+	assert.Equal(t, links[2], link)
+
+	_, ok = FindLink(links, "next")
+	assert.False(t, ok)
+
+	status := &ResponseStatus{Links: &[]Link{{Rel: LinkRelSelf, Href: "/config-gtm/v1/domains/example.akadns.net/status/current"}}}
+	link, ok = status.StatusLink()
+	require.True(t, ok)
+	assert.Equal(t, "/config-gtm/v1/domains/example.akadns.net/status/current", link.Href)
+
+	_, ok = (&ResponseStatus{}).StatusLink()
+	assert.False(t, ok)
+
+	var noStatus *ResponseStatus
+	_, ok = noStatus.StatusLink()
+	assert.False(t, ok)
+}
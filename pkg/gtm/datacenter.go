@@ -4,8 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-
 	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
 
 //
@@ -101,18 +102,8 @@ func (p *gtm) ListDatacenters(ctx context.Context, domainName string) ([]*Datace
 
 	var dcs DatacenterList
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters", domainName)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create ListDatacenters request: %w", err)
-	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &dcs)
-	if err != nil {
-		return nil, fmt.Errorf("ListDatacenters request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &dcs, http.StatusOK); err != nil {
+		return nil, requestError("ListDatacenters", err)
 	}
 
 	return dcs.DatacenterItems, nil
@@ -125,18 +116,8 @@ func (p *gtm) GetDatacenter(ctx context.Context, dcID int, domainName string) (*
 
 	var dc Datacenter
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters/%s", domainName, strconv.Itoa(dcID))
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create GetDatacenter request: %w", err)
-	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &dc)
-	if err != nil {
-		return nil, fmt.Errorf("GetDatacenter request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &dc, http.StatusOK); err != nil {
+		return nil, requestError("GetDatacenter", err)
 	}
 
 	return &dc, nil
@@ -148,19 +129,9 @@ func (p *gtm) CreateDatacenter(ctx context.Context, dc *Datacenter, domainName s
 	logger.Debug("CreateDatacenter")
 
 	postURL := fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters", domainName)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, postURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Datacenter request: %w", err)
-	}
-
 	var dcresp DatacenterResponse
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &dcresp, dc)
-	if err != nil {
-		return nil, fmt.Errorf("Datacenter request failed: %w", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodPost, postURL, dc, &dcresp, http.StatusCreated); err != nil {
+		return nil, requestError("Datacenter", err)
 	}
 
 	return &dcresp, nil
@@ -211,7 +182,6 @@ func createDefaultDC(ctx context.Context, p *gtm, defaultID int, domainName stri
 		return dc, err
 	}
 	apiError, ok := err.(*Error)
-	//if !strings.Contains(err.Error(), "not found") || !strings.Contains(err.Error(), "Datacenter") {
 	if !ok || apiError.StatusCode != http.StatusNotFound {
 		return nil, err
 	}
@@ -226,19 +196,9 @@ func createDefaultDC(ctx context.Context, p *gtm, defaultID int, domainName stri
 		defaultURL += "datacenter-for-ip-version-selector-ipv6"
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, defaultURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Default Datacenter request: %w", err)
-	}
-
 	var dcresp DatacenterResponse
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &dcresp, "")
-	if err != nil {
-		return nil, fmt.Errorf("Default Datacenter request failed: %w", err)
-	}
-	if resp.StatusCode != http.StatusCreated {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodPost, defaultURL, "", &dcresp, http.StatusCreated); err != nil {
+		return nil, requestError("Default Datacenter", err)
 	}
 
 	return dcresp.Resource, nil
@@ -251,19 +211,9 @@ func (p *gtm) UpdateDatacenter(ctx context.Context, dc *Datacenter, domainName s
 	logger.Debug("UpdateDatacenter")
 
 	putURL := fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters/%s", domainName, strconv.Itoa(dc.DatacenterId))
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, putURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Update Datacenter request: %w", err)
-	}
-
 	var dcresp DatacenterResponse
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &dcresp, dc)
-	if err != nil {
-		return nil, fmt.Errorf("Datacenter request failed: %w", err)
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodPut, putURL, dc, &dcresp, http.StatusOK); err != nil {
+		return nil, requestError("Datacenter", err)
 	}
 
 	return dcresp.Status, nil
@@ -275,19 +225,9 @@ func (p *gtm) DeleteDatacenter(ctx context.Context, dc *Datacenter, domainName s
 	logger.Debug("DeleteDatacenter")
 
 	delURL := fmt.Sprintf("/config-gtm/v1/domains/%s/datacenters/%s", domainName, strconv.Itoa(dc.DatacenterId))
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, delURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Delete Datacenter request: %w", err)
-	}
-
 	var dcresp DatacenterResponse
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &dcresp)
-	if err != nil {
-		return nil, fmt.Errorf("Datacenter request failed: %w", err)
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodDelete, delURL, nil, &dcresp, http.StatusOK); err != nil {
+		return nil, requestError("Datacenter", err)
 	}
 
 	return dcresp.Status, nil
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-
+	"net/url"
 	"reflect"
 	"strings"
+	"time"
 	"unicode"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
 
 //
@@ -25,6 +28,24 @@ type Domains interface {
 	//
 	// See: https://techdocs.akamai.com/gtm/reference/get-status-current
 	GetDomainStatus(context.Context, string) (*ResponseStatus, error)
+	// GetChangeStatus retrieves propagation status of the change with the given ID made in the given domain.
+	// If a later change was made in the domain since, status of the later change is returned, as it is
+	// propagated together with the earlier ones; ChangeID of the result tells which change it refers to.
+	//
+	// See: https://techdocs.akamai.com/gtm/reference/get-status-current
+	GetChangeStatus(context.Context, string, ChangeID) (*ResponseStatus, error)
+	// WaitForDomainStatus polls current status of the given domain until its propagation is complete or denied,
+	// and returns the final status.
+	//
+	// See: https://techdocs.akamai.com/gtm/reference/get-status-current
+	WaitForDomainStatus(context.Context, string, WaitOptions) (*ResponseStatus, error)
+	// WaitForChangeStatus polls status of the change with the given ID made in the given domain, see GetChangeStatus,
+	// until its propagation is complete or denied, and returns the final status.
+	//
+	// See: https://techdocs.akamai.com/gtm/reference/get-status-current
+	WaitForChangeStatus(context.Context, string, ChangeID, WaitOptions) (*ResponseStatus, error)
+	// GetChangeHistory retrieves records of changes made in the given domain, optionally limited to the given time range.
+	GetChangeHistory(context.Context, string, *ChangeHistoryOptions) ([]*ChangeRecord, error)
 	// ListDomains retrieves all Domains.
 	//
 	// See: https://techdocs.akamai.com/gtm/reference/get-domains
@@ -110,10 +131,10 @@ type DomainItem struct {
 func (dom *Domain) Validate() error {
 
 	if len(dom.Name) < 1 {
-		return fmt.Errorf("Domain is missing Name")
+		return fmt.Errorf("%w: Domain is missing Name", ErrStructValidation)
 	}
 	if len(dom.Type) < 1 {
-		return fmt.Errorf("Domain is missing Type")
+		return fmt.Errorf("%w: Domain is missing Type", ErrStructValidation)
 	}
 
 	return nil
@@ -137,42 +158,120 @@ func (p *gtm) GetDomainStatus(ctx context.Context, domainName string) (*Response
 
 	var stat ResponseStatus
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/status/current", domainName)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create GetDomain request: %w", err)
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &stat, http.StatusOK); err != nil {
+		return nil, requestError("GetDomain", err)
 	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &stat)
-	if err != nil {
-		return nil, fmt.Errorf("GetDomain request failed: %w", err)
+
+	return &stat, nil
+}
+
+func (p *gtm) GetChangeStatus(ctx context.Context, domainName string, changeID ChangeID) (*ResponseStatus, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("GetChangeStatus")
+
+	if domainName == "" {
+		return nil, fmt.Errorf("%w: domain name is required", ErrBadRequest)
+	}
+	if changeID == "" {
+		return nil, fmt.Errorf("%w: change ID is required", ErrBadRequest)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	var stat ResponseStatus
+	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/status/current", domainName)
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &stat, http.StatusOK); err != nil {
+		return nil, requestError("GetChangeStatus", err)
+	}
+	if changeID != ChangeIDCurrent && stat.ChangeID() != changeID {
+		logger.Debugf("change %s of domain %s was followed by change %s", changeID, domainName, stat.ChangeId)
 	}
 
 	return &stat, nil
 }
 
-func (p *gtm) ListDomains(ctx context.Context) ([]*DomainItem, error) {
+// WaitOptions configures polling of WaitForDomainStatus and WaitForChangeStatus
+type WaitOptions struct {
+	// Interval is the time between consecutive status checks. DefaultWaitInterval is used if it is not set.
+	Interval time.Duration
+}
+
+// DefaultWaitInterval is the interval used by WaitForDomainStatus and WaitForChangeStatus if none is given
+const DefaultWaitInterval = 30 * time.Second
+
+func (p *gtm) WaitForDomainStatus(ctx context.Context, domainName string, opts WaitOptions) (*ResponseStatus, error) {
 
 	logger := p.Log(ctx)
-	logger.Debug("ListDomains")
+	logger.Debug("WaitForDomainStatus")
 
-	var domains DomainsList
-	getURL := fmt.Sprintf("/config-gtm/v1/domains")
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
+	if domainName == "" {
+		return nil, fmt.Errorf("%w: domain name is required", ErrBadRequest)
+	}
+
+	stat, err := p.waitForPropagation(ctx, domainName, opts, func() (*ResponseStatus, error) {
+		return p.GetDomainStatus(ctx, domainName)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create ListDomains request: %w", err)
+		return nil, fmt.Errorf("WaitForDomainStatus failed: %w", err)
 	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &domains)
+
+	return stat, nil
+}
+
+func (p *gtm) WaitForChangeStatus(ctx context.Context, domainName string, changeID ChangeID, opts WaitOptions) (*ResponseStatus, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("WaitForChangeStatus")
+
+	if domainName == "" {
+		return nil, fmt.Errorf("%w: domain name is required", ErrBadRequest)
+	}
+	if changeID == "" {
+		return nil, fmt.Errorf("%w: change ID is required", ErrBadRequest)
+	}
+
+	stat, err := p.waitForPropagation(ctx, domainName, opts, func() (*ResponseStatus, error) {
+		return p.GetChangeStatus(ctx, domainName, changeID)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("ListDomains request failed: %w", err)
+		return nil, fmt.Errorf("WaitForChangeStatus failed: %w", err)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	return stat, nil
+}
+
+// waitForPropagation calls getStatus every opts.Interval until the returned propagation status is complete or denied
+func (p *gtm) waitForPropagation(ctx context.Context, domainName string, opts WaitOptions, getStatus func() (*ResponseStatus, error)) (*ResponseStatus, error) {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+
+	for {
+		stat, err := getStatus()
+		if err != nil {
+			return nil, err
+		}
+		switch stat.PropagationStatus {
+		case PropagationStatusComplete, PropagationStatusDenied:
+			return stat, nil
+		}
+		p.Log(ctx).Debugf("domain %s propagation status: %s", domainName, stat.PropagationStatus)
+
+		if err := session.Wait(ctx, interval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *gtm) ListDomains(ctx context.Context) ([]*DomainItem, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("ListDomains")
+
+	var domains DomainsList
+	getURL := fmt.Sprintf("/config-gtm/v1/domains")
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &domains, http.StatusOK); err != nil {
+		return nil, requestError("ListDomains", err)
 	}
 
 	return domains.DomainItems, nil
@@ -185,49 +284,33 @@ func (p *gtm) GetDomain(ctx context.Context, domainName string) (*Domain, error)
 
 	var domain Domain
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s", domainName)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create GetDomain request: %w", err)
-	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &domain)
-	if err != nil {
-		return nil, fmt.Errorf("GetDomain request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &domain, http.StatusOK); err != nil {
+		return nil, requestError("GetDomain", err)
 	}
 
 	return &domain, nil
 }
 
 // save method; Create or Update
-func (dom *Domain) save(_ context.Context, p *gtm, queryArgs map[string]string, req *http.Request) (*DomainResponse, error) {
-
-	// set schema version
-	setVersionHeader(req, schemaVersion)
+func (dom *Domain) save(ctx context.Context, p *gtm, queryArgs map[string]string, method, reqURL string) (*DomainResponse, error) {
 
 	// Look for optional args
 	if len(queryArgs) > 0 {
-		q := req.URL.Query()
+		q := url.Values{}
 		if val, ok := queryArgs["contractId"]; ok {
 			q.Add("contractId", strings.TrimPrefix(val, "ctr_"))
 		}
 		if val, ok := queryArgs["gid"]; ok {
 			q.Add("gid", strings.TrimPrefix(val, "grp_"))
 		}
-		req.URL.RawQuery = q.Encode()
+		if len(q) > 0 {
+			reqURL = fmt.Sprintf("%s?%s", reqURL, q.Encode())
+		}
 	}
 
 	var dresp DomainResponse
-	resp, err := p.Exec(req, &dresp, dom)
-	if err != nil {
-		return nil, fmt.Errorf("Domain request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, method, reqURL, dom, &dresp, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, requestError("Domain", err)
 	}
 
 	return &dresp, nil
@@ -239,18 +322,14 @@ func (p *gtm) CreateDomain(ctx context.Context, domain *Domain, queryArgs map[st
 	logger := p.Log(ctx)
 	logger.Debug("CreateDomain")
 
-	if err := domain.Validate(); err != nil {
+	if err := p.validate(ctx, domain); err != nil {
 		logger.Errorf("Domain validation failed. %w", err)
 		return nil, fmt.Errorf("Domain validation failed. %w", err)
 	}
 
 	postURL := fmt.Sprintf("/config-gtm/v1/domains/")
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, postURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create CreateDomain request: %w", err)
-	}
 
-	return domain.save(ctx, p, queryArgs, req)
+	return domain.save(ctx, p, queryArgs, http.MethodPost, postURL)
 
 }
 
@@ -259,18 +338,14 @@ func (p *gtm) UpdateDomain(ctx context.Context, domain *Domain, queryArgs map[st
 	logger := p.Log(ctx)
 	logger.Debug("UpdateDomain")
 
-	if err := domain.Validate(); err != nil {
+	if err := p.validate(ctx, domain); err != nil {
 		logger.Errorf("Domain validation failed. %w", err)
 		return nil, fmt.Errorf("Domain validation failed. %w", err)
 	}
 
 	putURL := fmt.Sprintf("/config-gtm/v1/domains/%s", domain.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, putURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create UpdateDomain request: %w", err)
-	}
 
-	stat, err := domain.save(ctx, p, queryArgs, req)
+	stat, err := domain.save(ctx, p, queryArgs, http.MethodPut, putURL)
 	if err != nil {
 		return nil, err
 	}
@@ -283,21 +358,9 @@ func (p *gtm) DeleteDomain(ctx context.Context, domain *Domain) (*ResponseStatus
 	logger.Debug("DeleteDomain")
 
 	delURL := fmt.Sprintf("/config-gtm/v1/domains/%s", domain.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, delURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create DeleteDomain request: %w", err)
-	}
-
 	var responseBody ResponseBody
-	setVersionHeader(req, schemaVersion)
-
-	resp, err := p.Exec(req, &responseBody)
-	if err != nil {
-		return nil, fmt.Errorf("Delete Domain request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodDelete, delURL, nil, &responseBody, http.StatusOK); err != nil {
+		return nil, requestError("Delete Domain", err)
 	}
 
 	return responseBody.Status, nil
@@ -328,7 +391,7 @@ func (p *gtm) NullFieldMap(ctx context.Context, domain *Domain) (*NullFieldMapSt
 	logger := p.Log(ctx)
 	logger.Debug("NullFieldMap")
 
-	if err := domain.Validate(); err != nil {
+	if err := p.validate(ctx, domain); err != nil {
 		logger.Errorf("Domain validation failed. %w", err)
 		return nil, fmt.Errorf("Domain validation failed. %w", err)
 	}
@@ -339,18 +402,8 @@ func (p *gtm) NullFieldMap(ctx context.Context, domain *Domain) (*NullFieldMapSt
 	var objMap ObjectMap
 
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s", domain.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create GetDomain request: %w", err)
-	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &objMap)
-	if err != nil {
-		return nil, fmt.Errorf("GetDomain request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &objMap, http.StatusOK); err != nil {
+		return nil, requestError("GetDomain", err)
 	}
 
 	for i, d := range objMap {
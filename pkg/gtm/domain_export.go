@@ -0,0 +1,187 @@
+package gtm
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+//
+// Comparison of whole gtm domain configurations
+// Based on 1.4 schema
+//
+
+// DomainExport represents a complete configuration of a GTM domain, as returned by GetDomain.
+// It can be compared with an export of another domain, e.g. when promoting changes between environments.
+type DomainExport Domain
+
+// DomainDiff summarizes differences between two DomainExports
+type DomainDiff struct {
+	Added   []DomainObjectKey
+	Removed []DomainObjectKey
+	Changed []DomainObjectKey
+}
+
+// DomainObjectKey identifies a map or a property in a domain
+type DomainObjectKey struct {
+	Type string
+	Name string
+}
+
+const (
+	// DomainObjectProperty is a DomainObjectKey type of property
+	DomainObjectProperty = "property"
+	// DomainObjectGeoMap is a DomainObjectKey type of geographic map
+	DomainObjectGeoMap = "geographicMap"
+	// DomainObjectCidrMap is a DomainObjectKey type of cidr map
+	DomainObjectCidrMap = "cidrMap"
+	// DomainObjectAsMap is a DomainObjectKey type of as map
+	DomainObjectAsMap = "asMap"
+)
+
+// ignoredDiffFields lists fields which are set by the server and do not describe the configuration
+var ignoredDiffFields = map[string]struct{}{
+	"links":        {},
+	"lastModified": {},
+}
+
+// Diff returns maps and properties which have to be added, removed or changed in the receiver domain
+// to match the other domain. Objects are matched by their type and name, and the comparison is insensitive
+// to the order of objects and of elements in lists, e.g. assignments or traffic targets.
+// Returned keys are sorted by type and name.
+func (d DomainExport) Diff(other DomainExport) DomainDiff {
+	current, desired := d.objects(), other.objects()
+
+	var diff DomainDiff
+	for key, obj := range desired {
+		currentObj, ok := current[key]
+		if !ok {
+			diff.Added = append(diff.Added, key)
+			continue
+		}
+		if !reflect.DeepEqual(currentObj, obj) {
+			diff.Changed = append(diff.Changed, key)
+		}
+	}
+	for key := range current {
+		if _, ok := desired[key]; !ok {
+			diff.Removed = append(diff.Removed, key)
+		}
+	}
+
+	sortDomainObjectKeys(diff.Added)
+	sortDomainObjectKeys(diff.Removed)
+	sortDomainObjectKeys(diff.Changed)
+
+	return diff
+}
+
+// IsEmpty reports whether DomainDiff contains no differences
+func (d DomainDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// objects returns canonical representations of all maps and properties of the domain, keyed by type and name
+func (d DomainExport) objects() map[DomainObjectKey]interface{} {
+	objects := make(map[DomainObjectKey]interface{})
+	for _, prop := range d.Properties {
+		if prop != nil {
+			objects[DomainObjectKey{Type: DomainObjectProperty, Name: prop.Name}] = canonicalize(prop)
+		}
+	}
+	for _, geo := range d.GeographicMaps {
+		if geo != nil {
+			objects[DomainObjectKey{Type: DomainObjectGeoMap, Name: geo.Name}] = canonicalize(geo)
+		}
+	}
+	for _, cidr := range d.CidrMaps {
+		if cidr != nil {
+			objects[DomainObjectKey{Type: DomainObjectCidrMap, Name: cidr.Name}] = canonicalize(cidr)
+		}
+	}
+	for _, as := range d.AsMaps {
+		if as != nil {
+			objects[DomainObjectKey{Type: DomainObjectAsMap, Name: as.Name}] = canonicalize(as)
+		}
+	}
+	return objects
+}
+
+// canonicalize converts obj to its generic JSON representation with server-managed fields removed
+// and all lists sorted, so that two representations can be compared regardless of elements order
+func canonicalize(obj interface{}) interface{} {
+	generic, err := toCanonical(obj)
+	if err != nil {
+		return obj
+	}
+	return generic
+}
+
+func toCanonical(obj interface{}) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, err
+	}
+	return sortGeneric(generic), nil
+}
+
+// marshalCanonical returns deterministic JSON representation of obj, with object keys and all lists sorted
+// and server-managed fields removed
+func marshalCanonical(obj interface{}) ([]byte, error) {
+	generic, err := toCanonical(obj)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(generic)
+}
+
+func sortGeneric(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			if _, ok := ignoredDiffFields[k]; ok {
+				delete(val, k)
+				continue
+			}
+			val[k] = sortGeneric(item)
+		}
+		return val
+	case []interface{}:
+		keys := make([]string, len(val))
+		for i, item := range val {
+			val[i] = sortGeneric(item)
+			key, _ := json.Marshal(val[i])
+			keys[i] = string(key)
+		}
+		sort.Sort(byKeys{items: val, keys: keys})
+		return val
+	default:
+		return val
+	}
+}
+
+// byKeys sorts generic list items by their precomputed JSON representations
+type byKeys struct {
+	items []interface{}
+	keys  []string
+}
+
+func (b byKeys) Len() int           { return len(b.items) }
+func (b byKeys) Less(i, j int) bool { return b.keys[i] < b.keys[j] }
+func (b byKeys) Swap(i, j int) {
+	b.items[i], b.items[j] = b.items[j], b.items[i]
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+}
+
+func sortDomainObjectKeys(keys []DomainObjectKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Type != keys[j].Type {
+			return keys[i].Type < keys[j].Type
+		}
+		return keys[i].Name < keys[j].Name
+	})
+}
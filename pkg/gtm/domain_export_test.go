@@ -0,0 +1,112 @@
+package gtm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainExport_Diff(t *testing.T) {
+	baseDomain := func() DomainExport {
+		return DomainExport{
+			Name: "example.akadns.net",
+			Type: "weighted",
+			Properties: []*Property{
+				{
+					Name: "www",
+					Type: "weighted-round-robin",
+					TrafficTargets: []*TrafficTarget{
+						{DatacenterId: 1, Enabled: true, Weight: 50, Servers: []string{"1.2.3.4", "1.2.3.5"}},
+						{DatacenterId: 2, Enabled: true, Weight: 50, Servers: []string{"1.2.3.6"}},
+					},
+				},
+				{
+					Name: "api",
+					Type: "failover",
+				},
+			},
+			GeographicMaps: []*GeoMap{
+				{
+					Name:              "geo",
+					DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "default"},
+					Assignments: []*GeoAssignment{
+						{DatacenterBase: DatacenterBase{DatacenterId: 1, Nickname: "dc1"}, Countries: []string{"US", "CA"}},
+						{DatacenterBase: DatacenterBase{DatacenterId: 2, Nickname: "dc2"}, Countries: []string{"GB"}},
+					},
+					Links: []*Link{{Rel: "self", Href: "https://example.com/geo"}},
+				},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		modify   func(*DomainExport)
+		expected DomainDiff
+	}{
+		"no changes": {
+			modify:   func(*DomainExport) {},
+			expected: DomainDiff{},
+		},
+		"different order and links only": {
+			modify: func(d *DomainExport) {
+				d.Properties[0], d.Properties[1] = d.Properties[1], d.Properties[0]
+				targets := d.Properties[1].TrafficTargets
+				targets[0], targets[1] = targets[1], targets[0]
+				targets[1].Servers = []string{"1.2.3.5", "1.2.3.4"}
+				assignments := d.GeographicMaps[0].Assignments
+				assignments[0], assignments[1] = assignments[1], assignments[0]
+				assignments[1].Countries = []string{"CA", "US"}
+				d.GeographicMaps[0].Links = nil
+			},
+			expected: DomainDiff{},
+		},
+		"added map": {
+			modify: func(d *DomainExport) {
+				d.CidrMaps = append(d.CidrMaps, &CidrMap{
+					Name:              "cidr",
+					DefaultDatacenter: &DatacenterBase{DatacenterId: 5400},
+				})
+			},
+			expected: DomainDiff{
+				Added: []DomainObjectKey{{Type: DomainObjectCidrMap, Name: "cidr"}},
+			},
+		},
+		"removed property": {
+			modify: func(d *DomainExport) {
+				d.Properties = d.Properties[:1]
+			},
+			expected: DomainDiff{
+				Removed: []DomainObjectKey{{Type: DomainObjectProperty, Name: "api"}},
+			},
+		},
+		"changed assignment": {
+			modify: func(d *DomainExport) {
+				d.GeographicMaps[0].Assignments[1].Countries = []string{"GB", "IE"}
+			},
+			expected: DomainDiff{
+				Changed: []DomainObjectKey{{Type: DomainObjectGeoMap, Name: "geo"}},
+			},
+		},
+		"same name different type": {
+			modify: func(d *DomainExport) {
+				d.AsMaps = append(d.AsMaps, &AsMap{Name: "geo", DefaultDatacenter: &DatacenterBase{DatacenterId: 5400}})
+				d.Properties[0].TrafficTargets[0].Weight = 20
+			},
+			expected: DomainDiff{
+				Added:   []DomainObjectKey{{Type: DomainObjectAsMap, Name: "geo"}},
+				Changed: []DomainObjectKey{{Type: DomainObjectProperty, Name: "www"}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			current, desired := baseDomain(), baseDomain()
+			test.modify(&desired)
+
+			diff := current.Diff(desired)
+			assert.Equal(t, test.expected, diff)
+			assert.Equal(t, len(test.expected.Added)+len(test.expected.Removed)+len(test.expected.Changed) == 0, diff.IsEmpty())
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package gtm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
+)
+
+//
+// Support gtm domain change history thru Edgegrid
+// Based on 1.4 Schema
+//
+
+// ChangeHistoryOptions contains optional filters of GetChangeHistory
+type ChangeHistoryOptions struct {
+	// From limits the history to changes made at or after the time
+	From time.Time
+	// To limits the history to changes made at or before the time
+	To time.Time
+}
+
+// ChangeRecord represents a single change made in a GTM domain
+type ChangeRecord struct {
+	User         string    `json:"user"`
+	Timestamp    time.Time `json:"timestamp"`
+	ResourceType string    `json:"resourceType"`
+	ResourceName string    `json:"resourceName"`
+	Action       string    `json:"action"`
+	ChangeId     string    `json:"changeId,omitempty"`
+	Comments     string    `json:"comments,omitempty"`
+}
+
+// ChangeHistory contains a list of change records
+type ChangeHistory struct {
+	ChangeRecords []*ChangeRecord `json:"items"`
+}
+
+// Validate validates ChangeHistoryOptions
+func (opts *ChangeHistoryOptions) Validate() error {
+
+	if !opts.From.IsZero() && !opts.To.IsZero() && opts.To.Before(opts.From) {
+		return fmt.Errorf("%w: ChangeHistoryOptions To must not be before From", ErrStructValidation)
+	}
+
+	return nil
+}
+
+func (p *gtm) GetChangeHistory(ctx context.Context, domainName string, opts *ChangeHistoryOptions) ([]*ChangeRecord, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("GetChangeHistory")
+
+	if domainName == "" {
+		return nil, fmt.Errorf("%w: domain name is required", ErrBadRequest)
+	}
+
+	query := url.Values{}
+	if opts != nil {
+		if err := p.validate(ctx, opts); err != nil {
+			return nil, fmt.Errorf("GetChangeHistory request failed: %w", err)
+		}
+		if !opts.From.IsZero() {
+			query.Set("from", opts.From.UTC().Format(time.RFC3339))
+		}
+		if !opts.To.IsZero() {
+			query.Set("to", opts.To.UTC().Format(time.RFC3339))
+		}
+	}
+
+	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/history", domainName)
+	if len(query) > 0 {
+		getURL = fmt.Sprintf("%s?%s", getURL, query.Encode())
+	}
+
+	var history ChangeHistory
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &history, http.StatusOK); err != nil {
+		return nil, requestError("GetChangeHistory", err)
+	}
+
+	if history.ChangeRecords == nil {
+		return []*ChangeRecord{}, nil
+	}
+
+	return history.ChangeRecords, nil
+}
@@ -0,0 +1,146 @@
+package gtm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGtm_GetChangeHistory(t *testing.T) {
+	respData, err := loadTestData("TestGtm_GetChangeHistory.resp.json")
+	require.NoError(t, err)
+
+	expected := []*ChangeRecord{
+		{
+			User:         "jdoe",
+			Timestamp:    time.Date(2023, 9, 21, 14, 38, 5, 0, time.UTC),
+			ResourceType: "property",
+			ResourceName: "www",
+			Action:       "UPDATE",
+			ChangeId:     "5beb11ae-8908-4bfe-8459-e88efc4d2fdc",
+			Comments:     "Increase weight of secondary datacenter",
+		},
+		{
+			User:         "asmith",
+			Timestamp:    time.Date(2023, 9, 20, 8, 12, 44, 0, time.UTC),
+			ResourceType: "geographicMap",
+			ResourceName: "UK Delivery",
+			Action:       "CREATE",
+			ChangeId:     "93a48b86-4fc3-4a5f-9ca2-036835034cc6",
+		},
+		{
+			User:         "asmith",
+			Timestamp:    time.Date(2023, 9, 19, 16, 1, 30, 0, time.UTC),
+			ResourceType: "datacenter",
+			ResourceName: "Winterfell",
+			Action:       "DELETE",
+			ChangeId:     "40e36abd-bfb2-4635-9fca-62175cf17007",
+		},
+	}
+
+	tests := map[string]struct {
+		domainName       string
+		opts             *ChangeHistoryOptions
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedResponse []*ChangeRecord
+		withError        error
+	}{
+		"200 OK": {
+			domainName:       "example.akadns.net",
+			responseStatus:   http.StatusOK,
+			responseBody:     string(respData),
+			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/history",
+			expectedResponse: expected,
+		},
+		"200 OK - date filtered": {
+			domainName: "example.akadns.net",
+			opts: &ChangeHistoryOptions{
+				From: time.Date(2023, 9, 19, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2023, 9, 22, 2, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+			},
+			responseStatus:   http.StatusOK,
+			responseBody:     string(respData),
+			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/history?from=2023-09-19T00%3A00%3A00Z&to=2023-09-22T00%3A00%3A00Z",
+			expectedResponse: expected,
+		},
+		"200 OK - from only": {
+			domainName:       "example.akadns.net",
+			opts:             &ChangeHistoryOptions{From: time.Date(2023, 9, 19, 0, 0, 0, 0, time.UTC)},
+			responseStatus:   http.StatusOK,
+			responseBody:     string(respData),
+			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/history?from=2023-09-19T00%3A00%3A00Z",
+			expectedResponse: expected,
+		},
+		"200 OK - empty history": {
+			domainName:       "example.akadns.net",
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"items": []}`,
+			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/history",
+			expectedResponse: []*ChangeRecord{},
+		},
+		"200 OK - history without items": {
+			domainName:       "example.akadns.net",
+			responseStatus:   http.StatusOK,
+			responseBody:     `{}`,
+			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/history",
+			expectedResponse: []*ChangeRecord{},
+		},
+		"missing domain name": {
+			withError: ErrBadRequest,
+		},
+		"to before from": {
+			domainName: "example.akadns.net",
+			opts: &ChangeHistoryOptions{
+				From: time.Date(2023, 9, 22, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2023, 9, 19, 0, 0, 0, 0, time.UTC),
+			},
+			withError: ErrStructValidation,
+		},
+		"500 internal server error": {
+			domainName:     "example.akadns.net",
+			responseStatus: http.StatusInternalServerError,
+			responseBody: `
+{
+    "type": "internal_error",
+    "title": "Internal Server Error",
+    "detail": "Error fetching change history",
+    "status": 500
+}`,
+			expectedPath: "/config-gtm/v1/domains/example.akadns.net/history",
+			withError: &Error{
+				Type:       "internal_error",
+				Title:      "Internal Server Error",
+				Detail:     "Error fetching change history",
+				StatusCode: http.StatusInternalServerError,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.GetChangeHistory(context.Background(), test.domainName, test.opts)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 	"github.com/stretchr/testify/assert"
@@ -24,6 +25,268 @@ func TestGtm_NewDomain(t *testing.T) {
 	assert.Equal(t, "primary", dom.Type)
 }
 
+func TestGtm_GetChangeStatus(t *testing.T) {
+	tests := map[string]struct {
+		domainName       string
+		changeID         ChangeID
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedResponse *ResponseStatus
+		withError        error
+	}{
+		"200 OK": {
+			domainName:     "example.akadns.net",
+			changeID:       "93a48b86-4fc3-4a5f-9ca2-036835034cc6",
+			responseStatus: http.StatusOK,
+			responseBody: `
+{
+    "changeId": "93a48b86-4fc3-4a5f-9ca2-036835034cc6",
+    "message": "Change Pending",
+    "passingValidation": true,
+    "propagationStatus": "PENDING",
+    "propagationStatusDate": "2023-09-20T08:12:44.000+0000"
+}`,
+			expectedPath: "/config-gtm/v1/domains/example.akadns.net/status/current",
+			expectedResponse: &ResponseStatus{
+				ChangeId:              "93a48b86-4fc3-4a5f-9ca2-036835034cc6",
+				Message:               "Change Pending",
+				PassingValidation:     true,
+				PropagationStatus:     PropagationStatusPending,
+				PropagationStatusDate: "2023-09-20T08:12:44.000+0000",
+			},
+		},
+		"200 OK - current change": {
+			domainName:       "example.akadns.net",
+			changeID:         ChangeIDCurrent,
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"changeId": "93a48b86-4fc3-4a5f-9ca2-036835034cc6", "propagationStatus": "COMPLETE"}`,
+			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/status/current",
+			expectedResponse: &ResponseStatus{ChangeId: "93a48b86-4fc3-4a5f-9ca2-036835034cc6", PropagationStatus: PropagationStatusComplete},
+		},
+		"200 OK - later change": {
+			domainName:       "example.akadns.net",
+			changeID:         "93a48b86-4fc3-4a5f-9ca2-036835034cc6",
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"changeId": "0e9dfd7e-ad61-4c2a-8a38-64a3e4c0b2f1", "propagationStatus": "PENDING"}`,
+			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/status/current",
+			expectedResponse: &ResponseStatus{ChangeId: "0e9dfd7e-ad61-4c2a-8a38-64a3e4c0b2f1", PropagationStatus: PropagationStatusPending},
+		},
+		"missing domain name": {
+			changeID:  "93a48b86-4fc3-4a5f-9ca2-036835034cc6",
+			withError: ErrBadRequest,
+		},
+		"missing change ID": {
+			domainName: "example.akadns.net",
+			withError:  ErrBadRequest,
+		},
+		"404 not found": {
+			domainName:     "example.akadns.net",
+			changeID:       "93a48b86-4fc3-4a5f-9ca2-036835034cc6",
+			responseStatus: http.StatusNotFound,
+			responseBody: `
+{
+    "type": "not_found",
+    "title": "Not Found",
+    "detail": "Domain not found",
+    "status": 404
+}`,
+			expectedPath: "/config-gtm/v1/domains/example.akadns.net/status/current",
+			withError: &Error{
+				Type:       "not_found",
+				Title:      "Not Found",
+				Detail:     "Domain not found",
+				StatusCode: http.StatusNotFound,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.GetChangeStatus(context.Background(), test.domainName, test.changeID)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestGtm_WaitForChangeStatus(t *testing.T) {
+	const changeID ChangeID = "93a48b86-4fc3-4a5f-9ca2-036835034cc6"
+	tests := map[string]struct {
+		statuses         []ResponseStatus
+		hang             bool
+		timeout          time.Duration
+		expectedCalls    int
+		expectedResponse *ResponseStatus
+		withError        func(*testing.T, error)
+	}{
+		"pending, then complete": {
+			statuses: []ResponseStatus{
+				{ChangeId: string(changeID), PropagationStatus: PropagationStatusPending},
+				{ChangeId: string(changeID), PropagationStatus: PropagationStatusPending},
+				{ChangeId: string(changeID), PropagationStatus: PropagationStatusComplete},
+			},
+			expectedCalls:    3,
+			expectedResponse: &ResponseStatus{ChangeId: string(changeID), PropagationStatus: PropagationStatusComplete},
+		},
+		"complete with a later change": {
+			statuses: []ResponseStatus{
+				{ChangeId: string(changeID), PropagationStatus: PropagationStatusPending},
+				{ChangeId: "0e9dfd7e-ad61-4c2a-8a38-64a3e4c0b2f1", PropagationStatus: PropagationStatusComplete},
+			},
+			expectedCalls:    2,
+			expectedResponse: &ResponseStatus{ChangeId: "0e9dfd7e-ad61-4c2a-8a38-64a3e4c0b2f1", PropagationStatus: PropagationStatusComplete},
+		},
+		"context timeout while waiting for response": {
+			hang:    true,
+			timeout: 50 * time.Millisecond,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, context.DeadlineExceeded), "want: %s; got: %s", context.DeadlineExceeded, err)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls int
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-gtm/v1/domains/example.akadns.net/status/current", r.URL.String())
+				if test.hang {
+					<-r.Context().Done()
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				err := json.NewEncoder(w).Encode(test.statuses[calls])
+				assert.NoError(t, err)
+				calls++
+			}))
+			defer mockServer.Close()
+			client := mockAPIClient(t, mockServer)
+
+			ctx := context.Background()
+			if test.timeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.timeout)
+				defer cancel()
+			}
+			// the change ID is all that is needed to resume waiting, e.g. after a restart
+			write := &ResponseStatus{ChangeId: string(changeID), PropagationStatus: PropagationStatusPending}
+			result, err := client.WaitForChangeStatus(ctx, "example.akadns.net", write.ChangeID(), WaitOptions{Interval: time.Millisecond})
+			assert.Equal(t, test.expectedCalls, calls)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+
+	t.Run("missing change ID", func(t *testing.T) {
+		client := Client(session.Must(session.New()))
+		_, err := client.WaitForChangeStatus(context.Background(), "example.akadns.net", "", WaitOptions{})
+		assert.True(t, errors.Is(err, ErrBadRequest), "want: %s; got: %s", ErrBadRequest, err)
+	})
+}
+
+func TestGtm_WaitForDomainStatus(t *testing.T) {
+	tests := map[string]struct {
+		statuses         []string
+		responseStatus   int
+		timeout          time.Duration
+		expectedCalls    int
+		expectedResponse *ResponseStatus
+		withError        func(*testing.T, error)
+	}{
+		"pending, then complete": {
+			statuses:         []string{PropagationStatusPending, PropagationStatusPending, PropagationStatusComplete},
+			responseStatus:   http.StatusOK,
+			expectedCalls:    3,
+			expectedResponse: &ResponseStatus{ChangeId: "93a48b86-4fc3-4a5f-9ca2-036835034cc6", PropagationStatus: PropagationStatusComplete},
+		},
+		"denied": {
+			statuses:         []string{PropagationStatusPending, PropagationStatusDenied},
+			responseStatus:   http.StatusOK,
+			expectedCalls:    2,
+			expectedResponse: &ResponseStatus{ChangeId: "93a48b86-4fc3-4a5f-9ca2-036835034cc6", PropagationStatus: PropagationStatusDenied},
+		},
+		"500 internal server error": {
+			statuses:       []string{PropagationStatusPending},
+			responseStatus: http.StatusInternalServerError,
+			expectedCalls:  1,
+			withError: func(t *testing.T, err error) {
+				var apiErr *Error
+				require.True(t, errors.As(err, &apiErr), "want: *Error; got: %s", err)
+				assert.Equal(t, http.StatusInternalServerError, apiErr.StatusCode)
+			},
+		},
+		"context timeout": {
+			statuses:       []string{PropagationStatusPending, PropagationStatusPending, PropagationStatusPending, PropagationStatusPending},
+			responseStatus: http.StatusOK,
+			timeout:        50 * time.Millisecond,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, context.DeadlineExceeded), "want: %s; got: %s", context.DeadlineExceeded, err)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls int
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-gtm/v1/domains/example.akadns.net/status/current", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				status := test.statuses[calls%len(test.statuses)]
+				calls++
+				w.WriteHeader(test.responseStatus)
+				if test.responseStatus != http.StatusOK {
+					_, err := w.Write([]byte(`{"type": "internal_error", "title": "Internal Server Error", "status": 500}`))
+					assert.NoError(t, err)
+					return
+				}
+				err := json.NewEncoder(w).Encode(ResponseStatus{ChangeId: "93a48b86-4fc3-4a5f-9ca2-036835034cc6", PropagationStatus: status})
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+
+			ctx := context.Background()
+			if test.timeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.timeout)
+				defer cancel()
+			}
+			result, err := client.WaitForDomainStatus(ctx, "example.akadns.net", WaitOptions{Interval: 20 * time.Millisecond})
+			if test.expectedCalls != 0 {
+				assert.Equal(t, test.expectedCalls, calls)
+			}
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+
+	t.Run("missing domain name", func(t *testing.T) {
+		client := Client(session.Must(session.New()))
+		_, err := client.WaitForDomainStatus(context.Background(), "", WaitOptions{})
+		assert.True(t, errors.Is(err, ErrBadRequest), "want: %s; got: %s", ErrBadRequest, err)
+	})
+}
+
 func TestGtm_ListDomains(t *testing.T) {
 	var result DomainsList
 
@@ -224,6 +487,18 @@ func TestGtm_GetDomain(t *testing.T) {
 				StatusCode: http.StatusInternalServerError,
 			},
 		},
+		"403 forbidden": {
+			domain:         "example.akadns.net",
+			responseStatus: http.StatusForbidden,
+			responseBody: []byte(`
+{
+    "type": "https://problems.luna.akamaiapis.net/config-gtm/v1/forbidden",
+    "title": "Forbidden",
+    "detail": "User does not have permission to access domain example.akadns.net"
+}`),
+			expectedPath: "/config-gtm/v1/domains/example.akadns.net",
+			withError:    ErrForbidden,
+		},
 	}
 
 	for name, test := range tests {
@@ -13,6 +13,30 @@ var (
 	ErrBadRequest = errors.New("missing argument")
 	// ErrNotFound used when status code is 404 Not Found
 	ErrNotFound = errors.New("404 Not Found")
+	// ErrForbidden is used when status code is 403 Forbidden, e.g. when the credentials lack permission for a domain
+	ErrForbidden = errors.New("403 Forbidden")
+	// ErrConflict is used when status code is 409 Conflict, e.g. when the domain was modified concurrently
+	ErrConflict = errors.New("409 Conflict")
+	// ErrRateLimited is used when status code is 429 Too Many Requests, so the request should be retried after a back off
+	ErrRateLimited = errors.New("429 Too Many Requests")
+	// ErrServerError is used when status code is 5xx
+	ErrServerError = errors.New("server error")
+	// ErrZeroWeightSum is returned when weights of all property traffic targets sum to zero
+	ErrZeroWeightSum = errors.New("traffic targets weights sum to zero")
+	// ErrUnexpectedContentType is returned when a response cannot be decoded because its content type is not JSON
+	ErrUnexpectedContentType = errors.New("unexpected response content type")
+	// ErrUnsupportedSchemaVersion is returned when the schema version set with ContextWithSchemaVersion is not supported
+	ErrUnsupportedSchemaVersion = errors.New("unsupported schema version")
+	// ErrNotModified is returned by conditional requests when the resource was not modified since the given time
+	ErrNotModified = errors.New("not modified")
+	// ErrCountryNotFound is returned when a country is not assigned to any datacenter of a GeoMap
+	ErrCountryNotFound = errors.New("country not found")
+	// ErrAssignmentNotFound is returned when a map has no assignment for the given datacenter
+	ErrAssignmentNotFound = errors.New("assignment not found")
+	// ErrCountriesNotCovered is matched by CoverageError, returned when required countries are not assigned in a GeoMap
+	ErrCountriesNotCovered = errors.New("countries not covered")
+	// ErrDatacenterNotFound is returned when a map references a datacenter which does not exist in the domain
+	ErrDatacenterNotFound = errors.New("datacenter not found")
 )
 
 type (
@@ -59,7 +83,36 @@ func (e *Error) Error() string {
 	if err != nil {
 		return fmt.Sprintf("error marshaling API error: %s", err)
 	}
-	return fmt.Sprintf("API error: \n%s", msg)
+
+	summary := "API error"
+	if location, ok := e.Location(); ok {
+		summary += fmt.Sprintf(" at %q", location)
+	}
+	if e.BehaviorName != "" {
+		summary += fmt.Sprintf(" in behavior %q", e.BehaviorName)
+	}
+	return fmt.Sprintf("%s: \n%s", summary, msg)
+}
+
+// Location returns the location of the element which caused the error, e.g. a JSON path in the request body,
+// and reports whether it was provided by the API
+func (e *Error) Location() (string, bool) {
+	return e.ErrorLocation, e.ErrorLocation != ""
+}
+
+// LogString returns the error as compact, single-line JSON, suitable for structured log aggregators
+func (e *Error) LogString() string {
+	msg, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Sprintf("error marshaling API error: %s", err)
+	}
+	return string(msg)
+}
+
+// IsValidationError reports whether the request was rejected by the server because of invalid content,
+// i.e. the error points to the invalid element or behavior
+func (e *Error) IsValidationError() bool {
+	return e.ErrorLocation != "" || e.BehaviorName != ""
 }
 
 // Is handles error comparisons
@@ -69,6 +122,22 @@ func (e *Error) Is(target error) bool {
 		return true
 	}
 
+	if errors.Is(target, ErrForbidden) && e.StatusCode == http.StatusForbidden {
+		return true
+	}
+
+	if errors.Is(target, ErrConflict) && e.StatusCode == http.StatusConflict {
+		return true
+	}
+
+	if errors.Is(target, ErrRateLimited) && e.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	if errors.Is(target, ErrServerError) && e.StatusCode >= http.StatusInternalServerError && e.StatusCode < 600 {
+		return true
+	}
+
 	var t *Error
 	if !errors.As(target, &t) {
 		return false
@@ -0,0 +1,188 @@
+package gtm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestError_LogString(t *testing.T) {
+	e := Error{
+		Type:          "https://problems.luna.akamaiapis.net/config-gtm/v1/propertyValidationError",
+		Title:         "Property Validation Failure",
+		Detail:        "Invalid configuration for property \"www\":\nno traffic targets",
+		ErrorLocation: "properties[0]",
+		StatusCode:    http.StatusBadRequest,
+	}
+
+	res := e.LogString()
+	assert.NotContains(t, res, "\n")
+	assert.True(t, json.Valid([]byte(res)))
+
+	var unmarshaled Error
+	require.NoError(t, json.Unmarshal([]byte(res), &unmarshaled))
+	unmarshaled.StatusCode = e.StatusCode
+	assert.Equal(t, e, unmarshaled)
+	assert.Contains(t, e.Error(), "\n")
+}
+
+func TestError_Location(t *testing.T) {
+	tests := map[string]struct {
+		responseBody     string
+		expectedLocation string
+		expectedOK       bool
+		expectedSummary  string
+	}{
+		"with error location": {
+			responseBody: `
+{
+    "type": "https://problems.luna.akamaiapis.net/config-gtm/v1/propertyValidationError",
+    "title": "Property Validation Failure",
+    "detail": "Invalid handout CNAME",
+    "errorLocation": "/trafficTargets/0/handoutCName",
+    "behaviorName": "trafficTarget"
+}`,
+			expectedLocation: "/trafficTargets/0/handoutCName",
+			expectedOK:       true,
+			expectedSummary:  `API error at "/trafficTargets/0/handoutCName" in behavior "trafficTarget": ` + "\n",
+		},
+		"without error location": {
+			responseBody: `
+{
+    "type": "internal_error",
+    "title": "Internal Server Error",
+    "detail": "Error creating property"
+}`,
+			expectedSummary: "API error: \n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res := &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       ioutil.NopCloser(strings.NewReader(test.responseBody)),
+				Request:    httptest.NewRequest(http.MethodPut, "/config-gtm/v1/domains/example.akadns.net/properties/www", nil),
+			}
+			err := Client(session.Must(session.New())).(*gtm).Error(res)
+
+			var apiErr *Error
+			require.True(t, errors.As(err, &apiErr))
+			location, ok := apiErr.Location()
+			assert.Equal(t, test.expectedLocation, location)
+			assert.Equal(t, test.expectedOK, ok)
+			assert.True(t, strings.HasPrefix(err.Error(), test.expectedSummary), "got: %s", err)
+		})
+	}
+}
+
+func TestError_Is(t *testing.T) {
+	tests := map[string]struct {
+		err      *Error
+		target   error
+		expected bool
+	}{
+		"403 is forbidden": {
+			err:      &Error{Title: "Forbidden", StatusCode: http.StatusForbidden},
+			target:   ErrForbidden,
+			expected: true,
+		},
+		"403 is not not found": {
+			err:    &Error{Title: "Forbidden", StatusCode: http.StatusForbidden},
+			target: ErrNotFound,
+		},
+		"404 is not found": {
+			err:      &Error{Title: "Not Found", StatusCode: http.StatusNotFound},
+			target:   ErrNotFound,
+			expected: true,
+		},
+		"401 is not forbidden": {
+			err:    &Error{Title: "Unauthorized", StatusCode: http.StatusUnauthorized},
+			target: ErrForbidden,
+		},
+		"409 is conflict": {
+			err:      &Error{Title: "Conflict", StatusCode: http.StatusConflict},
+			target:   ErrConflict,
+			expected: true,
+		},
+		"429 is rate limited": {
+			err:      &Error{Title: "Too Many Requests", StatusCode: http.StatusTooManyRequests},
+			target:   ErrRateLimited,
+			expected: true,
+		},
+		"429 is not server error": {
+			err:    &Error{Title: "Too Many Requests", StatusCode: http.StatusTooManyRequests},
+			target: ErrServerError,
+		},
+		"500 is server error": {
+			err:      &Error{Title: "Internal Server Error", StatusCode: http.StatusInternalServerError},
+			target:   ErrServerError,
+			expected: true,
+		},
+		"503 is server error": {
+			err:      &Error{Title: "Service Unavailable", StatusCode: http.StatusServiceUnavailable},
+			target:   ErrServerError,
+			expected: true,
+		},
+		"500 is not conflict": {
+			err:    &Error{Title: "Internal Server Error", StatusCode: http.StatusInternalServerError},
+			target: ErrConflict,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, errors.Is(test.err, test.target))
+		})
+	}
+}
+
+func TestError_IsValidationError(t *testing.T) {
+	tests := map[string]struct {
+		err      *Error
+		expected bool
+	}{
+		"error location": {
+			err:      &Error{Title: "Bad Request", ErrorLocation: "/properties/0/trafficTargets", StatusCode: http.StatusBadRequest},
+			expected: true,
+		},
+		"behavior name": {
+			err:      &Error{Title: "Bad Request", BehaviorName: "failover", StatusCode: http.StatusBadRequest},
+			expected: true,
+		},
+		"no location": {
+			err: &Error{Title: "Bad Request", StatusCode: http.StatusBadRequest},
+		},
+		"not found": {
+			err: &Error{Title: "Not Found", StatusCode: http.StatusNotFound},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.err.IsValidationError())
+		})
+	}
+}
+
+func TestError_IsRateLimitedResponse(t *testing.T) {
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, err := w.Write([]byte(`{"type": "https://problems.luna.akamaiapis.net/-/pep-authn/request-limit-exceeded", "title": "Too Many Requests"}`))
+		assert.NoError(t, err)
+	}))
+	client := mockAPIClient(t, mockServer)
+
+	_, err := client.GetDomainStatus(context.Background(), "example.akadns.net")
+	assert.True(t, errors.Is(err, ErrRateLimited), "want: %s; got: %s", ErrRateLimited, err)
+	assert.False(t, errors.Is(err, ErrServerError), "got: %s", err)
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
 
 //
@@ -21,10 +25,19 @@ type GeoMaps interface {
 	//
 	// See: https://techdocs.akamai.com/gtm/reference/get-geographic-maps
 	ListGeoMaps(context.Context, string) ([]*GeoMap, error)
+	// ListGeoMapNames retrieves names of all GeoMaps, extracted from the ListGeoMaps response.
+	//
+	// See: https://techdocs.akamai.com/gtm/reference/get-geographic-maps
+	ListGeoMapNames(context.Context, string) ([]string, error)
 	// GetGeoMap retrieves a GeoMap with the given name.
 	//
 	// See: https://techdocs.akamai.com/gtm/reference/get-geographic-map
 	GetGeoMap(context.Context, string, string) (*GeoMap, error)
+	// GetGeoMapIfModifiedSince retrieves a GeoMap with the given name if it was modified after the given time,
+	// e.g. LastModified of the previously retrieved GeoMap. ErrNotModified is returned if it was not modified.
+	//
+	// See: https://techdocs.akamai.com/gtm/reference/get-geographic-map
+	GetGeoMapIfModifiedSince(context.Context, string, string, time.Time) (*GeoMap, error)
 	// CreateGeoMap creates the datacenter identified by the receiver argument in the specified domain.
 	//
 	// See: https://techdocs.akamai.com/gtm/reference/put-geographic-map
@@ -37,6 +50,11 @@ type GeoMaps interface {
 	//
 	// See: https://techdocs.akamai.com/gtm/reference/put-geographic-map
 	UpdateGeoMap(context.Context, *GeoMap, string) (*ResponseStatus, error)
+	// TouchGeoMap retrieves the GeoMap with the given name and saves it back unchanged, e.g. to force its re-propagation
+	// after datacenters of the domain changed.
+	//
+	// See: https://techdocs.akamai.com/gtm/reference/put-geographic-map
+	TouchGeoMap(context.Context, string, string) (*ResponseStatus, error)
 }
 
 // GeoAssignment represents a GTM geo assignment element
@@ -51,6 +69,8 @@ type GeoMap struct {
 	Assignments       []*GeoAssignment `json:"assignments,omitempty"`
 	Name              string           `json:"name"`
 	Links             []*Link          `json:"links,omitempty"`
+	// LastModified is the value of the Last-Modified header of the response the GeoMap was retrieved with, if any
+	LastModified time.Time `json:"-"`
 }
 
 // GeoMapList represents the returned GTM GeoMap List body
@@ -58,19 +78,152 @@ type GeoMapList struct {
 	GeoMapItems []*GeoMap `json:"items"`
 }
 
-// Validate validates GeoMap
+// Validate validates GeoMap. Besides name and default datacenter, every datacenter can be assigned only once,
+// country codes have to be two character uppercase codes, and a country must not be assigned to more than one datacenter.
+// The first problem found is returned, see ValidateMaps for all of them.
 func (geo *GeoMap) Validate() error {
+	if errs := geo.validationErrors(); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
 
-	if len(geo.Name) < 1 {
-		return fmt.Errorf("GeoMap is missing Name")
+// validationErrors returns all problems found in the GeoMap
+func (geo *GeoMap) validationErrors() []error {
+	var errs []error
+	if geo.Name == "" {
+		errs = append(errs, fmt.Errorf("%w: GeoMap is missing Name", ErrStructValidation))
 	}
 	if geo.DefaultDatacenter == nil {
-		return fmt.Errorf("GeoMap is missing DefaultDatacenter")
+		errs = append(errs, fmt.Errorf("%w: GeoMap is missing DefaultDatacenter", ErrStructValidation))
+	}
+
+	datacenters := make(map[int]struct{}, len(geo.Assignments))
+	countries := make(map[string]int)
+	for i, assignment := range geo.Assignments {
+		if assignment == nil {
+			errs = append(errs, fmt.Errorf("%w: GeoMap assignment %d is nil", ErrStructValidation, i))
+			continue
+		}
+		if _, ok := datacenters[assignment.DatacenterId]; ok {
+			errs = append(errs, fmt.Errorf("%w: GeoMap has duplicate assignment for datacenter %d", ErrStructValidation, assignment.DatacenterId))
+		}
+		datacenters[assignment.DatacenterId] = struct{}{}
+
+		for _, country := range assignment.Countries {
+			if !countryCodeRegexp.MatchString(country) {
+				errs = append(errs, fmt.Errorf("%w: GeoMap assignment for datacenter %d has invalid country code %q", ErrStructValidation, assignment.DatacenterId, country))
+				continue
+			}
+			if dcID, ok := countries[country]; ok && dcID != assignment.DatacenterId {
+				errs = append(errs, fmt.Errorf("%w: GeoMap country %q is assigned to datacenters %d and %d", ErrStructValidation, country, dcID, assignment.DatacenterId))
+				continue
+			}
+			countries[country] = assignment.DatacenterId
+		}
+	}
+	return errs
+}
+
+// MarshalCanonical returns deterministic JSON representation of the GeoMap, e.g. for storing it in version control.
+// Object keys are sorted, lists like assignments and their countries are sorted, and links are omitted,
+// so the output does not depend on the order in which the API returned elements.
+func (geo *GeoMap) MarshalCanonical() ([]byte, error) {
+	return marshalCanonical(geo)
+}
+
+// MoveCountry moves the country code from the assignment it currently belongs to, to the assignment of the given datacenter.
+// The GeoMap is left unchanged and ErrCountryNotFound or ErrAssignmentNotFound is returned if the code is not assigned
+// to any datacenter or if there is no assignment for the target datacenter respectively.
+func (geo *GeoMap) MoveCountry(code string, toDatacenterID int) error {
+	var target, source *GeoAssignment
+	sourceIndex := -1
+	for _, assignment := range geo.Assignments {
+		if assignment.DatacenterId == toDatacenterID {
+			target = assignment
+		}
+		if sourceIndex >= 0 {
+			continue
+		}
+		for i, country := range assignment.Countries {
+			if country == code {
+				source, sourceIndex = assignment, i
+				break
+			}
+		}
+	}
+	if target == nil {
+		return fmt.Errorf("%w: GeoMap %q has no assignment for datacenter %d", ErrAssignmentNotFound, geo.Name, toDatacenterID)
+	}
+	if source == nil {
+		return fmt.Errorf("%w: country %q is not assigned to any datacenter in GeoMap %q", ErrCountryNotFound, code, geo.Name)
+	}
+	if source == target {
+		return nil
 	}
 
+	countries := make([]string, 0, len(source.Countries)-1)
+	countries = append(countries, source.Countries[:sourceIndex]...)
+	source.Countries = append(countries, source.Countries[sourceIndex+1:]...)
+	target.Countries = append(target.Countries, code)
+
 	return nil
 }
 
+// CoverageError is returned by GeoMap.ValidateCoverage when some of the required countries are not assigned
+// to any datacenter. It matches ErrCountriesNotCovered with errors.Is.
+type CoverageError struct {
+	Map string
+	// Uncovered lists the countries which are not assigned, for each of the required groups in their order.
+	// It is empty for groups which are fully covered.
+	Uncovered [][]string
+}
+
+func (e *CoverageError) Error() string {
+	var groups []string
+	for i, countries := range e.Uncovered {
+		if len(countries) > 0 {
+			groups = append(groups, fmt.Sprintf("group %d: %s", i, strings.Join(countries, ", ")))
+		}
+	}
+	return fmt.Sprintf("%s: GeoMap %q leaves countries to the default datacenter: %s", ErrCountriesNotCovered, e.Map, strings.Join(groups, "; "))
+}
+
+// Is handles error comparisons
+func (e *CoverageError) Is(target error) bool {
+	return target == ErrCountriesNotCovered
+}
+
+// ValidateCoverage checks that every country of each of the required groups is explicitly assigned to a datacenter,
+// rather than left to the default datacenter, e.g. to enforce that traffic of EU countries is routed explicitly.
+// Country codes are compared ignoring case. CoverageError listing the uncovered countries is returned otherwise.
+func (geo *GeoMap) ValidateCoverage(required [][]string) error {
+	assigned := make(map[string]struct{})
+	for _, assignment := range geo.Assignments {
+		if assignment == nil {
+			continue
+		}
+		for _, country := range assignment.Countries {
+			assigned[strings.ToUpper(country)] = struct{}{}
+		}
+	}
+
+	uncovered := make([][]string, len(required))
+	var missing bool
+	for i, group := range required {
+		for _, country := range group {
+			if _, ok := assigned[strings.ToUpper(country)]; !ok {
+				uncovered[i] = append(uncovered[i], country)
+				missing = true
+			}
+		}
+	}
+	if !missing {
+		return nil
+	}
+	return &CoverageError{Map: geo.Name, Uncovered: uncovered}
+}
+
 func (p *gtm) NewGeoMap(ctx context.Context, name string) *GeoMap {
 
 	logger := p.Log(ctx)
@@ -87,21 +240,29 @@ func (p *gtm) ListGeoMaps(ctx context.Context, domainName string) ([]*GeoMap, er
 
 	var geos GeoMapList
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/geographic-maps", domainName)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create ListGeoMaps request: %w", err)
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &geos, http.StatusOK); err != nil {
+		return nil, requestError("ListGeoMaps", err)
 	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &geos)
+
+	return geos.GeoMapItems, nil
+}
+
+func (p *gtm) ListGeoMapNames(ctx context.Context, domainName string) ([]string, error) {
+
+	logger := p.Log(ctx)
+	logger.Debug("ListGeoMapNames")
+
+	maps, err := p.ListGeoMaps(ctx, domainName)
 	if err != nil {
-		return nil, fmt.Errorf("ListGeoMaps request failed: %w", err)
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	names := make([]string, 0, len(maps))
+	for _, m := range maps {
+		names = append(names, m.Name)
 	}
 
-	return geos.GeoMapItems, nil
+	return names, nil
 }
 
 func (p *gtm) GetGeoMap(ctx context.Context, name, domainName string) (*GeoMap, error) {
@@ -111,19 +272,27 @@ func (p *gtm) GetGeoMap(ctx context.Context, name, domainName string) (*GeoMap,
 
 	var geo GeoMap
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/geographic-maps/%s", domainName, name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create GetGeoMap request: %w", err)
-	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &geo)
+	resp, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &geo, http.StatusOK)
 	if err != nil {
-		return nil, fmt.Errorf("GetGeoMap request failed: %w", err)
+		return nil, requestError("GetGeoMap", err)
 	}
+	geo.LastModified = lastModified(resp)
+
+	return &geo, nil
+}
+
+func (p *gtm) GetGeoMapIfModifiedSince(ctx context.Context, name, domainName string, since time.Time) (*GeoMap, error) {
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	logger := p.Log(ctx)
+	logger.Debug("GetGeoMapIfModifiedSince")
+
+	var geo GeoMap
+	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/geographic-maps/%s", domainName, name)
+	resp, err := p.getIfModifiedSince(ctx, getURL, since, &geo)
+	if err != nil {
+		return nil, requestError("GetGeoMapIfModifiedSince", err)
 	}
+	geo.LastModified = lastModified(resp)
 
 	return &geo, nil
 }
@@ -162,28 +331,39 @@ func (p *gtm) UpdateGeoMap(ctx context.Context, geo *GeoMap, domainName string)
 	return stat.Status, err
 }
 
-// Save GeoMap in given domain. Common path for Create and Update.
-func (geo *GeoMap) save(ctx context.Context, p *gtm, domainName string) (*GeoMapResponse, error) {
+func (p *gtm) TouchGeoMap(ctx context.Context, name, domainName string) (*ResponseStatus, error) {
 
-	if err := geo.Validate(); err != nil {
-		return nil, fmt.Errorf("GeoMap validation failed. %w", err)
-	}
+	logger := p.Log(ctx)
+	logger.Debug("TouchGeoMap")
 
-	putURL := fmt.Sprintf("/config-gtm/v1/domains/%s/geographic-maps/%s", domainName, geo.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, putURL, nil)
+	geo, err := p.GetGeoMap(ctx, name, domainName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create GeoMap request: %w", err)
+		return nil, fmt.Errorf("TouchGeoMap failed: %w", err)
 	}
 
-	var mapresp GeoMapResponse
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &mapresp, geo)
+	stat, err := geo.save(ctx, p, domainName)
 	if err != nil {
-		return nil, fmt.Errorf("GeoMap request failed: %w", err)
+		return nil, fmt.Errorf("TouchGeoMap failed: %w", err)
 	}
+	return stat.Status, nil
+}
+
+// Save GeoMap in given domain. Common path for Create and Update.
+func (geo *GeoMap) save(ctx context.Context, p *gtm, domainName string) (*GeoMapResponse, error) {
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return nil, p.Error(resp)
+	if err := p.validate(ctx, geo); err != nil {
+		return nil, fmt.Errorf("GeoMap validation failed. %w", err)
+	}
+	if p.datacenterPreflight {
+		if err := p.checkMapDatacenters(ctx, domainName, geo.Name, geo.datacenterIDs()); err != nil {
+			return nil, fmt.Errorf("GeoMap datacenter preflight failed: %w", err)
+		}
+	}
+
+	putURL := fmt.Sprintf("/config-gtm/v1/domains/%s/geographic-maps/%s", domainName, geo.Name)
+	var mapresp GeoMapResponse
+	if _, err := session.DoRequest(ctx, p, http.MethodPut, putURL, geo, &mapresp, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, requestError("GeoMap", err)
 	}
 
 	return &mapresp, nil
@@ -194,26 +374,15 @@ func (p *gtm) DeleteGeoMap(ctx context.Context, geo *GeoMap, domainName string)
 	logger := p.Log(ctx)
 	logger.Debug("DeleteGeoMap")
 
-	if err := geo.Validate(); err != nil {
+	if err := p.validate(ctx, geo); err != nil {
 		logger.Errorf("Resource validation failed. %w", err)
 		return nil, fmt.Errorf("GeoMap validation failed. %w", err)
 	}
 
 	delURL := fmt.Sprintf("/config-gtm/v1/domains/%s/geographic-maps/%s", domainName, geo.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, delURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Delete request: %w", err)
-	}
-
 	var mapresp ResponseBody
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &mapresp)
-	if err != nil {
-		return nil, fmt.Errorf("GeoMap request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodDelete, delURL, nil, &mapresp, http.StatusOK); err != nil {
+		return nil, requestError("GeoMap", err)
 	}
 
 	return mapresp.Status, nil
@@ -0,0 +1,68 @@
+package gtm
+
+import (
+	"regexp"
+)
+
+//
+// Fluent construction of gtm geomaps
+// Based on 1.4 schema
+//
+
+// GeoMapBuilder constructs a GeoMap step by step. The GeoMap is validated when calling Build.
+type GeoMapBuilder struct {
+	name              string
+	defaultDatacenter *DatacenterBase
+	assignments       []*GeoAssignment
+}
+
+// countryCodeRegexp matches two character country codes, e.g. ISO 3166-1 alpha-2 codes or special codes like A1
+var countryCodeRegexp = regexp.MustCompile(`^[A-Z][A-Z0-9]$`)
+
+// NewGeoMapBuilder returns an empty GeoMapBuilder
+func NewGeoMapBuilder() *GeoMapBuilder {
+	return &GeoMapBuilder{}
+}
+
+// WithName sets the name of the GeoMap
+func (b *GeoMapBuilder) WithName(name string) *GeoMapBuilder {
+	b.name = name
+	return b
+}
+
+// WithDefaultDatacenter sets the datacenter used for countries which are not assigned to any other datacenter
+func (b *GeoMapBuilder) WithDefaultDatacenter(dcID int, nickname string) *GeoMapBuilder {
+	b.defaultDatacenter = &DatacenterBase{DatacenterId: dcID, Nickname: nickname}
+	return b
+}
+
+// AddAssignment assigns countries, identified by their two letter uppercase codes, to the datacenter
+func (b *GeoMapBuilder) AddAssignment(dcID int, nickname string, countries ...string) *GeoMapBuilder {
+	assignment := &GeoAssignment{Countries: append([]string{}, countries...)}
+	assignment.DatacenterId = dcID
+	assignment.Nickname = nickname
+	b.assignments = append(b.assignments, assignment)
+	return b
+}
+
+// Build validates and returns the GeoMap. It fails if the GeoMap is missing name or default datacenter,
+// if a datacenter is assigned more than once or if any of the country codes is invalid.
+func (b *GeoMapBuilder) Build() (*GeoMap, error) {
+	geo := &GeoMap{
+		Name: b.name,
+	}
+	if b.defaultDatacenter != nil {
+		dc := *b.defaultDatacenter
+		geo.DefaultDatacenter = &dc
+	}
+	for _, assignment := range b.assignments {
+		asn := *assignment
+		asn.Countries = append([]string{}, assignment.Countries...)
+		geo.Assignments = append(geo.Assignments, &asn)
+	}
+	if err := geo.Validate(); err != nil {
+		return nil, err
+	}
+
+	return geo, nil
+}
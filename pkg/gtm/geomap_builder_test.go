@@ -0,0 +1,75 @@
+package gtm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoMapBuilder_Build(t *testing.T) {
+	tests := map[string]struct {
+		builder   *GeoMapBuilder
+		expected  *GeoMap
+		withError error
+	}{
+		"ok": {
+			builder: NewGeoMapBuilder().
+				WithName("UK Delivery").
+				WithDefaultDatacenter(5400, "Default Datacenter").
+				AddAssignment(3131, "Frankfurt", "DE", "AT").
+				AddAssignment(3132, "London", "GB"),
+			expected: &GeoMap{
+				Name:              "UK Delivery",
+				DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "Default Datacenter"},
+				Assignments: []*GeoAssignment{
+					{DatacenterBase: DatacenterBase{DatacenterId: 3131, Nickname: "Frankfurt"}, Countries: []string{"DE", "AT"}},
+					{DatacenterBase: DatacenterBase{DatacenterId: 3132, Nickname: "London"}, Countries: []string{"GB"}},
+				},
+			},
+		},
+		"missing name": {
+			builder:   NewGeoMapBuilder().WithDefaultDatacenter(5400, "Default Datacenter"),
+			withError: ErrStructValidation,
+		},
+		"missing default datacenter": {
+			builder:   NewGeoMapBuilder().WithName("UK Delivery"),
+			withError: ErrStructValidation,
+		},
+		"duplicate datacenter": {
+			builder: NewGeoMapBuilder().
+				WithName("UK Delivery").
+				WithDefaultDatacenter(5400, "Default Datacenter").
+				AddAssignment(3131, "Frankfurt", "DE").
+				AddAssignment(3131, "Frankfurt", "AT"),
+			withError: ErrStructValidation,
+		},
+		"invalid country code": {
+			builder: NewGeoMapBuilder().
+				WithName("UK Delivery").
+				WithDefaultDatacenter(5400, "Default Datacenter").
+				AddAssignment(3131, "Frankfurt", "DEU"),
+			withError: ErrStructValidation,
+		},
+		"lowercase country code": {
+			builder: NewGeoMapBuilder().
+				WithName("UK Delivery").
+				WithDefaultDatacenter(5400, "Default Datacenter").
+				AddAssignment(3131, "Frankfurt", "de"),
+			withError: ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := test.builder.Build()
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
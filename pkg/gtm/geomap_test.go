@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 	"github.com/stretchr/testify/assert"
@@ -177,6 +178,31 @@ func TestGtm_GetGeoMap(t *testing.T) {
 	}
 }
 
+func TestGtm_GetGeoMapWithExecer(t *testing.T) {
+	respData, err := loadTestData("TestGtm_GetGeoMap.resp.json")
+	require.NoError(t, err)
+	var expected GeoMap
+	require.NoError(t, json.Unmarshal(respData, &expected))
+
+	var calls int
+	execer := session.ExecerFunc(func(r *http.Request, out interface{}, in ...interface{}) (*http.Response, error) {
+		calls++
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/config-gtm/v1/domains/example.akadns.net/geographic-maps/Software-rollout", r.URL.String())
+		assert.Empty(t, in)
+		if err := json.Unmarshal(respData, out); err != nil {
+			return nil, err
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: r}, nil
+	})
+
+	client := Client(session.WithExecer(session.Must(session.New()), execer))
+	result, err := client.GetGeoMap(context.Background(), "Software-rollout", "example.akadns.net")
+	require.NoError(t, err)
+	assert.Equal(t, &expected, result)
+	assert.Equal(t, 1, calls)
+}
+
 func TestGtm_NewGeoAssignment(t *testing.T) {
 	client := Client(session.Must(session.New()))
 
@@ -187,7 +213,7 @@ func TestGtm_NewGeoAssignment(t *testing.T) {
 }
 
 func TestGtm_CreateGeoMap(t *testing.T) {
-	var result GeoMapResponse
+	var result, resultWithWarnings GeoMapResponse
 	var req GeoMap
 
 	respData, err := loadTestData("TestGtm_CreateGeoMap.resp.json")
@@ -199,6 +225,22 @@ func TestGtm_CreateGeoMap(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	warningsData, err := loadTestData("TestGtm_CreateGeoMapWarnings.resp.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := json.NewDecoder(bytes.NewBuffer(warningsData)).Decode(&resultWithWarnings); err != nil {
+		t.Fatal(err)
+	}
+	require.Len(t, resultWithWarnings.Warnings, 1)
+	assert.Equal(t, &Warning{
+		Type:          "https://problems.luna.akamaiapis.net/config-gtm/v1/unusedDatacenter",
+		Title:         "Unused datacenter",
+		Detail:        "Datacenter 3132 is not used by any property",
+		ErrorLocation: "/assignments/0",
+	}, resultWithWarnings.Warnings[0])
+
 	reqData, err := loadTestData("TestGtm_CreateGeoMap.req.json")
 	if err != nil {
 		t.Fatal(err)
@@ -229,6 +271,17 @@ func TestGtm_CreateGeoMap(t *testing.T) {
 			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/geographic-maps/UK%20Delivery",
 			expectedResponse: &result,
 		},
+		"200 OK with warnings": {
+			geomap:     &req,
+			domainName: "example.akadns.net",
+			headers: http.Header{
+				"Content-Type": []string{"application/vnd.config-gtm.v1.4+json;charset=UTF-8"},
+			},
+			responseStatus:   http.StatusOK,
+			responseBody:     string(warningsData),
+			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/geographic-maps/UK%20Delivery",
+			expectedResponse: &resultWithWarnings,
+		},
 		"500 internal server error": {
 			geomap:         &req,
 			domainName:     "example.akadns.net",
@@ -449,3 +502,329 @@ func TestGtm_DeleteGeoMap(t *testing.T) {
 		})
 	}
 }
+
+func TestGeoMap_MarshalCanonical(t *testing.T) {
+	geo := &GeoMap{
+		Name:              "UK Delivery",
+		DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "Default Mapping"},
+		Assignments: []*GeoAssignment{
+			{DatacenterBase: DatacenterBase{DatacenterId: 3133, Nickname: "UK users"}, Countries: []string{"IE", "GB"}},
+			{DatacenterBase: DatacenterBase{DatacenterId: 3131, Nickname: "DE users"}, Countries: []string{"DE"}},
+		},
+		Links: []*Link{{Rel: "self", Href: "/config-gtm/v1/domains/example.akadns.net/geographic-maps/UK%20Delivery"}},
+	}
+	reordered := &GeoMap{
+		Name:              "UK Delivery",
+		DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "Default Mapping"},
+		Assignments: []*GeoAssignment{
+			{DatacenterBase: DatacenterBase{DatacenterId: 3131, Nickname: "DE users"}, Countries: []string{"DE"}},
+			{DatacenterBase: DatacenterBase{DatacenterId: 3133, Nickname: "UK users"}, Countries: []string{"GB", "IE"}},
+		},
+	}
+
+	expected := `{"assignments":[{"countries":["DE"],"datacenterId":3131,"nickname":"DE users"},` +
+		`{"countries":["GB","IE"],"datacenterId":3133,"nickname":"UK users"}],` +
+		`"defaultDatacenter":{"datacenterId":5400,"nickname":"Default Mapping"},"name":"UK Delivery"}`
+
+	for i := 0; i < 10; i++ {
+		data, err := geo.MarshalCanonical()
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(data))
+
+		data, err = reordered.MarshalCanonical()
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(data))
+	}
+}
+
+func TestGeoMap_MoveCountry(t *testing.T) {
+	newGeoMap := func() *GeoMap {
+		return &GeoMap{
+			Name:              "UK Delivery",
+			DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "Default Mapping"},
+			Assignments: []*GeoAssignment{
+				{DatacenterBase: DatacenterBase{DatacenterId: 3133, Nickname: "UK users"}, Countries: []string{"GB", "IE", "DE"}},
+				{DatacenterBase: DatacenterBase{DatacenterId: 3131, Nickname: "DE users"}, Countries: []string{"AT"}},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		code         string
+		toDatacenter int
+		expected     [][]string
+		withError    error
+	}{
+		"move country": {
+			code:         "DE",
+			toDatacenter: 3131,
+			expected:     [][]string{{"GB", "IE"}, {"AT", "DE"}},
+		},
+		"move first country": {
+			code:         "GB",
+			toDatacenter: 3131,
+			expected:     [][]string{{"IE", "DE"}, {"AT", "GB"}},
+		},
+		"country already assigned to target datacenter": {
+			code:         "AT",
+			toDatacenter: 3131,
+			expected:     [][]string{{"GB", "IE", "DE"}, {"AT"}},
+		},
+		"country not found": {
+			code:         "FR",
+			toDatacenter: 3131,
+			withError:    ErrCountryNotFound,
+		},
+		"target assignment not found": {
+			code:         "DE",
+			toDatacenter: 3132,
+			withError:    ErrAssignmentNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			geo := newGeoMap()
+			err := geo.MoveCountry(test.code, test.toDatacenter)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				assert.Equal(t, newGeoMap(), geo, "GeoMap should not be modified")
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, geo.Assignments, len(test.expected))
+			for i, countries := range test.expected {
+				assert.Equal(t, countries, geo.Assignments[i].Countries)
+			}
+		})
+	}
+}
+
+func TestGeoMap_ValidateCoverage(t *testing.T) {
+	geo := &GeoMap{
+		Name:              "EU Delivery",
+		DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "Default Mapping"},
+		Assignments: []*GeoAssignment{
+			{DatacenterBase: DatacenterBase{DatacenterId: 3131, Nickname: "Frankfurt"}, Countries: []string{"DE", "AT", "PL"}},
+			{DatacenterBase: DatacenterBase{DatacenterId: 3132, Nickname: "Paris"}, Countries: []string{"FR", "BE"}},
+		},
+	}
+
+	tests := map[string]struct {
+		required          [][]string
+		expectedUncovered [][]string
+	}{
+		"all groups covered": {
+			required: [][]string{{"DE", "AT"}, {"fr", "BE"}},
+		},
+		"no required groups": {},
+		"uncovered countries": {
+			required:          [][]string{{"DE", "NL", "AT", "LU"}, {"FR"}, {"IT"}},
+			expectedUncovered: [][]string{{"NL", "LU"}, nil, {"IT"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := geo.ValidateCoverage(test.required)
+			if test.expectedUncovered == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, ErrCountriesNotCovered), "want: %s; got: %s", ErrCountriesNotCovered, err)
+			var coverageErr *CoverageError
+			require.True(t, errors.As(err, &coverageErr))
+			assert.Equal(t, "EU Delivery", coverageErr.Map)
+			assert.Equal(t, test.expectedUncovered, coverageErr.Uncovered)
+			assert.Contains(t, err.Error(), "group 0: NL, LU; group 2: IT")
+		})
+	}
+}
+
+func TestGtm_GetGeoMapIfModifiedSince(t *testing.T) {
+	var result GeoMap
+
+	respData, err := loadTestData("TestGtm_GetGeoMap.resp.json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(respData, &result))
+	result.LastModified = time.Date(2023, 9, 21, 14, 38, 5, 0, time.UTC)
+
+	tests := map[string]struct {
+		since                   time.Time
+		responseStatus          int
+		responseBody            string
+		expectedIfModifiedSince string
+		expectedResponse        *GeoMap
+		withError               error
+	}{
+		"200 OK": {
+			since:                   time.Date(2023, 9, 20, 10, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+			responseStatus:          http.StatusOK,
+			responseBody:            string(respData),
+			expectedIfModifiedSince: "Wed, 20 Sep 2023 08:00:00 GMT",
+			expectedResponse:        &result,
+		},
+		"200 OK - zero time sends no condition": {
+			responseStatus:   http.StatusOK,
+			responseBody:     string(respData),
+			expectedResponse: &result,
+		},
+		"304 Not Modified": {
+			since:                   time.Date(2023, 9, 21, 14, 38, 5, 0, time.UTC),
+			responseStatus:          http.StatusNotModified,
+			expectedIfModifiedSince: "Thu, 21 Sep 2023 14:38:05 GMT",
+			withError:               ErrNotModified,
+		},
+		"500 internal server error": {
+			since:          time.Date(2023, 9, 21, 14, 38, 5, 0, time.UTC),
+			responseStatus: http.StatusInternalServerError,
+			responseBody: `
+{
+    "type": "internal_error",
+    "title": "Internal Server Error",
+    "detail": "Error fetching geomap",
+    "status": 500
+}`,
+			expectedIfModifiedSince: "Thu, 21 Sep 2023 14:38:05 GMT",
+			withError: &Error{
+				Type:       "internal_error",
+				Title:      "Internal Server Error",
+				Detail:     "Error fetching geomap",
+				StatusCode: http.StatusInternalServerError,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-gtm/v1/domains/example.akadns.net/geographic-maps/Software-rollout", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, test.expectedIfModifiedSince, r.Header.Get("If-Modified-Since"))
+				w.Header().Set("Last-Modified", "Thu, 21 Sep 2023 14:38:05 GMT")
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.GetGeoMapIfModifiedSince(context.Background(), "Software-rollout", "example.akadns.net", test.since)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestGtm_TouchGeoMap(t *testing.T) {
+	respData, err := loadTestData("TestGtm_GetGeoMap.resp.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fetched GeoMap
+	if err := json.NewDecoder(bytes.NewBuffer(respData)).Decode(&fetched); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		getStatus        int
+		getBody          string
+		expectedMethods  []string
+		expectedResponse *ResponseStatus
+		withError        error
+	}{
+		"200 OK": {
+			getStatus:       http.StatusOK,
+			getBody:         string(respData),
+			expectedMethods: []string{http.MethodGet, http.MethodPut},
+			expectedResponse: &ResponseStatus{
+				Message:               "Change Pending",
+				ChangeId:              "5beb11ae-8908-4bfe-8459-e88efc4d2fdc",
+				PropagationStatus:     "PENDING",
+				PropagationStatusDate: "2019-04-25T14:54:00.000+00:00",
+				PassingValidation:     true,
+			},
+		},
+		"404 not found": {
+			getStatus:       http.StatusNotFound,
+			getBody:         `{"type": "https://problems.luna.akamaiapis.net/config-gtm/v1/notFound", "title": "Not Found", "detail": "GeoMap not found"}`,
+			expectedMethods: []string{http.MethodGet},
+			withError:       ErrNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var methods []string
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-gtm/v1/domains/example.akadns.net/geographic-maps/UK%20Delivery", r.URL.String())
+				methods = append(methods, r.Method)
+				if r.Method == http.MethodGet {
+					w.WriteHeader(test.getStatus)
+					_, err := w.Write([]byte(test.getBody))
+					assert.NoError(t, err)
+					return
+				}
+
+				var saved GeoMap
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&saved))
+				assert.Equal(t, fetched, saved)
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`{"resource": ` + test.getBody + `, "status": {"message": "Change Pending", "changeId": "5beb11ae-8908-4bfe-8459-e88efc4d2fdc", "propagationStatus": "PENDING", "propagationStatusDate": "2019-04-25T14:54:00.000+00:00", "passingValidation": true}}`))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.TouchGeoMap(context.Background(), "UK Delivery", "example.akadns.net")
+			assert.Equal(t, test.expectedMethods, methods)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestGtm_GetGeoMapResponseRecorder(t *testing.T) {
+	respData, err := loadTestData("TestGtm_GetGeoMap.resp.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expected GeoMap
+	if err := json.NewDecoder(bytes.NewBuffer(respData)).Decode(&expected); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, opts := range map[string][]Option{
+		"default":                 nil,
+		"content type validation": {WithContentTypeValidation(true)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-gtm/v1/domains/example.akadns.net/geographic-maps/UK%20Delivery", r.URL.String())
+				w.Header().Set("Content-Type", "application/vnd.config-gtm.v1.4+json;charset=UTF-8")
+				w.Header().Set("ETag", `"2a8c"`)
+				w.Header().Set("X-Request-Id", "8b5e7f3a")
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write(respData)
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer, opts...)
+
+			ctx, recorder := session.ContextWithResponseRecorder(context.Background())
+			result, err := client.GetGeoMap(ctx, "UK Delivery", "example.akadns.net")
+			require.NoError(t, err)
+			assert.Equal(t, &expected, result)
+
+			resp := recorder.Response()
+			require.NotNil(t, resp)
+			assert.Equal(t, `"2a8c"`, resp.Header.Get("ETag"))
+			assert.Equal(t, "8b5e7f3a", resp.Header.Get("X-Request-Id"))
+		})
+	}
+}
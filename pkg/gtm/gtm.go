@@ -4,8 +4,16 @@
 package gtm
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
@@ -15,6 +23,9 @@ var (
 	ErrStructValidation = errors.New("struct validation")
 )
 
+// APIVersion is the version of the GTM API used by the client, as in /config-gtm/v1 paths
+const APIVersion = "v1"
+
 type (
 	// GTM is the gtm api interface
 	GTM interface {
@@ -25,10 +36,18 @@ type (
 		ASMaps
 		GeoMaps
 		CidrMaps
+
+		// CheckAPIVersion verifies that APIVersion of the GTM API is available for the account.
+		// If it is not, the returned error wraps *session.APIVersionError.
+		//
+		// See: https://techdocs.akamai.com/gtm/reference/get-identity
+		CheckAPIVersion(context.Context) error
 	}
 
 	gtm struct {
 		session.Session
+		validateContentType bool
+		datacenterPreflight bool
 	}
 
 	// Option defines a GTM option
@@ -36,6 +55,10 @@ type (
 
 	// ClientFunc is a gtm client new method, this can used for mocking
 	ClientFunc func(sess session.Session, opts ...Option) GTM
+
+	validator interface {
+		Validate() error
+	}
 )
 
 // Client returns a new dns Client instance with the specified controller
@@ -50,8 +73,149 @@ func Client(sess session.Session, opts ...Option) GTM {
 	return p
 }
 
-// Exec overrides the session.Exec to add dns options
+func (p *gtm) CheckAPIVersion(ctx context.Context) error {
+	logger := p.Log(ctx)
+	logger.Debug("CheckAPIVersion")
+
+	checkURL := fmt.Sprintf("/config-gtm/%s/identity", APIVersion)
+	if err := session.CheckAPIVersion(ctx, p, "config-gtm", APIVersion, checkURL); err != nil {
+		return fmt.Errorf("CheckAPIVersion request failed: %w", err)
+	}
+
+	return nil
+}
+
+// WithDefaultHeaders adds the headers to every request made by the client, e.g. a static team identifier.
+// Headers set by the client methods and with session.WithContextHeaders take precedence, see session.WithDefaultHeaders.
+func WithDefaultHeaders(h http.Header) Option {
+	return func(c *gtm) {
+		c.Session = session.WithDefaultHeaders(c.Session, h)
+	}
+}
+
+// WithContentTypeValidation enables checking that successful responses have a JSON content type before
+// they are decoded, so that e.g. an HTML page returned by a proxy results in ErrUnexpectedContentType
+// instead of a confusing unmarshaling error. Responses without Content-Type header are always decoded,
+// while empty bodies are skipped, as in session.Exec.
+func WithContentTypeValidation(validate bool) Option {
+	return func(p *gtm) {
+		p.validateContentType = validate
+	}
+}
+
+// WithDatacenterPreflight enables checking that every datacenter referenced by a GeoMap or CidrMap exists
+// in the domain before the map is created or updated. The check costs an additional ListDatacenters request,
+// and MissingDatacentersError is returned if any of the datacenters does not exist.
+func WithDatacenterPreflight(enabled bool) Option {
+	return func(p *gtm) {
+		p.datacenterPreflight = enabled
+	}
+}
+
+// Exec overrides the session.Exec to add gtm schema version headers
 func (p *gtm) Exec(r *http.Request, out interface{}, in ...interface{}) (*http.Response, error) {
+	if err := validateSchemaVersion(r.Context()); err != nil {
+		return nil, err
+	}
+	if r.Header.Get("Accept") == "" {
+		setVersionHeader(r, schemaVersion)
+	}
+
+	if !p.validateContentType || out == nil {
+		return p.Session.Exec(r, out, in...)
+	}
+
+	resp, err := p.Session.Exec(r, nil, in...)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices ||
+		resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusResetContent {
+		return resp, nil
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewBuffer(data))
+
+	// same as in session.Exec, out is left untouched when a success response has no body
+	if len(bytes.TrimSpace(data)) == 0 {
+		return resp, nil
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" && !isJSONContentType(contentType) {
+		return nil, fmt.Errorf("%w: got %q with body: %.100q", ErrUnexpectedContentType, contentType, data)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return nil, fmt.Errorf("%w: %s", session.ErrUnmarshaling, err)
+	}
+
+	return resp, nil
+}
+
+// isJSONContentType reports whether the media type is application/json or its variant,
+// e.g. application/vnd.config-gtm.v1.4+json or application/problem+json
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	slash := strings.Index(mediaType, "/")
+	if slash < 0 {
+		return false
+	}
+	subtype := mediaType[slash+1:]
+	return subtype == "json" || strings.HasSuffix(subtype, "+json")
+}
+
+// validate runs client-side validation of v, unless it was disabled for the call with ContextWithSkipValidation
+func (p *gtm) validate(ctx context.Context, v validator) error {
+	if contextSkipValidation(ctx) {
+		return nil
+	}
+	return v.Validate()
+}
+
+// getIfModifiedSince fetches the resource into out, unless it was not modified since the given time,
+// in which case ErrNotModified is returned. The condition is not sent if since is zero.
+func (p *gtm) getIfModifiedSince(ctx context.Context, getURL string, since time.Time, out interface{}) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
+	if !since.IsZero() {
+		req.Header.Set("If-Modified-Since", since.UTC().Format(http.TimeFormat))
+	}
 
-	return p.Session.Exec(r, out, in...)
+	resp, err := p.Exec(req, out)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp, nil
+	case http.StatusNotModified:
+		return nil, ErrNotModified
+	}
+	return nil, p.Error(resp)
+}
+
+// requestError wraps err of a failed request with the name of the operation. The *Error parsed from the API
+// response is returned as is, so that callers can keep asserting its type.
+func requestError(operation string, err error) error {
+	if _, ok := err.(*Error); ok {
+		return err
+	}
+	return fmt.Errorf("%s request failed: %w", operation, err)
+}
+
+// lastModified returns the time from the Last-Modified header of the response, or zero time if it is missing or invalid
+func lastModified(resp *http.Response) time.Time {
+	t, err := http.ParseTime(resp.Header.Get("Last-Modified"))
+	if err != nil {
+		return time.Time{}
+	}
+	return t
 }
@@ -1,13 +1,16 @@
 package gtm
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/edgegrid"
@@ -16,7 +19,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func mockAPIClient(t *testing.T, mockServer *httptest.Server) GTM {
+func mockAPIClient(t *testing.T, mockServer *httptest.Server, opts ...Option) GTM {
 	serverURL, err := url.Parse(mockServer.URL)
 	require.NoError(t, err)
 	certPool := x509.NewCertPool()
@@ -30,7 +33,7 @@ func mockAPIClient(t *testing.T, mockServer *httptest.Server) GTM {
 	}
 	s, err := session.New(session.WithClient(httpClient), session.WithSigner(&edgegrid.Config{Host: serverURL.Host}))
 	assert.NoError(t, err)
-	return Client(s)
+	return Client(s, opts...)
 }
 
 func dummyOpt() Option {
@@ -67,6 +70,13 @@ func TestClient(t *testing.T) {
 				Session: sess,
 			},
 		},
+		"content type validation option": {
+			options: []Option{WithContentTypeValidation(true)},
+			expected: &gtm{
+				Session:             sess,
+				validateContentType: true,
+			},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -75,3 +85,315 @@ func TestClient(t *testing.T) {
 		})
 	}
 }
+
+func TestGtm_ContentTypeValidation(t *testing.T) {
+	tests := map[string]struct {
+		validate     bool
+		contentType  string
+		responseBody string
+		expectedName string
+		withError    error
+	}{
+		"vendor json content type": {
+			validate:     true,
+			contentType:  "application/vnd.config-gtm.v1.4+json;charset=UTF-8",
+			responseBody: `{"name": "example.akadns.net", "type": "weighted"}`,
+			expectedName: "example.akadns.net",
+		},
+		"plain json content type": {
+			validate:     true,
+			contentType:  "application/json",
+			responseBody: `{"name": "example.akadns.net", "type": "weighted"}`,
+			expectedName: "example.akadns.net",
+		},
+		"html content type": {
+			validate:     true,
+			contentType:  "text/html; charset=utf-8",
+			responseBody: `<html><body>Service Unavailable</body></html>`,
+			withError:    ErrUnexpectedContentType,
+		},
+		"empty body": {
+			validate:    true,
+			contentType: "application/json",
+		},
+		"whitespace only body with html content type": {
+			validate:     true,
+			contentType:  "text/html; charset=utf-8",
+			responseBody: " \n",
+		},
+		"html content type without validation": {
+			contentType:  "text/html; charset=utf-8",
+			responseBody: `<html><body>Service Unavailable</body></html>`,
+			withError:    session.ErrUnmarshaling,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", test.contentType)
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer, WithContentTypeValidation(test.validate))
+			result, err := client.GetDomain(context.Background(), "example.akadns.net")
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedName, result.Name)
+		})
+	}
+}
+
+func TestGtm_APIErrorNotWrapped(t *testing.T) {
+	tests := map[string]func(GTM) error{
+		"GetDomain": func(c GTM) error {
+			_, err := c.GetDomain(context.Background(), "example.akadns.net")
+			return err
+		},
+		"GetGeoMap": func(c GTM) error {
+			_, err := c.GetGeoMap(context.Background(), "Software-rollout", "example.akadns.net")
+			return err
+		},
+		"GetDatacenter": func(c GTM) error {
+			_, err := c.GetDatacenter(context.Background(), 1, "example.akadns.net")
+			return err
+		},
+		"ListResources": func(c GTM) error {
+			_, err := c.ListResources(context.Background(), "example.akadns.net")
+			return err
+		},
+	}
+
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/problem+json")
+				w.WriteHeader(http.StatusNotFound)
+				_, err := w.Write([]byte(`{"type": "not_found", "title": "Not Found", "status": 404}`))
+				assert.NoError(t, err)
+			}))
+			defer mockServer.Close()
+			client := mockAPIClient(t, mockServer)
+
+			err := call(client)
+			apiError, ok := err.(*Error)
+			require.True(t, ok, "want: *Error; got: %T %s", err, err)
+			assert.Equal(t, http.StatusNotFound, apiError.StatusCode)
+		})
+	}
+}
+
+func TestGtm_ListMapNames(t *testing.T) {
+	listGeoMapNames := func(c GTM) ([]string, error) {
+		return c.ListGeoMapNames(context.Background(), "example.akadns.net")
+	}
+	listCidrMapNames := func(c GTM) ([]string, error) {
+		return c.ListCidrMapNames(context.Background(), "example.akadns.net")
+	}
+
+	tests := map[string]struct {
+		call             func(GTM) ([]string, error)
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedResponse []string
+		withError        error
+	}{
+		"geographic maps": {
+			call:             listGeoMapNames,
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"items": [{"name": "UK Delivery"}, {"name": "US Delivery"}]}`,
+			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/geographic-maps",
+			expectedResponse: []string{"UK Delivery", "US Delivery"},
+		},
+		"cidr maps": {
+			call:             listCidrMapNames,
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"items": [{"name": "The North"}, {"name": "The South"}]}`,
+			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/cidr-maps",
+			expectedResponse: []string{"The North", "The South"},
+		},
+		"no geographic maps": {
+			call:             listGeoMapNames,
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"items": []}`,
+			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/geographic-maps",
+			expectedResponse: []string{},
+		},
+		"no cidr maps": {
+			call:             listCidrMapNames,
+			responseStatus:   http.StatusOK,
+			responseBody:     `{"items": []}`,
+			expectedPath:     "/config-gtm/v1/domains/example.akadns.net/cidr-maps",
+			expectedResponse: []string{},
+		},
+		"500 internal server error": {
+			call:           listCidrMapNames,
+			responseStatus: http.StatusInternalServerError,
+			responseBody:   `{"type": "internal_error", "title": "Internal Server Error", "detail": "Error fetching maps", "status": 500}`,
+			expectedPath:   "/config-gtm/v1/domains/example.akadns.net/cidr-maps",
+			withError: &Error{
+				Type:       "internal_error",
+				Title:      "Internal Server Error",
+				Detail:     "Error fetching maps",
+				StatusCode: http.StatusInternalServerError,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := test.call(client)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestGtm_RoundTripFunc(t *testing.T) {
+	var requests int
+	sess, err := session.New(
+		session.WithSigner(&edgegrid.Config{Host: "akab-test.luna.akamaiapis.net", ClientToken: "ct", AccessToken: "at", ClientSecret: "cs"}),
+		session.WithRoundTripFunc(func(r *http.Request) (*http.Response, error) {
+			requests++
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "https://akab-test.luna.akamaiapis.net/config-gtm/v1/domains/example.akadns.net/status/current", r.URL.String())
+			assert.Contains(t, r.Header.Get("Authorization"), "EG1-HMAC-SHA256 client_token=ct;access_token=at;")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       ioutil.NopCloser(strings.NewReader(`{"changeId": "40e36abd-bfb2-4635-9fca-62175cf17007", "propagationStatus": "COMPLETE"}`)),
+				Request:    r,
+			}, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	result, err := Client(sess).GetDomainStatus(context.Background(), "example.akadns.net")
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, &ResponseStatus{
+		ChangeId:          "40e36abd-bfb2-4635-9fca-62175cf17007",
+		PropagationStatus: "COMPLETE",
+	}, result)
+}
+
+func TestGtm_DefaultHeaders(t *testing.T) {
+	tests := map[string]struct {
+		contextHeaders http.Header
+		expectedTeamID string
+	}{
+		"default headers": {
+			expectedTeamID: "delivery",
+		},
+		"context headers win": {
+			contextHeaders: http.Header{"X-Team-Id": []string{"security"}},
+			expectedTeamID: "security",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedTeamID, r.Header.Get("X-Team-Id"))
+				assert.Equal(t, "application/vnd.config-gtm.v1.4+json", r.Header.Get("Accept"))
+				assert.Contains(t, r.Header.Get("Authorization"), "EG1-HMAC-SHA256 ")
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`{"changeId": "40e36abd-bfb2-4635-9fca-62175cf17007", "propagationStatus": "COMPLETE"}`))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer, WithDefaultHeaders(http.Header{
+				"X-Team-Id":     []string{"delivery"},
+				"Accept":        []string{"application/json"},
+				"Authorization": []string{"Basic abc"},
+			}))
+
+			ctx := context.Background()
+			if test.contextHeaders != nil {
+				ctx = session.ContextWithOptions(ctx, session.WithContextHeaders(test.contextHeaders))
+			}
+			_, err := client.GetDomainStatus(ctx, "example.akadns.net")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGtm_ContextWithSchemaVersion(t *testing.T) {
+	var acceptHeaders []string
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptHeaders = append(acceptHeaders, r.Header.Get("Accept"))
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"changeId": "40e36abd-bfb2-4635-9fca-62175cf17007", "propagationStatus": "COMPLETE"}`))
+		assert.NoError(t, err)
+	}))
+	client := mockAPIClient(t, mockServer)
+
+	_, err := client.GetDomainStatus(ContextWithSchemaVersion(context.Background(), "1.6"), "example.akadns.net")
+	require.NoError(t, err)
+	_, err = client.GetDomainStatus(context.Background(), "example.akadns.net")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"application/vnd.config-gtm.v1.6+json",
+		"application/vnd.config-gtm.v1.4+json",
+	}, acceptHeaders)
+
+	_, err = client.GetDomainStatus(ContextWithSchemaVersion(context.Background(), "2.0"), "example.akadns.net")
+	assert.True(t, errors.Is(err, ErrUnsupportedSchemaVersion), "want: %s; got: %s", ErrUnsupportedSchemaVersion, err)
+	assert.Len(t, acceptHeaders, 2, "request with unsupported schema version should not be sent")
+}
+
+func TestGtm_CheckAPIVersion(t *testing.T) {
+	tests := map[string]struct {
+		responseStatus int
+		responseBody   string
+		withError      error
+	}{
+		"200 OK - compatible": {
+			responseStatus: http.StatusOK,
+			responseBody:   `{"accountId": "1-2ABCD", "active": true}`,
+		},
+		"404 not found - incompatible": {
+			responseStatus: http.StatusNotFound,
+			responseBody:   `{"type": "not_found", "title": "Not Found", "status": 404}`,
+			withError:      session.ErrUnsupportedAPIVersion,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/config-gtm/v1/identity", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			err := client.CheckAPIVersion(context.Background())
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				var versionErr *session.APIVersionError
+				require.True(t, errors.As(err, &versionErr))
+				assert.Equal(t, session.APIVersionError{API: "config-gtm", Version: APIVersion, StatusCode: http.StatusNotFound}, *versionErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package gtm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MissingDatacentersError is returned by the datacenter preflight, see WithDatacenterPreflight,
+// when a map references datacenters which do not exist in the domain. It matches ErrDatacenterNotFound with errors.Is.
+type MissingDatacentersError struct {
+	Map           string
+	DatacenterIDs []int
+}
+
+func (e *MissingDatacentersError) Error() string {
+	ids := make([]string, 0, len(e.DatacenterIDs))
+	for _, id := range e.DatacenterIDs {
+		ids = append(ids, strconv.Itoa(id))
+	}
+	return fmt.Sprintf("%s: map %q references datacenters: %s", ErrDatacenterNotFound, e.Map, strings.Join(ids, ", "))
+}
+
+// Is handles error comparisons
+func (e *MissingDatacentersError) Is(target error) bool {
+	return target == ErrDatacenterNotFound
+}
+
+// datacenterIDs returns IDs of the default datacenter and of all assignments of the GeoMap
+func (geo *GeoMap) datacenterIDs() []int {
+	ids := make([]int, 0, len(geo.Assignments)+1)
+	if geo.DefaultDatacenter != nil {
+		ids = append(ids, geo.DefaultDatacenter.DatacenterId)
+	}
+	for _, assignment := range geo.Assignments {
+		ids = append(ids, assignment.DatacenterId)
+	}
+	return ids
+}
+
+// datacenterIDs returns IDs of the default datacenter and of all assignments of the CidrMap
+func (cidr *CidrMap) datacenterIDs() []int {
+	ids := make([]int, 0, len(cidr.Assignments)+1)
+	if cidr.DefaultDatacenter != nil {
+		ids = append(ids, cidr.DefaultDatacenter.DatacenterId)
+	}
+	for _, assignment := range cidr.Assignments {
+		ids = append(ids, assignment.DatacenterId)
+	}
+	return ids
+}
+
+// checkMapDatacenters lists datacenters of the domain and returns MissingDatacentersError with sorted IDs
+// of the referenced datacenters which do not exist
+func (p *gtm) checkMapDatacenters(ctx context.Context, domainName, mapName string, ids []int) error {
+	datacenters, err := p.ListDatacenters(ctx, domainName)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[int]struct{}, len(datacenters))
+	for _, dc := range datacenters {
+		existing[dc.DatacenterId] = struct{}{}
+	}
+	missing := make(map[int]struct{})
+	for _, id := range ids {
+		if _, ok := existing[id]; !ok {
+			missing[id] = struct{}{}
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	missingIDs := make([]int, 0, len(missing))
+	for id := range missing {
+		missingIDs = append(missingIDs, id)
+	}
+	sort.Ints(missingIDs)
+	return &MissingDatacentersError{Map: mapName, DatacenterIDs: missingIDs}
+}
@@ -0,0 +1,105 @@
+package gtm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGtm_DatacenterPreflight(t *testing.T) {
+	geoMap := func(defaultID int, ids ...int) *GeoMap {
+		geo := &GeoMap{Name: "UK Delivery", DefaultDatacenter: &DatacenterBase{DatacenterId: defaultID, Nickname: "Default Datacenter"}}
+		countries := []string{"GB", "IE", "FR"}
+		for i, id := range ids {
+			geo.Assignments = append(geo.Assignments, &GeoAssignment{DatacenterBase: DatacenterBase{DatacenterId: id}, Countries: []string{countries[i]}})
+		}
+		return geo
+	}
+	cidrMap := func(defaultID int, ids ...int) *CidrMap {
+		cidr := &CidrMap{Name: "Office", DefaultDatacenter: &DatacenterBase{DatacenterId: defaultID, Nickname: "Default Datacenter"}}
+		for i, id := range ids {
+			cidr.Assignments = append(cidr.Assignments, &CidrAssignment{DatacenterBase: DatacenterBase{DatacenterId: id}, Blocks: []string{fmt.Sprintf("1.2.%d.0/24", i)}})
+		}
+		return cidr
+	}
+
+	tests := map[string]struct {
+		preflight       bool
+		save            func(context.Context, GTM) error
+		expectedPaths   []string
+		expectedMissing []int
+	}{
+		"geo map with existing datacenters": {
+			preflight: true,
+			save: func(ctx context.Context, client GTM) error {
+				_, err := client.UpdateGeoMap(ctx, geoMap(5400, 3131, 3132), "example.akadns.net")
+				return err
+			},
+			expectedPaths: []string{
+				"GET /config-gtm/v1/domains/example.akadns.net/datacenters",
+				"PUT /config-gtm/v1/domains/example.akadns.net/geographic-maps/UK%20Delivery",
+			},
+		},
+		"geo map with missing datacenters": {
+			preflight: true,
+			save: func(ctx context.Context, client GTM) error {
+				_, err := client.CreateGeoMap(ctx, geoMap(5400, 3200, 3131, 3199), "example.akadns.net")
+				return err
+			},
+			expectedPaths:   []string{"GET /config-gtm/v1/domains/example.akadns.net/datacenters"},
+			expectedMissing: []int{3199, 3200},
+		},
+		"cidr map with missing default datacenter": {
+			preflight: true,
+			save: func(ctx context.Context, client GTM) error {
+				_, err := client.CreateCidrMap(ctx, cidrMap(5401, 3131), "example.akadns.net")
+				return err
+			},
+			expectedPaths:   []string{"GET /config-gtm/v1/domains/example.akadns.net/datacenters"},
+			expectedMissing: []int{5401},
+		},
+		"preflight disabled": {
+			save: func(ctx context.Context, client GTM) error {
+				_, err := client.UpdateCidrMap(ctx, cidrMap(5400, 3199), "example.akadns.net")
+				return err
+			},
+			expectedPaths: []string{"PUT /config-gtm/v1/domains/example.akadns.net/cidr-maps/Office"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var paths []string
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				paths = append(paths, r.Method+" "+r.URL.EscapedPath())
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(`{"items": [{"datacenterId": 3131, "nickname": "London"}, {"datacenterId": 3132, "nickname": "Dublin"}, {"datacenterId": 5400, "nickname": "Default Datacenter"}]}`))
+					assert.NoError(t, err)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`{"resource": {}, "status": {"propagationStatus": "PENDING"}}`))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer, WithDatacenterPreflight(test.preflight))
+			err := test.save(context.Background(), client)
+			assert.Equal(t, test.expectedPaths, paths)
+			if test.expectedMissing != nil {
+				assert.True(t, errors.Is(err, ErrDatacenterNotFound), "want: %s; got: %s", ErrDatacenterNotFound, err)
+				var missingErr *MissingDatacentersError
+				require.True(t, errors.As(err, &missingErr))
+				assert.Equal(t, test.expectedMissing, missingErr.DatacenterIDs)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package gtm
+
+import (
+	"bytes"
+	"math/big"
+	"net"
+	"sort"
+)
+
+//
+// Assignment statistics of gtm geomaps and cidrmaps
+//
+
+// MapStats summarizes assignments of a GeoMap or a CidrMap
+type MapStats struct {
+	// AssignmentCount is the number of datacenter assignments, not including the default datacenter
+	AssignmentCount int
+	// HasDefaultDatacenter reports whether the default datacenter is set
+	HasDefaultDatacenter bool
+	// CountryCount is the number of distinct countries assigned to datacenters. Set for GeoMap only
+	CountryCount int
+	// BlockCount is the number of CIDR blocks assigned to datacenters. Set for CidrMap only
+	BlockCount int
+	// AddressCount is the number of IPv4 and IPv6 addresses covered by the blocks, each address counted once
+	// even if blocks overlap. Set for CidrMap only
+	AddressCount *big.Int
+}
+
+// addressRange is an inclusive range of addresses of a single IP family
+type addressRange struct {
+	first, last net.IP
+}
+
+// Stats returns assignment statistics of the GeoMap
+func (geo *GeoMap) Stats() MapStats {
+	stats := MapStats{
+		AssignmentCount:      len(geo.Assignments),
+		HasDefaultDatacenter: geo.DefaultDatacenter != nil,
+	}
+	countries := make(map[string]struct{})
+	for _, assignment := range geo.Assignments {
+		for _, country := range assignment.Countries {
+			countries[country] = struct{}{}
+		}
+	}
+	stats.CountryCount = len(countries)
+
+	return stats
+}
+
+// Stats returns assignment statistics of the CidrMap. Blocks which are not valid CIDRs are counted in BlockCount,
+// but do not contribute to AddressCount.
+func (cidr *CidrMap) Stats() MapStats {
+	stats := MapStats{
+		AssignmentCount:      len(cidr.Assignments),
+		HasDefaultDatacenter: cidr.DefaultDatacenter != nil,
+	}
+
+	var v4, v6 []addressRange
+	for _, assignment := range cidr.Assignments {
+		stats.BlockCount += len(assignment.Blocks)
+		for _, block := range assignment.Blocks {
+			_, ipNet, err := net.ParseCIDR(block)
+			if err != nil {
+				continue
+			}
+			r := blockRange(ipNet)
+			if len(r.first) == net.IPv4len {
+				v4 = append(v4, r)
+			} else {
+				v6 = append(v6, r)
+			}
+		}
+	}
+	stats.AddressCount = new(big.Int).Add(countAddresses(v4), countAddresses(v6))
+
+	return stats
+}
+
+// blockRange returns the first and the last address of the network
+func blockRange(ipNet *net.IPNet) addressRange {
+	first := ipNet.IP
+	if ip4 := first.To4(); ip4 != nil {
+		first = ip4
+	}
+	last := make(net.IP, len(first))
+	for i := range first {
+		last[i] = first[i] | ^ipNet.Mask[i]
+	}
+	return addressRange{first: first, last: last}
+}
+
+// countAddresses returns the number of addresses covered by the ranges of a single IP family
+func countAddresses(ranges []addressRange) *big.Int {
+	total := new(big.Int)
+	if len(ranges) == 0 {
+		return total
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return bytes.Compare(ranges[i].first, ranges[j].first) < 0
+	})
+
+	add := func(r addressRange) {
+		size := new(big.Int).Sub(new(big.Int).SetBytes(r.last), new(big.Int).SetBytes(r.first))
+		total.Add(total, size.Add(size, big.NewInt(1)))
+	}
+	current := ranges[0]
+	for _, r := range ranges[1:] {
+		if bytes.Compare(r.first, current.last) <= 0 {
+			// CIDR blocks either nest or are disjoint
+			if bytes.Compare(r.last, current.last) > 0 {
+				current.last = r.last
+			}
+			continue
+		}
+		add(current)
+		current = r
+	}
+	add(current)
+
+	return total
+}
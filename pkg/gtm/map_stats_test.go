@@ -0,0 +1,124 @@
+package gtm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeoMap_Stats(t *testing.T) {
+	tests := map[string]struct {
+		geoMap   *GeoMap
+		expected MapStats
+	}{
+		"with assignments": {
+			geoMap: &GeoMap{
+				Name:              "UK Delivery",
+				DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "Default Datacenter"},
+				Assignments: []*GeoAssignment{
+					{DatacenterBase: DatacenterBase{DatacenterId: 3131, Nickname: "Frankfurt"}, Countries: []string{"DE", "AT"}},
+					{DatacenterBase: DatacenterBase{DatacenterId: 3132, Nickname: "London"}, Countries: []string{"GB", "IE", "DE"}},
+				},
+			},
+			expected: MapStats{
+				AssignmentCount:      2,
+				HasDefaultDatacenter: true,
+				CountryCount:         4,
+			},
+		},
+		"empty": {
+			geoMap:   &GeoMap{Name: "UK Delivery"},
+			expected: MapStats{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.geoMap.Stats())
+		})
+	}
+}
+
+func TestCidrMap_Stats(t *testing.T) {
+	ipv6Slash32, _ := new(big.Int).SetString("79228162514264337593543950336", 10)
+
+	tests := map[string]struct {
+		cidrMap          *CidrMap
+		expectedStats    MapStats
+		expectedAddrsNum *big.Int
+	}{
+		"IPv4 blocks": {
+			cidrMap: &CidrMap{
+				Name:              "The North",
+				DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "All Other CIDR Blocks"},
+				Assignments: []*CidrAssignment{
+					{DatacenterBase: DatacenterBase{DatacenterId: 3134, Nickname: "Frostfangs"}, Blocks: []string{"1.2.3.0/24", "1.2.4.0/31"}},
+					{DatacenterBase: DatacenterBase{DatacenterId: 3133, Nickname: "Winterfell"}, Blocks: []string{"10.0.0.1/32"}},
+				},
+			},
+			expectedStats: MapStats{
+				AssignmentCount:      2,
+				HasDefaultDatacenter: true,
+				BlockCount:           3,
+			},
+			expectedAddrsNum: big.NewInt(259),
+		},
+		"overlapping blocks are counted once": {
+			cidrMap: &CidrMap{
+				Name: "The North",
+				Assignments: []*CidrAssignment{
+					{DatacenterBase: DatacenterBase{DatacenterId: 3134, Nickname: "Frostfangs"}, Blocks: []string{"10.0.0.0/8", "10.1.0.0/16"}},
+					{DatacenterBase: DatacenterBase{DatacenterId: 3133, Nickname: "Winterfell"}, Blocks: []string{"10.2.3.0/24", "11.0.0.0/32"}},
+				},
+			},
+			expectedStats: MapStats{
+				AssignmentCount: 2,
+				BlockCount:      4,
+			},
+			expectedAddrsNum: big.NewInt(1<<24 + 1),
+		},
+		"IPv6 and IPv4 blocks": {
+			cidrMap: &CidrMap{
+				Name:              "The North",
+				DefaultDatacenter: &DatacenterBase{DatacenterId: 5400, Nickname: "All Other CIDR Blocks"},
+				Assignments: []*CidrAssignment{
+					{DatacenterBase: DatacenterBase{DatacenterId: 3134, Nickname: "Frostfangs"}, Blocks: []string{"2001:db8::/32", "2001:db8:1::/48"}},
+					{DatacenterBase: DatacenterBase{DatacenterId: 3133, Nickname: "Winterfell"}, Blocks: []string{"1.2.3.0/24"}},
+				},
+			},
+			expectedStats: MapStats{
+				AssignmentCount:      2,
+				HasDefaultDatacenter: true,
+				BlockCount:           3,
+			},
+			expectedAddrsNum: new(big.Int).Add(ipv6Slash32, big.NewInt(256)),
+		},
+		"invalid blocks are not covered": {
+			cidrMap: &CidrMap{
+				Name: "The North",
+				Assignments: []*CidrAssignment{
+					{DatacenterBase: DatacenterBase{DatacenterId: 3133, Nickname: "Winterfell"}, Blocks: []string{"1.2.3.0/33", "1.2.3.4/32"}},
+				},
+			},
+			expectedStats: MapStats{
+				AssignmentCount: 1,
+				BlockCount:      2,
+			},
+			expectedAddrsNum: big.NewInt(1),
+		},
+		"empty": {
+			cidrMap:          &CidrMap{Name: "The North"},
+			expectedAddrsNum: big.NewInt(0),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			stats := test.cidrMap.Stats()
+			assert.Equal(t, 0, test.expectedAddrsNum.Cmp(stats.AddressCount), "want: %s; got: %s", test.expectedAddrsNum, stats.AddressCount)
+			stats.AddressCount = nil
+			assert.Equal(t, test.expectedStats, stats)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package gtm
+
+import (
+	"fmt"
+)
+
+// Kinds of maps reported in ValidationResult
+const (
+	MapKindGeo  = "GeoMap"
+	MapKindCidr = "CidrMap"
+)
+
+// ValidationResult contains the outcome of local validation of a single map checked with ValidateMaps
+type ValidationResult struct {
+	// Kind is MapKindGeo or MapKindCidr
+	Kind string
+	Name string
+	// Errors lists all problems found in the map, each of them wrapping ErrStructValidation.
+	// It is empty if the map is valid.
+	Errors []error
+}
+
+// Valid reports whether no problems were found in the map
+func (r ValidationResult) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+// ValidateMaps runs all local validations of the maps, without any request to the API, e.g. in a pre-commit hook.
+// The maps are checked the same way as by their Validate, but unlike Validate, which stops at the first problem,
+// all problems of every map are reported: missing name or
+// default datacenter, duplicate datacenter assignments, invalid country codes or CIDR blocks, countries assigned to more than one
+// datacenter and overlapping blocks of different datacenters. A result is returned for every map, GeoMaps first, in the given order.
+func ValidateMaps(geomaps []*GeoMap, cidrmaps []*CidrMap) []ValidationResult {
+	results := make([]ValidationResult, 0, len(geomaps)+len(cidrmaps))
+	for _, geo := range geomaps {
+		result := ValidationResult{Kind: MapKindGeo}
+		if geo == nil {
+			result.Errors = []error{fmt.Errorf("%w: GeoMap is nil", ErrStructValidation)}
+		} else {
+			result.Name = geo.Name
+			result.Errors = geo.validationErrors()
+		}
+		results = append(results, result)
+	}
+	for _, cidr := range cidrmaps {
+		result := ValidationResult{Kind: MapKindCidr}
+		if cidr == nil {
+			result.Errors = []error{fmt.Errorf("%w: CidrMap is nil", ErrStructValidation)}
+		} else {
+			result.Name = cidr.Name
+			result.Errors = cidr.validationErrors()
+		}
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,88 @@
+package gtm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMaps(t *testing.T) {
+	defaultDC := &DatacenterBase{DatacenterId: 5400, Nickname: "Default Datacenter"}
+	geomaps := []*GeoMap{
+		{
+			Name:              "UK Delivery",
+			DefaultDatacenter: defaultDC,
+			Assignments: []*GeoAssignment{
+				{DatacenterBase: DatacenterBase{DatacenterId: 3131}, Countries: []string{"GB", "IE"}},
+				{DatacenterBase: DatacenterBase{DatacenterId: 3132}, Countries: []string{"A1"}},
+			},
+		},
+		{
+			Assignments: []*GeoAssignment{
+				{DatacenterBase: DatacenterBase{DatacenterId: 3131}, Countries: []string{"GB", "uk"}},
+				{DatacenterBase: DatacenterBase{DatacenterId: 3131}, Countries: []string{"FR"}},
+				{DatacenterBase: DatacenterBase{DatacenterId: 3132}, Countries: []string{"GB"}},
+			},
+		},
+		nil,
+	}
+	cidrmaps := []*CidrMap{
+		{
+			Name:              "Office",
+			DefaultDatacenter: defaultDC,
+			Assignments: []*CidrAssignment{
+				{DatacenterBase: DatacenterBase{DatacenterId: 3131}, Blocks: []string{"10.0.0.0/8", "2001:db8::/32"}},
+			},
+		},
+		{
+			Name:              "Partners",
+			DefaultDatacenter: defaultDC,
+			Assignments: []*CidrAssignment{
+				{DatacenterBase: DatacenterBase{DatacenterId: 3131}, Blocks: []string{"10.0.0.0/8", "1.2.3.4", "1.2.3.256"}},
+				{DatacenterBase: DatacenterBase{DatacenterId: 3132}, Blocks: []string{"10.1.0.0/16"}},
+			},
+		},
+	}
+
+	results := ValidateMaps(geomaps, cidrmaps)
+	require.Len(t, results, 5)
+
+	expected := []struct {
+		kind   string
+		name   string
+		errors []string
+	}{
+		{kind: MapKindGeo, name: "UK Delivery"},
+		{kind: MapKindGeo, errors: []string{
+			"struct validation: GeoMap is missing Name",
+			"struct validation: GeoMap is missing DefaultDatacenter",
+			`struct validation: GeoMap assignment for datacenter 3131 has invalid country code "uk"`,
+			"struct validation: GeoMap has duplicate assignment for datacenter 3131",
+			`struct validation: GeoMap country "GB" is assigned to datacenters 3131 and 3132`,
+		}},
+		{kind: MapKindGeo, errors: []string{"struct validation: GeoMap is nil"}},
+		{kind: MapKindCidr, name: "Office"},
+		{kind: MapKindCidr, name: "Partners", errors: []string{
+			`struct validation: CidrMap assignment for datacenter 3131 has invalid block "1.2.3.256": invalid CIDR address: 1.2.3.256`,
+			`struct validation: CidrMap block "10.1.0.0/16" of datacenter 3132 overlaps block "10.0.0.0/8" of datacenter 3131`,
+		}},
+	}
+	for i, result := range results {
+		assert.Equal(t, expected[i].kind, result.Kind, "result %d", i)
+		assert.Equal(t, expected[i].name, result.Name, "result %d", i)
+		assert.Equal(t, len(expected[i].errors) == 0, result.Valid(), "result %d", i)
+		var messages []string
+		for _, err := range result.Errors {
+			assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+			messages = append(messages, err.Error())
+		}
+		assert.Equal(t, expected[i].errors, messages, "result %d", i)
+	}
+
+	// ValidateMaps has to agree with Validate, which is run before the maps are sent
+	for i, cidr := range cidrmaps {
+		assert.Equal(t, results[len(geomaps)+i].Valid(), cidr.Validate() == nil, "CidrMap %q", cidr.Name)
+	}
+}
@@ -4,6 +4,7 @@ package gtm
 
 import (
 	"context"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -34,6 +35,12 @@ func (p *Mock) NewDomain(ctx context.Context, _ string, _ string) *Domain {
 	return args.Get(0).(*Domain)
 }
 
+func (p *Mock) CheckAPIVersion(ctx context.Context) error {
+	args := p.Called(ctx)
+
+	return args.Error(0)
+}
+
 func (p *Mock) GetDomainStatus(ctx context.Context, domain string) (*ResponseStatus, error) {
 	args := p.Called(ctx, domain)
 
@@ -44,6 +51,46 @@ func (p *Mock) GetDomainStatus(ctx context.Context, domain string) (*ResponseSta
 	return args.Get(0).(*ResponseStatus), args.Error(1)
 }
 
+func (p *Mock) GetChangeStatus(ctx context.Context, domain string, changeID ChangeID) (*ResponseStatus, error) {
+	args := p.Called(ctx, domain, changeID)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ResponseStatus), args.Error(1)
+}
+
+func (p *Mock) WaitForDomainStatus(ctx context.Context, domain string, opts WaitOptions) (*ResponseStatus, error) {
+	args := p.Called(ctx, domain, opts)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ResponseStatus), args.Error(1)
+}
+
+func (p *Mock) WaitForChangeStatus(ctx context.Context, domain string, changeID ChangeID, opts WaitOptions) (*ResponseStatus, error) {
+	args := p.Called(ctx, domain, changeID, opts)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ResponseStatus), args.Error(1)
+}
+
+func (p *Mock) GetChangeHistory(ctx context.Context, domain string, opts *ChangeHistoryOptions) ([]*ChangeRecord, error) {
+	args := p.Called(ctx, domain, opts)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*ChangeRecord), args.Error(1)
+}
+
 func (p *Mock) ListDomains(ctx context.Context) ([]*DomainItem, error) {
 	args := p.Called(ctx)
 
@@ -435,6 +482,16 @@ func (p *Mock) GetGeoMap(ctx context.Context, geo string, domain string) (*GeoMa
 	return args.Get(0).(*GeoMap), args.Error(1)
 }
 
+func (p *Mock) GetGeoMapIfModifiedSince(ctx context.Context, geo string, domain string, since time.Time) (*GeoMap, error) {
+	args := p.Called(ctx, geo, domain, since)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*GeoMap), args.Error(1)
+}
+
 func (p *Mock) CreateGeoMap(ctx context.Context, geo *GeoMap, domain string) (*GeoMapResponse, error) {
 	args := p.Called(ctx, geo, domain)
 
@@ -465,6 +522,16 @@ func (p *Mock) UpdateGeoMap(ctx context.Context, geo *GeoMap, domain string) (*R
 	return args.Get(0).(*ResponseStatus), args.Error(1)
 }
 
+func (p *Mock) TouchGeoMap(ctx context.Context, name, domain string) (*ResponseStatus, error) {
+	args := p.Called(ctx, name, domain)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ResponseStatus), args.Error(1)
+}
+
 func (p *Mock) NewGeoMap(ctx context.Context, mname string) *GeoMap {
 	args := p.Called(ctx, mname)
 
@@ -495,6 +562,16 @@ func (p *Mock) ListGeoMaps(ctx context.Context, domain string) ([]*GeoMap, error
 	return args.Get(0).([]*GeoMap), args.Error(1)
 }
 
+func (p *Mock) ListGeoMapNames(ctx context.Context, domain string) ([]string, error) {
+	args := p.Called(ctx, domain)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func (p *Mock) GetCidrMap(ctx context.Context, cidr string, domain string) (*CidrMap, error) {
 	args := p.Called(ctx, cidr, domain)
 
@@ -505,6 +582,16 @@ func (p *Mock) GetCidrMap(ctx context.Context, cidr string, domain string) (*Cid
 	return args.Get(0).(*CidrMap), args.Error(1)
 }
 
+func (p *Mock) GetCidrMapIfModifiedSince(ctx context.Context, cidr string, domain string, since time.Time) (*CidrMap, error) {
+	args := p.Called(ctx, cidr, domain, since)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*CidrMap), args.Error(1)
+}
+
 func (p *Mock) CreateCidrMap(ctx context.Context, cidr *CidrMap, domain string) (*CidrMapResponse, error) {
 	args := p.Called(ctx, cidr, domain)
 
@@ -535,6 +622,16 @@ func (p *Mock) UpdateCidrMap(ctx context.Context, cidr *CidrMap, domain string)
 	return args.Get(0).(*ResponseStatus), args.Error(1)
 }
 
+func (p *Mock) TouchCidrMap(ctx context.Context, name, domain string) (*ResponseStatus, error) {
+	args := p.Called(ctx, name, domain)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*ResponseStatus), args.Error(1)
+}
+
 func (p *Mock) NewCidrMap(ctx context.Context, mname string) *CidrMap {
 	args := p.Called(ctx, mname)
 
@@ -564,3 +661,13 @@ func (p *Mock) ListCidrMaps(ctx context.Context, domain string) ([]*CidrMap, err
 
 	return args.Get(0).([]*CidrMap), args.Error(1)
 }
+
+func (p *Mock) ListCidrMapNames(ctx context.Context, domain string) ([]string, error) {
+	args := p.Called(ctx, domain)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]string), args.Error(1)
+}
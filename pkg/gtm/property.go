@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
 
 //
@@ -142,16 +144,16 @@ type PropertyList struct {
 func (prop *Property) Validate() error {
 
 	if len(prop.Name) < 1 {
-		return fmt.Errorf("Property is missing Name")
+		return fmt.Errorf("%w: Property is missing Name", ErrStructValidation)
 	}
 	if len(prop.Type) < 1 {
-		return fmt.Errorf("Property is missing Type")
+		return fmt.Errorf("%w: Property is missing Type", ErrStructValidation)
 	}
 	if len(prop.ScoreAggregationType) < 1 {
-		return fmt.Errorf("Property is missing ScoreAggregationType")
+		return fmt.Errorf("%w: Property is missing ScoreAggregationType", ErrStructValidation)
 	}
 	if len(prop.HandoutMode) < 1 {
-		return fmt.Errorf("Property is missing HandoutMode")
+		return fmt.Errorf("%w: Property is missing HandoutMode", ErrStructValidation)
 	}
 	// is zero a valid value? need to check and uncomment
 	//if prop.HandoutLimit == 0 {
@@ -161,6 +163,47 @@ func (prop *Property) Validate() error {
 	return nil
 }
 
+// ValidateWeights validates traffic targets weights of Property. It returns ErrZeroWeightSum when
+// weights of all traffic targets sum to zero and therefore cannot be used for weighted load balancing
+func (prop *Property) ValidateWeights() error {
+	if prop.weightSum() == 0 {
+		return fmt.Errorf("%w: property %q", ErrZeroWeightSum, prop.Name)
+	}
+
+	return nil
+}
+
+// NormalizeWeights scales traffic targets weights proportionally, so that they sum to the provided total
+// (e.g. 100). The property is modified in place. ErrZeroWeightSum is returned, and no weight is changed,
+// when weights of all traffic targets sum to zero, and an error wrapping ErrStructValidation when the total is not positive
+func (prop *Property) NormalizeWeights(total float64) error {
+	if total <= 0 {
+		return fmt.Errorf("%w: total weight must be greater than 0, got: %v", ErrStructValidation, total)
+	}
+	if err := prop.ValidateWeights(); err != nil {
+		return err
+	}
+
+	sum := prop.weightSum()
+	for _, target := range prop.TrafficTargets {
+		if target != nil {
+			target.Weight = target.Weight * total / sum
+		}
+	}
+
+	return nil
+}
+
+func (prop *Property) weightSum() float64 {
+	var sum float64
+	for _, target := range prop.TrafficTargets {
+		if target != nil {
+			sum += target.Weight
+		}
+	}
+	return sum
+}
+
 func (p *gtm) NewTrafficTarget(ctx context.Context) *TrafficTarget {
 
 	logger := p.Log(ctx)
@@ -210,18 +253,8 @@ func (p *gtm) ListProperties(ctx context.Context, domainName string) ([]*Propert
 
 	var properties PropertyList
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/properties", domainName)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create ListProperties request: %w", err)
-	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &properties)
-	if err != nil {
-		return nil, fmt.Errorf("ListProperties request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &properties, http.StatusOK); err != nil {
+		return nil, requestError("ListProperties", err)
 	}
 
 	return properties.PropertyItems, nil
@@ -234,18 +267,8 @@ func (p *gtm) GetProperty(ctx context.Context, name, domainName string) (*Proper
 
 	var property Property
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/properties/%s", domainName, name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create GetProperty request: %w", err)
-	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &property)
-	if err != nil {
-		return nil, fmt.Errorf("GetProperty request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &property, http.StatusOK); err != nil {
+		return nil, requestError("GetProperty", err)
 	}
 
 	return &property, nil
@@ -277,25 +300,14 @@ func (p *gtm) UpdateProperty(ctx context.Context, property *Property, domainName
 // Save Property updates method
 func (prop *Property) save(ctx context.Context, p *gtm, domainName string) (*PropertyResponse, error) {
 
-	if err := prop.Validate(); err != nil {
+	if err := p.validate(ctx, prop); err != nil {
 		return nil, fmt.Errorf("Property validation failed. %w", err)
 	}
 
 	putURL := fmt.Sprintf("/config-gtm/v1/domains/%s/properties/%s", domainName, prop.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, putURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Property request: %w", err)
-	}
-
 	var presp PropertyResponse
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &presp, prop)
-	if err != nil {
-		return nil, fmt.Errorf("Property request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodPut, putURL, prop, &presp, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, requestError("Property", err)
 	}
 
 	return &presp, nil
@@ -306,26 +318,15 @@ func (p *gtm) DeleteProperty(ctx context.Context, property *Property, domainName
 	logger := p.Log(ctx)
 	logger.Debug("DeleteProperty")
 
-	if err := property.Validate(); err != nil {
+	if err := p.validate(ctx, property); err != nil {
 		logger.Errorf("Property validation failed. %w", err)
 		return nil, fmt.Errorf("Property validation failed. %w", err)
 	}
 
 	delURL := fmt.Sprintf("/config-gtm/v1/domains/%s/properties/%s", domainName, property.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, delURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Property request: %w", err)
-	}
-
 	var presp ResponseBody
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &presp)
-	if err != nil {
-		return nil, fmt.Errorf("Property request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodDelete, delURL, nil, &presp, http.StatusOK); err != nil {
+		return nil, requestError("Property", err)
 	}
 
 	return presp.Status, nil
@@ -466,3 +466,63 @@ func TestGtm_DeleteProperty(t *testing.T) {
 		})
 	}
 }
+
+func TestProperty_NormalizeWeights(t *testing.T) {
+	tests := map[string]struct {
+		weights         []float64
+		total           float64
+		expectedWeights []float64
+		withError       error
+	}{
+		"arbitrary sum scaled to 100": {
+			weights:         []float64{1, 3},
+			total:           100,
+			expectedWeights: []float64{25, 75},
+		},
+		"sum greater than total": {
+			weights:         []float64{50, 100, 50},
+			total:           100,
+			expectedWeights: []float64{25, 50, 25},
+		},
+		"custom total": {
+			weights:         []float64{2, 2, 0},
+			total:           10,
+			expectedWeights: []float64{5, 5, 0},
+		},
+		"zero sum": {
+			weights:         []float64{0, 0},
+			total:           100,
+			expectedWeights: []float64{0, 0},
+			withError:       ErrZeroWeightSum,
+		},
+		"no traffic targets": {
+			total:     100,
+			withError: ErrZeroWeightSum,
+		},
+		"invalid total": {
+			weights:         []float64{1, 3},
+			total:           0,
+			expectedWeights: []float64{1, 3},
+			withError:       ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			prop := Property{Name: "origin"}
+			for i, w := range test.weights {
+				prop.TrafficTargets = append(prop.TrafficTargets, &TrafficTarget{DatacenterId: i, Weight: w})
+			}
+
+			err := prop.NormalizeWeights(test.total)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+			} else {
+				require.NoError(t, err)
+			}
+			for i, w := range test.expectedWeights {
+				assert.InDelta(t, w, prop.TrafficTargets[i].Weight, 1e-9)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
 
 //
@@ -73,10 +75,10 @@ type ResourceList struct {
 func (rsrc *Resource) Validate() error {
 
 	if len(rsrc.Name) < 1 {
-		return fmt.Errorf("Resource is missing Name")
+		return fmt.Errorf("%w: Resource is missing Name", ErrStructValidation)
 	}
 	if len(rsrc.Type) < 1 {
-		return fmt.Errorf("Resource is missing Type")
+		return fmt.Errorf("%w: Resource is missing Type", ErrStructValidation)
 	}
 
 	return nil
@@ -107,18 +109,8 @@ func (p *gtm) ListResources(ctx context.Context, domainName string) ([]*Resource
 
 	var rsrcs ResourceList
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/resources", domainName)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create ListResources request: %w", err)
-	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &rsrcs)
-	if err != nil {
-		return nil, fmt.Errorf("ListResources request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &rsrcs, http.StatusOK); err != nil {
+		return nil, requestError("ListResources", err)
 	}
 
 	return rsrcs.ResourceItems, nil
@@ -131,18 +123,8 @@ func (p *gtm) GetResource(ctx context.Context, name, domainName string) (*Resour
 
 	var rsc Resource
 	getURL := fmt.Sprintf("/config-gtm/v1/domains/%s/resources/%s", domainName, name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create GetResource request: %w", err)
-	}
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &rsc)
-	if err != nil {
-		return nil, fmt.Errorf("GetResource request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodGet, getURL, nil, &rsc, http.StatusOK); err != nil {
+		return nil, requestError("GetResource", err)
 	}
 
 	return &rsc, nil
@@ -175,25 +157,14 @@ func (p *gtm) UpdateResource(ctx context.Context, rsrc *Resource, domainName str
 // save is a function that saves Resource in given domain. Common path for Create and Update.
 func (rsrc *Resource) save(ctx context.Context, p *gtm, domainName string) (*ResourceResponse, error) {
 
-	if err := rsrc.Validate(); err != nil {
+	if err := p.validate(ctx, rsrc); err != nil {
 		return nil, fmt.Errorf("Resource validation failed. %w", err)
 	}
 
 	putURL := fmt.Sprintf("/config-gtm/v1/domains/%s/resources/%s", domainName, rsrc.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, putURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Resource request: %w", err)
-	}
-
 	var rscresp ResourceResponse
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &rscresp, rsrc)
-	if err != nil {
-		return nil, fmt.Errorf("Resource request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodPut, putURL, rsrc, &rscresp, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, requestError("Resource", err)
 	}
 
 	return &rscresp, nil
@@ -205,26 +176,15 @@ func (p *gtm) DeleteResource(ctx context.Context, rsrc *Resource, domainName str
 	logger := p.Log(ctx)
 	logger.Debug("DeleteResource")
 
-	if err := rsrc.Validate(); err != nil {
+	if err := p.validate(ctx, rsrc); err != nil {
 		logger.Errorf("Resource validation failed. %w", err)
 		return nil, fmt.Errorf("Resource validation failed. %w", err)
 	}
 
 	delURL := fmt.Sprintf("/config-gtm/v1/domains/%s/resources/%s", domainName, rsrc.Name)
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, delURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Delete request: %w", err)
-	}
-
 	var rscresp ResponseBody
-	setVersionHeader(req, schemaVersion)
-	resp, err := p.Exec(req, &rscresp)
-	if err != nil {
-		return nil, fmt.Errorf("Resource request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, p.Error(resp)
+	if _, err := session.DoRequest(ctx, p, http.MethodDelete, delURL, nil, &rscresp, http.StatusOK); err != nil {
+		return nil, requestError("Resource", err)
 	}
 
 	return rscresp.Status, nil
@@ -5,6 +5,7 @@ package hapi
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
@@ -43,3 +44,11 @@ func Client(sess session.Session, opts ...Option) HAPI {
 	}
 	return h
 }
+
+// WithDefaultHeaders adds the headers to every request made by the client, e.g. a static team identifier.
+// Headers set by the client methods and with session.WithContextHeaders take precedence, see session.WithDefaultHeaders.
+func WithDefaultHeaders(h http.Header) Option {
+	return func(c *hapi) {
+		c.Session = session.WithDefaultHeaders(c.Session, h)
+	}
+}
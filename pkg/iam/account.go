@@ -0,0 +1,90 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type (
+	// Account is the IAM account verification API interface
+	Account interface {
+		// VerifyAccount checks that requests made with the client are scoped to the expected account.
+		// It is useful to run it at startup when the account switch key is configured, so that a typo
+		// does not silently scope every call to a wrong account.
+		//
+		// See: https://techdocs.akamai.com/iam-api/reference/get-user-profile
+		VerifyAccount(context.Context, VerifyAccountRequest) error
+	}
+
+	// VerifyAccountRequest contains the account expected by VerifyAccount
+	VerifyAccountRequest struct {
+		// AccountID is the expected account ID. An account switch key can be provided as well,
+		// in such case only the account ID part before ':' is compared.
+		AccountID string
+	}
+
+	// profileAccount is a part of the user profile response identifying the resolved account
+	profileAccount struct {
+		AccountID string `json:"accountId"`
+	}
+)
+
+var (
+	// ErrVerifyAccount is returned when VerifyAccount fails
+	ErrVerifyAccount = errors.New("verify account")
+
+	// ErrAccountMismatch is returned when requests are scoped to a different account than expected
+	ErrAccountMismatch = errors.New("account mismatch")
+)
+
+// Validate validates VerifyAccountRequest
+func (r VerifyAccountRequest) Validate() error {
+	return validation.Errors{
+		"AccountID": validation.Validate(r.AccountID, validation.Required),
+	}.Filter()
+}
+
+func (i *iam) VerifyAccount(ctx context.Context, params VerifyAccountRequest) error {
+	logger := i.Log(ctx)
+	logger.Debug("VerifyAccount")
+
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("%s: %w:\n%s", ErrVerifyAccount, ErrStructValidation, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/identity-management/v2/user-profile", nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrVerifyAccount, err)
+	}
+
+	var rval profileAccount
+	resp, err := i.Exec(req, &rval)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrVerifyAccount, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %w", ErrVerifyAccount, i.Error(resp))
+	}
+
+	expected := normalizeAccountID(params.AccountID)
+	if !strings.EqualFold(expected, normalizeAccountID(rval.AccountID)) {
+		return fmt.Errorf("%s: %w: expected %q, got %q", ErrVerifyAccount, ErrAccountMismatch, expected, rval.AccountID)
+	}
+
+	return nil
+}
+
+// normalizeAccountID strips the 'act_' prefix and the part of the account switch key following ':'
+func normalizeAccountID(accountID string) string {
+	accountID = strings.TrimPrefix(strings.TrimSpace(accountID), "act_")
+	if i := strings.Index(accountID, ":"); i >= 0 {
+		accountID = accountID[:i]
+	}
+	return accountID
+}
@@ -0,0 +1,100 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tj/assert"
+)
+
+func TestIAM_VerifyAccount(t *testing.T) {
+	profileResponse := `
+{
+	"uiIdentityId": "A-BC-1234567",
+	"uiUserName": "johndoe",
+	"firstName": "John",
+	"lastName": "Doe",
+	"email": "john.doe@mycompany.com",
+	"accountId": "1-123A"
+}`
+
+	tests := map[string]struct {
+		params         VerifyAccountRequest
+		responseStatus int
+		responseBody   string
+		expectedPath   string
+		withError      func(*testing.T, error)
+	}{
+		"200 OK - matching account": {
+			params:         VerifyAccountRequest{AccountID: "1-123A"},
+			responseStatus: http.StatusOK,
+			responseBody:   profileResponse,
+			expectedPath:   "/identity-management/v2/user-profile",
+		},
+		"200 OK - matching account switch key": {
+			params:         VerifyAccountRequest{AccountID: "act_1-123A:1-2RBL"},
+			responseStatus: http.StatusOK,
+			responseBody:   profileResponse,
+			expectedPath:   "/identity-management/v2/user-profile",
+		},
+		"200 OK - mismatching account": {
+			params:         VerifyAccountRequest{AccountID: "1-123B"},
+			responseStatus: http.StatusOK,
+			responseBody:   profileResponse,
+			expectedPath:   "/identity-management/v2/user-profile",
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrAccountMismatch), "want: %s; got: %s", ErrAccountMismatch, err)
+			},
+		},
+		"missing account ID": {
+			params: VerifyAccountRequest{},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+			},
+		},
+		"500 internal server error": {
+			params:         VerifyAccountRequest{AccountID: "1-123A"},
+			responseStatus: http.StatusInternalServerError,
+			responseBody: `
+{
+	"type": "internal_error",
+    "title": "Internal Server Error",
+    "detail": "Error making request",
+    "status": 500
+}`,
+			expectedPath: "/identity-management/v2/user-profile",
+			withError: func(t *testing.T, err error) {
+				want := &Error{
+					Type:       "internal_error",
+					Title:      "Internal Server Error",
+					Detail:     "Error making request",
+					StatusCode: http.StatusInternalServerError,
+				}
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			err := client.VerifyAccount(context.Background(), test.params)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
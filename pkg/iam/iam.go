@@ -3,6 +3,7 @@ package iam
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
@@ -15,6 +16,7 @@ var (
 type (
 	// IAM is the IAM api interface
 	IAM interface {
+		Account
 		BlockedProperties
 		Groups
 		Roles
@@ -46,3 +48,11 @@ func Client(sess session.Session, opts ...Option) IAM {
 	}
 	return p
 }
+
+// WithDefaultHeaders adds the headers to every request made by the client, e.g. a static team identifier.
+// Headers set by the client methods and with session.WithContextHeaders take precedence, see session.WithDefaultHeaders.
+func WithDefaultHeaders(h http.Header) Option {
+	return func(c *iam) {
+		c.Session = session.WithDefaultHeaders(c.Session, h)
+	}
+}
@@ -74,6 +74,12 @@ func (m *Mock) SupportedTimezones(ctx context.Context) ([]Timezone, error) {
 	return args.Get(0).([]Timezone), args.Error(1)
 }
 
+func (m *Mock) VerifyAccount(ctx context.Context, request VerifyAccountRequest) error {
+	args := m.Called(ctx, request)
+
+	return args.Error(0)
+}
+
 func (m *Mock) ListProducts(ctx context.Context) ([]string, error) {
 	args := m.Called(ctx)
 
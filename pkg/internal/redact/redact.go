@@ -0,0 +1,181 @@
+// Package redact masks secrets in URLs, headers and bodies of requests, so that they can be logged safely.
+// Anything logging requests or responses, like HTTP tracing of the session, should pass them through it.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Mask replaces values of sensitive query parameters, headers and JSON fields
+const Mask = "[REDACTED]"
+
+// sensitiveNames are normalized names, see normalize, of query parameters, headers and JSON fields holding secrets
+var sensitiveNames = map[string]struct{}{
+	"accesskey":          {},
+	"accesstoken":        {},
+	"accountswitchkey":   {},
+	"apikey":             {},
+	"authorization":      {},
+	"authtoken":          {},
+	"clientkey":          {},
+	"clientsecret":       {},
+	"clienttoken":        {},
+	"cookie":             {},
+	"password":           {},
+	"privatekey":         {},
+	"proxyauthorization": {},
+	"secret":             {},
+	"secretaccesskey":    {},
+	"setcookie":          {},
+	"token":              {},
+}
+
+// IsSensitive reports whether the query parameter, header or JSON field name is known to hold a secret.
+// Names are compared case-insensitively, ignoring '-' and '_', so e.g. client_secret and clientSecret are both sensitive.
+func IsSensitive(name string) bool {
+	_, ok := sensitiveNames[normalize(name)]
+	return ok
+}
+
+func normalize(name string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(name))
+}
+
+// URL returns the URL with values of sensitive query parameters masked
+func URL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	if u.RawQuery == "" {
+		return u.String()
+	}
+	redacted := *u
+	redacted.RawQuery = Query(u.RawQuery)
+	return redacted.String()
+}
+
+// Query returns the encoded query with values of sensitive parameters masked.
+// The order of parameters is preserved and the query is returned unchanged if it has no sensitive parameters.
+func Query(rawQuery string) string {
+	params := strings.Split(rawQuery, "&")
+	changed := false
+	for i, param := range params {
+		name, _, hasValue := strings.Cut(param, "=")
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+		if !hasValue || !IsSensitive(name) {
+			continue
+		}
+		params[i] = url.QueryEscape(name) + "=" + url.QueryEscape(Mask)
+		changed = true
+	}
+	if !changed {
+		return rawQuery
+	}
+	return strings.Join(params, "&")
+}
+
+// Header returns a copy of the header with values of sensitive headers masked
+func Header(h http.Header) http.Header {
+	redacted := make(http.Header, len(h))
+	for k, v := range h {
+		if IsSensitive(k) {
+			redacted[k] = []string{Mask}
+			continue
+		}
+		redacted[k] = append([]string{}, v...)
+	}
+	return redacted
+}
+
+// JSON returns the JSON body with values of sensitive fields masked at any depth.
+// Bodies which are not valid JSON are returned unchanged.
+func JSON(body []byte) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil || dec.More() {
+		return body
+	}
+	if !redactValue(v) {
+		return body
+	}
+	redacted, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+// redactValue masks sensitive fields of the decoded JSON value in place and reports whether any was masked
+func redactValue(v interface{}) bool {
+	changed := false
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, field := range val {
+			if IsSensitive(k) && field != nil {
+				val[k] = Mask
+				changed = true
+				continue
+			}
+			changed = redactValue(field) || changed
+		}
+	case []interface{}:
+		for _, item := range val {
+			changed = redactValue(item) || changed
+		}
+	}
+	return changed
+}
+
+// HTTPDump returns the dump of a request or a response, as created by httputil.DumpRequestOut or httputil.DumpResponse,
+// with sensitive query parameters of the request line, sensitive headers and sensitive fields of a JSON body masked
+func HTTPDump(dump []byte) []byte {
+	head, body := dump, []byte(nil)
+	if i := bytes.Index(dump, []byte("\r\n\r\n")); i >= 0 {
+		head, body = dump[:i], dump[i+4:]
+	}
+
+	lines := strings.Split(string(head), "\r\n")
+	for i, line := range lines {
+		if i == 0 {
+			lines[i] = redactRequestLine(line)
+			continue
+		}
+		name, _, ok := strings.Cut(line, ":")
+		if ok && IsSensitive(strings.TrimSpace(name)) {
+			lines[i] = name + ": " + Mask
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(strings.Join(lines, "\r\n"))
+	if body != nil {
+		buf.WriteString("\r\n\r\n")
+		buf.Write(JSON(body))
+	}
+	return buf.Bytes()
+}
+
+// redactRequestLine masks sensitive query parameters of the request URI, e.g. in "GET /path?a=b HTTP/1.1".
+// Status lines of responses are returned unchanged.
+func redactRequestLine(line string) string {
+	parts := strings.SplitN(line, " ", 3)
+	if len(parts) != 3 {
+		return line
+	}
+	path, query, ok := strings.Cut(parts[1], "?")
+	if !ok {
+		return line
+	}
+	parts[1] = path + "?" + Query(query)
+	return strings.Join(parts, " ")
+}
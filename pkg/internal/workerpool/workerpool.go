@@ -0,0 +1,104 @@
+// Package workerpool runs tasks with bounded concurrency. It is used by methods operating on many resources at once.
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Options configures Run
+type Options struct {
+	// Concurrency is the maximum number of tasks running at the same time. Values lower than 1 are treated as 1.
+	Concurrency int
+	// Interval is the minimum time between starting consecutive tasks, limiting the rate of e.g. API calls. Zero disables the limit.
+	Interval time.Duration
+	// FailFast stops starting new tasks once any task fails, and cancels the context passed to tasks which are running.
+	FailFast bool
+}
+
+// ErrNotStarted is returned for tasks which were not started, because the context was cancelled or, with FailFast, another task failed
+var ErrNotStarted = errors.New("task not started")
+
+// Run calls task for each index in [0, n) and returns the errors it returned, ordered by the index.
+// Tasks write their results by the index, so results are collected in order regardless of the order of completion.
+// Run returns once all started tasks have finished. Tasks which were not started get an error wrapping ErrNotStarted.
+func Run(ctx context.Context, n int, opts Options, task func(ctx context.Context, i int) error) []error {
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		failed   = -1
+		errs     = make([]error, n)
+		sem      = make(chan struct{}, concurrency)
+		throttle <-chan time.Time
+	)
+	if opts.Interval > 0 {
+		ticker := time.NewTicker(opts.Interval)
+		defer ticker.Stop()
+		throttle = ticker.C
+	}
+
+	notStarted := func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		if failed >= 0 {
+			return fmt.Errorf("%w: task %d failed", ErrNotStarted, failed)
+		}
+		return fmt.Errorf("%w: %s", ErrNotStarted, ctx.Err())
+	}
+
+	for i := 0; i < n; i++ {
+		if ctx.Err() != nil {
+			errs[i] = notStarted()
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = notStarted()
+			continue
+		}
+
+		if throttle != nil && i > 0 {
+			select {
+			case <-throttle:
+			case <-ctx.Done():
+				<-sem
+				errs[i] = notStarted()
+				continue
+			}
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			err := task(ctx, i)
+			errs[i] = err
+			if err != nil && opts.FailFast {
+				mu.Lock()
+				if failed < 0 {
+					failed = i
+				}
+				mu.Unlock()
+				cancel()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return errs
+}
@@ -0,0 +1,189 @@
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	errTask := errors.New("task error")
+
+	tests := map[string]struct {
+		n           int
+		opts        Options
+		failing     map[int]bool
+		expectedErr []error
+	}{
+		"all tasks succeed": {
+			n:           5,
+			opts:        Options{Concurrency: 2},
+			expectedErr: make([]error, 5),
+		},
+		"errors are ordered by index": {
+			n:           4,
+			opts:        Options{Concurrency: 4},
+			failing:     map[int]bool{1: true, 3: true},
+			expectedErr: []error{nil, errTask, nil, errTask},
+		},
+		"zero concurrency runs tasks one by one": {
+			n:           3,
+			opts:        Options{},
+			expectedErr: make([]error, 3),
+		},
+		"no tasks": {
+			n:           0,
+			opts:        Options{Concurrency: 2},
+			expectedErr: []error{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var inFlight, maxInFlight int32
+			results := make([]int, test.n)
+			errs := Run(context.Background(), test.n, test.opts, func(_ context.Context, i int) error {
+				current := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				for {
+					max := atomic.LoadInt32(&maxInFlight)
+					if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+						break
+					}
+				}
+				// finish in reverse order, so that results are not collected in order by accident
+				time.Sleep(time.Duration(test.n-i) * time.Millisecond)
+				if test.failing[i] {
+					return errTask
+				}
+				results[i] = i * i
+				return nil
+			})
+
+			assert.Equal(t, test.expectedErr, errs)
+			for i, result := range results {
+				if !test.failing[i] {
+					assert.Equal(t, i*i, result)
+				}
+			}
+			concurrency := test.opts.Concurrency
+			if concurrency < 1 {
+				concurrency = 1
+			}
+			assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(concurrency))
+		})
+	}
+}
+
+func TestRun_Cancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var started int32
+	errs := Run(ctx, 10, Options{Concurrency: 2}, func(ctx context.Context, i int) error {
+		if atomic.AddInt32(&started, 1) == 2 {
+			cancel()
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	require.Len(t, errs, 10)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&started))
+	for i, err := range errs {
+		if i < 2 {
+			assert.True(t, errors.Is(err, context.Canceled), "task %d: got: %s", i, err)
+			continue
+		}
+		assert.True(t, errors.Is(err, ErrNotStarted), "task %d: got: %s", i, err)
+	}
+}
+
+func TestRun_CancelledBeforeStart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var started int32
+	errs := Run(ctx, 3, Options{Concurrency: 3}, func(context.Context, int) error {
+		atomic.AddInt32(&started, 1)
+		return nil
+	})
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&started))
+	for _, err := range errs {
+		assert.True(t, errors.Is(err, ErrNotStarted), "got: %s", err)
+	}
+}
+
+func TestRun_FailFast(t *testing.T) {
+	errTask := errors.New("task error")
+
+	tests := map[string]struct {
+		failFast        bool
+		expectedStarted int32
+	}{
+		"fail fast": {
+			failFast:        true,
+			expectedStarted: 2,
+		},
+		"without fail fast all tasks are run": {
+			failFast:        false,
+			expectedStarted: 6,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var started int32
+			errs := Run(context.Background(), 6, Options{Concurrency: 2, FailFast: test.failFast}, func(ctx context.Context, i int) error {
+				atomic.AddInt32(&started, 1)
+				if i == 0 {
+					return errTask
+				}
+				if i == 1 {
+					// a running task is cancelled when another one fails
+					select {
+					case <-ctx.Done():
+						return ctx.Err()
+					case <-time.After(100 * time.Millisecond):
+					}
+				}
+				return nil
+			})
+
+			require.Len(t, errs, 6)
+			assert.Equal(t, test.expectedStarted, atomic.LoadInt32(&started))
+			assert.True(t, errors.Is(errs[0], errTask), "got: %s", errs[0])
+			if !test.failFast {
+				for _, err := range errs[1:] {
+					assert.NoError(t, err)
+				}
+				return
+			}
+			assert.True(t, errors.Is(errs[1], context.Canceled), "got: %s", errs[1])
+			for _, err := range errs[2:] {
+				assert.True(t, errors.Is(err, ErrNotStarted), "got: %s", err)
+				assert.Contains(t, err.Error(), "task 0 failed")
+			}
+		})
+	}
+}
+
+func TestRun_Interval(t *testing.T) {
+	var started int32
+	start := time.Now()
+	errs := Run(context.Background(), 4, Options{Concurrency: 4, Interval: 20 * time.Millisecond}, func(context.Context, int) error {
+		atomic.AddInt32(&started, 1)
+		return nil
+	})
+
+	assert.Equal(t, make([]error, 4), errs)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&started))
+	elapsed := time.Since(start)
+	assert.True(t, elapsed >= 60*time.Millisecond, "tasks should be started at least 20ms apart, all started in %s", elapsed)
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/tools"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -252,6 +253,16 @@ const (
 	StatusNew StatusValue = "NEW"
 )
 
+// environment returns NetworkStaging or NetworkProduction for any representation of the network, e.g. "staging"
+// or "prod", as the API accepts only the upper case values. Other values are returned unchanged.
+func environment(network string) string {
+	env, err := tools.ParseActivationNetwork(network)
+	if err != nil {
+		return network
+	}
+	return string(env)
+}
+
 // Validate validates GetActivationsRequest
 func (v GetActivationsRequest) Validate() error {
 	return validation.Errors{
@@ -273,6 +284,7 @@ func (p *networklists) GetActivations(ctx context.Context, params GetActivations
 
 	logger := p.Log(ctx)
 	logger.Debug("GetActivations")
+	params.Network = environment(params.Network)
 
 	var rval GetActivationsResponse
 
@@ -333,6 +345,7 @@ func (p *networklists) CreateActivations(ctx context.Context, params CreateActiv
 
 	logger := p.Log(ctx)
 	logger.Debug("CreateActivations")
+	params.Network = environment(params.Network)
 
 	uri := fmt.Sprintf("/network-list/v2/network-lists/%s/environments/%s/activate",
 		params.UniqueID,
@@ -384,6 +397,7 @@ func (p *networklists) RemoveActivations(ctx context.Context, params RemoveActiv
 
 	logger := p.Log(ctx)
 	logger.Debug("RemoveActivations")
+	params.Network = environment(params.Network)
 
 	uri := fmt.Sprintf("/network-list/v2/network-lists/%s/environments/%s/deactivate",
 		params.UniqueID,
@@ -104,6 +104,13 @@ func TestAppSec_GetActivations(t *testing.T) {
 			expectedPath:     "/network-list/v2/network-lists/38069_INTERNALWHITELIST/environments/STAGING/status",
 			expectedResponse: &result,
 		},
+		"200 OK - lower case network": {
+			params:           GetActivationsRequest{UniqueID: "38069_INTERNALWHITELIST", Network: "staging"},
+			responseStatus:   http.StatusOK,
+			responseBody:     respData,
+			expectedPath:     "/network-list/v2/network-lists/38069_INTERNALWHITELIST/environments/STAGING/status",
+			expectedResponse: &result,
+		},
 		"500 internal server error": {
 			params:         GetActivationsRequest{UniqueID: "38069_INTERNALWHITELIST", Network: "STAGING"},
 			responseStatus: http.StatusInternalServerError,
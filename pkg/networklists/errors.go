@@ -11,6 +11,8 @@ import (
 var (
 	// ErrBadRequest is returned when a required parameter is missing
 	ErrBadRequest = errors.New("missing argument")
+	// ErrConflict is matched by errors returned when a conditional request was rejected, because the resource has been modified
+	ErrConflict = errors.New("resource has been modified")
 )
 
 type (
@@ -58,6 +60,10 @@ func (e *Error) Error() string {
 
 // Is handles error comparisons
 func (e *Error) Is(target error) bool {
+	if target == ErrConflict {
+		return e.StatusCode == http.StatusPreconditionFailed
+	}
+
 	var t *Error
 	if !errors.As(target, &t) {
 		return false
@@ -64,6 +64,16 @@ func (p *Mock) CreateNetworkList(ctx context.Context, params CreateNetworkListRe
 	return args.Get(0).(*CreateNetworkListResponse), args.Error(1)
 }
 
+func (p *Mock) CreateNetworkListIfAbsent(ctx context.Context, params CreateNetworkListRequest) (*CreateNetworkListIfAbsentResponse, error) {
+	args := p.Called(ctx, params)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*CreateNetworkListIfAbsentResponse), args.Error(1)
+}
+
 func (p *Mock) RemoveNetworkList(ctx context.Context, params RemoveNetworkListRequest) (*RemoveNetworkListResponse, error) {
 	args := p.Called(ctx, params)
 
@@ -153,3 +163,23 @@ func (p *Mock) UpdateNetworkListSubscription(ctx context.Context, params UpdateN
 
 	return args.Get(0).(*UpdateNetworkListSubscriptionResponse), args.Error(1)
 }
+
+func (p *Mock) AddNetworkListElements(ctx context.Context, params AddNetworkListElementsRequest) (*GetNetworkListResponse, error) {
+	args := p.Called(ctx, params)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*GetNetworkListResponse), args.Error(1)
+}
+
+func (p *Mock) RemoveNetworkListElement(ctx context.Context, params RemoveNetworkListElementRequest) (*GetNetworkListResponse, error) {
+	args := p.Called(ctx, params)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*GetNetworkListResponse), args.Error(1)
+}
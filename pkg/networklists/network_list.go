@@ -2,6 +2,7 @@ package networklists
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -27,12 +28,19 @@ type (
 		// See: https://techdocs.akamai.com/network-lists/reference/post-network-lists
 		CreateNetworkList(ctx context.Context, params CreateNetworkListRequest) (*CreateNetworkListResponse, error)
 
+		// CreateNetworkListIfAbsent creates a new network list, unless a network list with the same name and type
+		// already exists, in which case the existing network list is returned.
+		//
+		// See: https://techdocs.akamai.com/network-lists/reference/post-network-lists
+		CreateNetworkListIfAbsent(ctx context.Context, params CreateNetworkListRequest) (*CreateNetworkListIfAbsentResponse, error)
+
 		// UpdateNetworkList modifies the network list.
 		//
 		//See: https://techdocs.akamai.com/network-lists/reference/put-network-list
 		UpdateNetworkList(ctx context.Context, params UpdateNetworkListRequest) (*UpdateNetworkListResponse, error)
 
-		// RemoveNetworkList removes a network list.
+		// RemoveNetworkList removes a network list. When ETag is provided, the network list is removed only
+		// if it has not changed since it was retrieved, otherwise an error matching ErrConflict is returned.
 		//
 		// See: https://techdocs.akamai.com/network-lists/reference/delete-network-list
 		RemoveNetworkList(ctx context.Context, params RemoveNetworkListRequest) (*RemoveNetworkListResponse, error)
@@ -111,6 +119,8 @@ type (
 				Method string `json:"method"`
 			} `json:"update"`
 		} `json:"links"`
+		// ETag is the value of the ETag response header, which can be passed to RemoveNetworkList
+		ETag string `json:"-"`
 	}
 
 	// CreateNetworkListRequest contains request parameters for CreateNetworkList method
@@ -187,6 +197,8 @@ type (
 	// RemoveNetworkListRequest contains request parameters for RemoveNetworkList method
 	RemoveNetworkListRequest struct {
 		UniqueID string `json:"-"`
+		// ETag, if set, is sent in the If-Match header, so that the network list is not removed if it was modified
+		ETag string `json:"-"`
 	}
 
 	// RemoveNetworkListResponse contains response from RemoveNetworkList method
@@ -237,6 +249,13 @@ type (
 		} `json:"links"`
 	}
 
+	// CreateNetworkListIfAbsentResponse contains response from CreateNetworkListIfAbsent method.
+	// Created is set to true when a new network list was created, and to false when an existing one was returned.
+	CreateNetworkListIfAbsentResponse struct {
+		CreateNetworkListResponse
+		Created bool `json:"-"`
+	}
+
 	// LinkInfo contains hypermedia link
 	LinkInfo struct {
 		Href   string `json:"href,omitempty"`
@@ -317,6 +336,7 @@ func (p *networklists) GetNetworkList(ctx context.Context, params GetNetworkList
 	if resp.StatusCode != http.StatusOK {
 		return nil, p.Error(resp)
 	}
+	rval.ETag = resp.Header.Get("ETag")
 
 	return &rval, nil
 
@@ -423,6 +443,76 @@ func (p *networklists) CreateNetworkList(ctx context.Context, params CreateNetwo
 
 }
 
+func (p *networklists) CreateNetworkListIfAbsent(ctx context.Context, params CreateNetworkListRequest) (*CreateNetworkListIfAbsentResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrStructValidation, err.Error())
+	}
+
+	logger := p.Log(ctx)
+	logger.Debug("CreateNetworkListIfAbsent")
+
+	existing, err := p.findNetworkList(ctx, params.Name, params.Type)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	created, err := p.CreateNetworkList(ctx, params)
+	if err != nil {
+		// the network list could have been created concurrently, since it was looked up
+		var e *Error
+		if !errors.As(err, &e) || e.StatusCode != http.StatusConflict {
+			return nil, err
+		}
+		logger.Debugf("network list %q was created concurrently, retrying lookup", params.Name)
+		existing, lookupErr := p.findNetworkList(ctx, params.Name, params.Type)
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+		if existing == nil {
+			return nil, err
+		}
+		return existing, nil
+	}
+
+	return &CreateNetworkListIfAbsentResponse{CreateNetworkListResponse: *created, Created: true}, nil
+}
+
+// findNetworkList returns network list with given name and type, or nil if such network list does not exist
+func (p *networklists) findNetworkList(ctx context.Context, name, listType string) (*CreateNetworkListIfAbsentResponse, error) {
+	lists, err := p.GetNetworkLists(ctx, GetNetworkListsRequest{Name: name, Type: listType})
+	if err != nil {
+		return nil, err
+	}
+	if len(lists.NetworkLists) == 0 {
+		return nil, nil
+	}
+
+	list, err := p.GetNetworkList(ctx, GetNetworkListRequest{UniqueID: lists.NetworkLists[0].UniqueID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateNetworkListIfAbsentResponse{
+		CreateNetworkListResponse: CreateNetworkListResponse{
+			Name:            list.Name,
+			Description:     list.Description,
+			UniqueID:        list.UniqueID,
+			SyncPoint:       list.SyncPoint,
+			Type:            list.Type,
+			NetworkListType: list.NetworkListType,
+			ElementCount:    list.ElementCount,
+			ReadOnly:        list.ReadOnly,
+			Shared:          list.Shared,
+			List:            list.List,
+			Links:           list.Links,
+		},
+		Created: false,
+	}, nil
+}
+
 func (p *networklists) RemoveNetworkList(ctx context.Context, params RemoveNetworkListRequest) (*RemoveNetworkListResponse, error) {
 	if err := params.Validate(); err != nil {
 		return nil, fmt.Errorf("%w: %s", ErrStructValidation, err.Error())
@@ -445,6 +535,9 @@ func (p *networklists) RemoveNetworkList(ctx context.Context, params RemoveNetwo
 	if err != nil {
 		return nil, fmt.Errorf("failed to create RemoveNetworkList request: %s", err.Error())
 	}
+	if params.ETag != "" {
+		req.Header.Set("If-Match", params.ETag)
+	}
 
 	resp, err := p.Exec(req, &rval)
 	if err != nil {
@@ -0,0 +1,156 @@
+package networklists
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type (
+	// The NetworkListElements interface supports adding and removing elements of a network list.
+	NetworkListElements interface {
+		// AddNetworkListElements appends elements to the network list and returns the updated network list.
+		AddNetworkListElements(ctx context.Context, params AddNetworkListElementsRequest) (*GetNetworkListResponse, error)
+
+		// RemoveNetworkListElement removes a single element from the network list and returns the updated network list.
+		RemoveNetworkListElement(ctx context.Context, params RemoveNetworkListElementRequest) (*GetNetworkListResponse, error)
+	}
+
+	// AddNetworkListElementsRequest contains request parameters for AddNetworkListElements method
+	AddNetworkListElementsRequest struct {
+		UniqueID string `json:"-"`
+		// Type is the type of the network list, either NetworkListTypeIP or NetworkListTypeGEO.
+		// The elements are validated according to it.
+		Type     string   `json:"-"`
+		Elements []string `json:"list"`
+	}
+
+	// RemoveNetworkListElementRequest contains request parameters for RemoveNetworkListElement method
+	RemoveNetworkListElementRequest struct {
+		UniqueID string
+		Element  string
+	}
+)
+
+const (
+	// NetworkListTypeIP is the type of network lists of IP addresses and CIDR blocks
+	NetworkListTypeIP = "IP"
+	// NetworkListTypeGEO is the type of network lists of ISO 3166 country and subdivision codes
+	NetworkListTypeGEO = "GEO"
+)
+
+var geoElementRegexp = regexp.MustCompile(`^[A-Za-z]{2}(-[A-Za-z0-9]{1,3})?$`)
+
+// Validate validates AddNetworkListElementsRequest
+func (v AddNetworkListElementsRequest) Validate() error {
+	return validation.Errors{
+		"UniqueID": validation.Validate(v.UniqueID, validation.Required),
+		"Type":     validation.Validate(v.Type, validation.Required, validation.In(NetworkListTypeIP, NetworkListTypeGEO)),
+		"Elements": validation.Validate(v.Elements, validation.Required, validation.Each(validation.Required, networkListElement(v.Type))),
+	}.Filter()
+}
+
+// Validate validates RemoveNetworkListElementRequest
+func (v RemoveNetworkListElementRequest) Validate() error {
+	return validation.Errors{
+		"UniqueID": validation.Validate(v.UniqueID, validation.Required),
+		"Element":  validation.Validate(v.Element, validation.Required),
+	}.Filter()
+}
+
+// networkListElement validates that the element is a valid element of the network list of given type
+func networkListElement(listType string) validation.Rule {
+	return validation.By(func(value interface{}) error {
+		element, _ := value.(string)
+		if element == "" {
+			return nil
+		}
+		switch listType {
+		case NetworkListTypeIP:
+			if net.ParseIP(element) == nil {
+				if _, _, err := net.ParseCIDR(element); err != nil {
+					return fmt.Errorf("%q is not a valid IP address or CIDR block", element)
+				}
+			}
+		case NetworkListTypeGEO:
+			if !geoElementRegexp.MatchString(element) {
+				return fmt.Errorf("%q is not a valid country or subdivision code", element)
+			}
+		}
+		return nil
+	})
+}
+
+func (p *networklists) AddNetworkListElements(ctx context.Context, params AddNetworkListElementsRequest) (*GetNetworkListResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrStructValidation, err.Error())
+	}
+
+	logger := p.Log(ctx)
+	logger.Debug("AddNetworkListElements")
+
+	putURL := fmt.Sprintf(
+		"/network-list/v2/network-lists/%s/append",
+		url.PathEscape(params.UniqueID),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, putURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AddNetworkListElements request: %s", err.Error())
+	}
+
+	var rval GetNetworkListResponse
+	resp, err := p.Exec(req, &rval, params)
+	if err != nil {
+		return nil, fmt.Errorf("AddNetworkListElements request failed: %s", err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return nil, p.Error(resp)
+	}
+
+	return &rval, nil
+}
+
+func (p *networklists) RemoveNetworkListElement(ctx context.Context, params RemoveNetworkListElementRequest) (*GetNetworkListResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrStructValidation, err.Error())
+	}
+
+	logger := p.Log(ctx)
+	logger.Debug("RemoveNetworkListElement")
+
+	uri, err := url.Parse(fmt.Sprintf(
+		"/network-list/v2/network-lists/%s/elements",
+		url.PathEscape(params.UniqueID)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse url: %s", err.Error())
+	}
+	// the element is sent as a query parameter, so that slashes of CIDR blocks and colons of IPv6 addresses are escaped
+	q := uri.Query()
+	q.Set("element", params.Element)
+	uri.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RemoveNetworkListElement request: %s", err.Error())
+	}
+
+	var rval GetNetworkListResponse
+	resp, err := p.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("RemoveNetworkListElement request failed: %s", err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, p.Error(resp)
+	}
+
+	return &rval, nil
+}
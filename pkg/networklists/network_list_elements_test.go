@@ -0,0 +1,289 @@
+package networklists
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkList_AddNetworkListElements(t *testing.T) {
+	tests := map[string]struct {
+		params           AddNetworkListElementsRequest
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedBody     string
+		expectedResponse *GetNetworkListResponse
+		withError        func(*testing.T, error)
+	}{
+		"200 OK - IP": {
+			params: AddNetworkListElementsRequest{
+				UniqueID: "79536_MARTINNETWORKLIST",
+				Type:     NetworkListTypeIP,
+				Elements: []string{"192.0.2.1", "198.51.100.0/24", "2001:db8::/32"},
+			},
+			responseStatus: http.StatusOK,
+			responseBody: `
+{
+    "name": "Martin Network List",
+    "uniqueId": "79536_MARTINNETWORKLIST",
+    "syncPoint": 5,
+    "type": "IP",
+    "elementCount": 4,
+    "list": ["192.0.2.1", "198.51.100.0/24", "2001:db8::/32", "203.0.113.7"]
+}`,
+			expectedPath: "/network-list/v2/network-lists/79536_MARTINNETWORKLIST/append",
+			expectedBody: `{"list":["192.0.2.1","198.51.100.0/24","2001:db8::/32"]}`,
+			expectedResponse: &GetNetworkListResponse{
+				Name:         "Martin Network List",
+				UniqueID:     "79536_MARTINNETWORKLIST",
+				SyncPoint:    5,
+				Type:         "IP",
+				ElementCount: 4,
+				List:         []string{"192.0.2.1", "198.51.100.0/24", "2001:db8::/32", "203.0.113.7"},
+			},
+		},
+		"200 OK - GEO": {
+			params: AddNetworkListElementsRequest{
+				UniqueID: "40731_BMROLLOUTGEO",
+				Type:     NetworkListTypeGEO,
+				Elements: []string{"US", "CA-QC"},
+			},
+			responseStatus: http.StatusOK,
+			responseBody: `
+{
+    "name": "Rollout GEO",
+    "uniqueId": "40731_BMROLLOUTGEO",
+    "syncPoint": 9,
+    "type": "GEO",
+    "elementCount": 2,
+    "list": ["US", "CA-QC"]
+}`,
+			expectedPath: "/network-list/v2/network-lists/40731_BMROLLOUTGEO/append",
+			expectedBody: `{"list":["US","CA-QC"]}`,
+			expectedResponse: &GetNetworkListResponse{
+				Name:         "Rollout GEO",
+				UniqueID:     "40731_BMROLLOUTGEO",
+				SyncPoint:    9,
+				Type:         "GEO",
+				ElementCount: 2,
+				List:         []string{"US", "CA-QC"},
+			},
+		},
+		"500 internal server error": {
+			params: AddNetworkListElementsRequest{
+				UniqueID: "79536_MARTINNETWORKLIST",
+				Type:     NetworkListTypeIP,
+				Elements: []string{"192.0.2.1"},
+			},
+			responseStatus: http.StatusInternalServerError,
+			responseBody: `
+{
+    "type": "internal_error",
+    "title": "Internal Server Error",
+    "detail": "Error appending elements"
+}`,
+			expectedPath: "/network-list/v2/network-lists/79536_MARTINNETWORKLIST/append",
+			expectedBody: `{"list":["192.0.2.1"]}`,
+			withError: func(t *testing.T, err error) {
+				want := &Error{
+					Type:       "internal_error",
+					Title:      "Internal Server Error",
+					Detail:     "Error appending elements",
+					StatusCode: http.StatusInternalServerError,
+				}
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+		"no elements": {
+			params: AddNetworkListElementsRequest{
+				UniqueID: "79536_MARTINNETWORKLIST",
+				Type:     NetworkListTypeIP,
+			},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				assert.Contains(t, err.Error(), "Elements")
+			},
+		},
+		"empty element": {
+			params: AddNetworkListElementsRequest{
+				UniqueID: "79536_MARTINNETWORKLIST",
+				Type:     NetworkListTypeIP,
+				Elements: []string{"192.0.2.1", ""},
+			},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				assert.Contains(t, err.Error(), "Elements")
+			},
+		},
+		"invalid IP element": {
+			params: AddNetworkListElementsRequest{
+				UniqueID: "79536_MARTINNETWORKLIST",
+				Type:     NetworkListTypeIP,
+				Elements: []string{"192.0.2.256"},
+			},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				assert.Contains(t, err.Error(), `"192.0.2.256" is not a valid IP address or CIDR block`)
+			},
+		},
+		"IP element in GEO list": {
+			params: AddNetworkListElementsRequest{
+				UniqueID: "40731_BMROLLOUTGEO",
+				Type:     NetworkListTypeGEO,
+				Elements: []string{"US", "192.0.2.1"},
+			},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				assert.Contains(t, err.Error(), `"192.0.2.1" is not a valid country or subdivision code`)
+			},
+		},
+		"missing type": {
+			params: AddNetworkListElementsRequest{
+				UniqueID: "79536_MARTINNETWORKLIST",
+				Elements: []string{"192.0.2.1"},
+			},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				assert.Contains(t, err.Error(), "Type")
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				var body json.RawMessage
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.JSONEq(t, test.expectedBody, string(body))
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.AddNetworkListElements(context.Background(), test.params)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestNetworkList_RemoveNetworkListElement(t *testing.T) {
+	tests := map[string]struct {
+		params           RemoveNetworkListElementRequest
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedResponse *GetNetworkListResponse
+		withError        func(*testing.T, error)
+	}{
+		"200 OK - CIDR block": {
+			params: RemoveNetworkListElementRequest{
+				UniqueID: "79536_MARTINNETWORKLIST",
+				Element:  "198.51.100.0/24",
+			},
+			responseStatus: http.StatusOK,
+			responseBody: `
+{
+    "name": "Martin Network List",
+    "uniqueId": "79536_MARTINNETWORKLIST",
+    "syncPoint": 6,
+    "type": "IP",
+    "elementCount": 1,
+    "list": ["192.0.2.1"]
+}`,
+			expectedPath: "/network-list/v2/network-lists/79536_MARTINNETWORKLIST/elements?element=198.51.100.0%2F24",
+			expectedResponse: &GetNetworkListResponse{
+				Name:         "Martin Network List",
+				UniqueID:     "79536_MARTINNETWORKLIST",
+				SyncPoint:    6,
+				Type:         "IP",
+				ElementCount: 1,
+				List:         []string{"192.0.2.1"},
+			},
+		},
+		"200 OK - IPv6 address": {
+			params: RemoveNetworkListElementRequest{
+				UniqueID: "79536_MARTINNETWORKLIST",
+				Element:  "2001:db8::1",
+			},
+			responseStatus: http.StatusOK,
+			responseBody: `
+{
+    "uniqueId": "79536_MARTINNETWORKLIST",
+    "syncPoint": 7,
+    "type": "IP"
+}`,
+			expectedPath: "/network-list/v2/network-lists/79536_MARTINNETWORKLIST/elements?element=2001%3Adb8%3A%3A1",
+			expectedResponse: &GetNetworkListResponse{
+				UniqueID:  "79536_MARTINNETWORKLIST",
+				SyncPoint: 7,
+				Type:      "IP",
+			},
+		},
+		"404 not found": {
+			params: RemoveNetworkListElementRequest{
+				UniqueID: "79536_MARTINNETWORKLIST",
+				Element:  "203.0.113.7",
+			},
+			responseStatus: http.StatusNotFound,
+			responseBody: `
+{
+    "type": "not_found",
+    "title": "Not Found",
+    "detail": "Element not found"
+}`,
+			expectedPath: "/network-list/v2/network-lists/79536_MARTINNETWORKLIST/elements?element=203.0.113.7",
+			withError: func(t *testing.T, err error) {
+				want := &Error{
+					Type:       "not_found",
+					Title:      "Not Found",
+					Detail:     "Element not found",
+					StatusCode: http.StatusNotFound,
+				}
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+		"missing element": {
+			params: RemoveNetworkListElementRequest{
+				UniqueID: "79536_MARTINNETWORKLIST",
+			},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				assert.Contains(t, err.Error(), "Element")
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.RemoveNetworkListElement(context.Background(), test.params)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
@@ -175,10 +175,14 @@ func TestNetworkList_GetNetworkList(t *testing.T) {
 	err := json.Unmarshal([]byte(respData), &result)
 	require.NoError(t, err)
 
+	resultWithETag := result
+	resultWithETag.ETag = `"5cb6b3a1"`
+
 	tests := map[string]struct {
 		params           GetNetworkListRequest
 		responseStatus   int
 		responseBody     string
+		responseHeaders  http.Header
 		expectedPath     string
 		expectedResponse *GetNetworkListResponse
 		withError        error
@@ -190,6 +194,14 @@ func TestNetworkList_GetNetworkList(t *testing.T) {
 			expectedPath:     "/network-list/v2/network-lists/Test",
 			expectedResponse: &result,
 		},
+		"200 OK with ETag": {
+			params:           GetNetworkListRequest{UniqueID: "Test"},
+			responseStatus:   http.StatusOK,
+			responseBody:     respData,
+			responseHeaders:  http.Header{"Etag": []string{`"5cb6b3a1"`}},
+			expectedPath:     "/network-list/v2/network-lists/Test",
+			expectedResponse: &resultWithETag,
+		},
 		"500 internal server error": {
 			params:         GetNetworkListRequest{UniqueID: "Test"},
 			responseStatus: http.StatusInternalServerError,
@@ -214,6 +226,9 @@ func TestNetworkList_GetNetworkList(t *testing.T) {
 			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				assert.Equal(t, test.expectedPath, r.URL.String())
 				assert.Equal(t, http.MethodGet, r.Method)
+				for k, v := range test.responseHeaders {
+					w.Header()[k] = v
+				}
 				w.WriteHeader(test.responseStatus)
 				_, err := w.Write([]byte(test.responseBody))
 				assert.NoError(t, err)
@@ -310,6 +325,180 @@ func TestNetworkList_CreateNetworkList(t *testing.T) {
 	}
 }
 
+// Test CreateNetworkListIfAbsent
+func TestNetworkList_CreateNetworkListIfAbsent(t *testing.T) {
+	listsBody := `
+{
+    "networkLists": [
+        {
+            "elementCount": 1,
+            "name": "Test",
+            "networkListType": "networkListResponse",
+            "readOnly": false,
+            "shared": false,
+            "syncPoint": 2,
+            "type": "IP",
+            "uniqueId": "1234_TEST"
+        }
+    ]
+}`
+	listBody := `
+{
+    "name": "Test",
+    "uniqueId": "1234_TEST",
+    "syncPoint": 2,
+    "type": "IP",
+    "networkListType": "networkListResponse",
+    "elementCount": 1,
+    "readOnly": false,
+    "shared": false,
+    "list": ["1.2.3.4"]
+}`
+	createdBody := `
+{
+    "name": "Test",
+    "uniqueId": "5678_TEST",
+    "syncPoint": 0,
+    "type": "IP",
+    "networkListType": "networkListResponse",
+    "elementCount": 1,
+    "readOnly": false,
+    "shared": false,
+    "list": ["1.2.3.4"]
+}`
+	conflictBody := `
+{
+    "type": "conflict",
+    "title": "Conflict",
+    "detail": "Network list with given name already exists"
+}`
+
+	tests := map[string]struct {
+		params           CreateNetworkListRequest
+		listsBodies      []string
+		createStatus     int
+		createBody       string
+		expectedCalls    []string
+		expectedResponse *CreateNetworkListIfAbsentResponse
+		withError        error
+	}{
+		"existing network list": {
+			params:        CreateNetworkListRequest{Name: "Test", Type: "IP", List: []string{"1.2.3.4"}},
+			listsBodies:   []string{listsBody},
+			expectedCalls: []string{"GET /network-list/v2/network-lists", "GET /network-list/v2/network-lists/1234_TEST"},
+			expectedResponse: &CreateNetworkListIfAbsentResponse{
+				CreateNetworkListResponse: CreateNetworkListResponse{
+					Name:            "Test",
+					UniqueID:        "1234_TEST",
+					SyncPoint:       2,
+					Type:            "IP",
+					NetworkListType: "networkListResponse",
+					ElementCount:    1,
+					List:            []string{"1.2.3.4"},
+				},
+				Created: false,
+			},
+		},
+		"new network list": {
+			params:        CreateNetworkListRequest{Name: "Test", Type: "IP", List: []string{"1.2.3.4"}},
+			listsBodies:   []string{`{"networkLists": []}`},
+			createStatus:  http.StatusCreated,
+			createBody:    createdBody,
+			expectedCalls: []string{"GET /network-list/v2/network-lists", "POST /network-list/v2/network-lists"},
+			expectedResponse: &CreateNetworkListIfAbsentResponse{
+				CreateNetworkListResponse: CreateNetworkListResponse{
+					Name:            "Test",
+					UniqueID:        "5678_TEST",
+					Type:            "IP",
+					NetworkListType: "networkListResponse",
+					ElementCount:    1,
+					List:            []string{"1.2.3.4"},
+				},
+				Created: true,
+			},
+		},
+		"created concurrently": {
+			params:       CreateNetworkListRequest{Name: "Test", Type: "IP", List: []string{"1.2.3.4"}},
+			listsBodies:  []string{`{"networkLists": []}`, listsBody},
+			createStatus: http.StatusConflict,
+			createBody:   conflictBody,
+			expectedCalls: []string{
+				"GET /network-list/v2/network-lists",
+				"POST /network-list/v2/network-lists",
+				"GET /network-list/v2/network-lists",
+				"GET /network-list/v2/network-lists/1234_TEST",
+			},
+			expectedResponse: &CreateNetworkListIfAbsentResponse{
+				CreateNetworkListResponse: CreateNetworkListResponse{
+					Name:            "Test",
+					UniqueID:        "1234_TEST",
+					SyncPoint:       2,
+					Type:            "IP",
+					NetworkListType: "networkListResponse",
+					ElementCount:    1,
+					List:            []string{"1.2.3.4"},
+				},
+				Created: false,
+			},
+		},
+		"conflict without existing network list": {
+			params:       CreateNetworkListRequest{Name: "Test", Type: "IP"},
+			listsBodies:  []string{`{"networkLists": []}`, `{"networkLists": []}`},
+			createStatus: http.StatusConflict,
+			createBody:   conflictBody,
+			expectedCalls: []string{
+				"GET /network-list/v2/network-lists",
+				"POST /network-list/v2/network-lists",
+				"GET /network-list/v2/network-lists",
+			},
+			withError: &Error{
+				Type:       "conflict",
+				Title:      "Conflict",
+				Detail:     "Network list with given name already exists",
+				StatusCode: http.StatusConflict,
+			},
+		},
+		"validation error": {
+			params:    CreateNetworkListRequest{Type: "IP"},
+			withError: ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			listsCalls := 0
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.Method+" "+r.URL.String())
+				switch {
+				case r.Method == http.MethodGet && r.URL.String() == "/network-list/v2/network-lists":
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(test.listsBodies[listsCalls]))
+					assert.NoError(t, err)
+					listsCalls++
+				case r.Method == http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(listBody))
+					assert.NoError(t, err)
+				case r.Method == http.MethodPost:
+					w.WriteHeader(test.createStatus)
+					_, err := w.Write([]byte(test.createBody))
+					assert.NoError(t, err)
+				}
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.CreateNetworkListIfAbsent(context.Background(), test.params)
+			assert.Equal(t, test.expectedCalls, calls)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
 // Test Update NetworkList
 func TestNetworkList_UpdateNetworkList(t *testing.T) {
 	result := UpdateNetworkListResponse{}
@@ -407,6 +596,7 @@ func TestNetworkList_DeleteNetworkList(t *testing.T) {
 		responseStatus   int
 		responseBody     string
 		expectedPath     string
+		expectedIfMatch  string
 		expectedResponse *RemoveNetworkListResponse
 		withError        error
 		headers          http.Header
@@ -421,6 +611,30 @@ func TestNetworkList_DeleteNetworkList(t *testing.T) {
 			expectedResponse: &result,
 			expectedPath:     "/network-list/v2/network-lists/Test",
 		},
+		"200 Success with matching ETag": {
+			params: RemoveNetworkListRequest{UniqueID: "Test", ETag: `"5cb6b3a1"`},
+			headers: http.Header{
+				"Content-Type": []string{"application/json;charset=UTF-8"},
+			},
+			responseStatus:   http.StatusOK,
+			responseBody:     respData,
+			expectedResponse: &result,
+			expectedPath:     "/network-list/v2/network-lists/Test",
+			expectedIfMatch:  `"5cb6b3a1"`,
+		},
+		"412 stale ETag": {
+			params:         RemoveNetworkListRequest{UniqueID: "Test", ETag: `"4ab1c2d3"`},
+			responseStatus: http.StatusPreconditionFailed,
+			responseBody: `
+        {
+         "type": "precondition_failed",
+         "title": "Precondition Failed",
+         "detail": "The network list has been modified"
+         }`,
+			expectedPath:    "/network-list/v2/network-lists/Test",
+			expectedIfMatch: `"4ab1c2d3"`,
+			withError:       ErrConflict,
+		},
 		"500 internal server error": {
 			params:         RemoveNetworkListRequest{UniqueID: "Test"},
 			responseStatus: http.StatusInternalServerError,
@@ -445,6 +659,7 @@ func TestNetworkList_DeleteNetworkList(t *testing.T) {
 			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				assert.Equal(t, http.MethodDelete, r.Method)
 				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, test.expectedIfMatch, r.Header.Get("If-Match"))
 				w.WriteHeader(test.responseStatus)
 				if len(test.responseBody) > 0 {
 					_, err := w.Write([]byte(test.responseBody))
@@ -5,6 +5,7 @@ package networklists
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 )
@@ -20,6 +21,7 @@ type (
 		Activations
 		NetworkList
 		NetworkListDescription
+		NetworkListElements
 		NetworkListSubscription
 	}
 
@@ -46,3 +48,11 @@ func Client(sess session.Session, opts ...Option) NTWRKLISTS {
 	}
 	return p
 }
+
+// WithDefaultHeaders adds the headers to every request made by the client, e.g. a static team identifier.
+// Headers set by the client methods and with session.WithContextHeaders take precedence, see session.WithDefaultHeaders.
+func WithDefaultHeaders(h http.Header) Option {
+	return func(c *networklists) {
+		c.Session = session.WithDefaultHeaders(c.Session, h)
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/edgegriderr"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -24,10 +26,30 @@ type (
 		// See: https://techdocs.akamai.com/property-mgr/reference/get-edgehostname
 		GetEdgeHostname(context.Context, GetEdgeHostnameRequest) (*GetEdgeHostnamesResponse, error)
 
+		// GetEdgeHostnamesByProductAndPrefix fetches a list of edge hostnames and returns only those
+		// matching provided product ID and domain prefix. Filtering is done on the client side.
+		//
+		// See: https://techdocs.akamai.com/property-mgr/reference/get-edgehostnames
+		GetEdgeHostnamesByProductAndPrefix(context.Context, GetEdgeHostnamesByProductAndPrefixRequest) (*GetEdgeHostnamesResponse, error)
+
 		// CreateEdgeHostname creates a new edge hostname
 		//
 		// See: https://techdocs.akamai.com/property-mgr/reference/post-edgehostnames
 		CreateEdgeHostname(context.Context, CreateEdgeHostnameRequest) (*CreateEdgeHostnameResponse, error)
+
+		// CreateAndGetEdgeHostname creates a new edge hostname and follows the returned link to fetch the created edge hostname.
+		// As the created edge hostname may not be available immediately, fetching is retried while the API responds with 404.
+		//
+		// See: https://techdocs.akamai.com/property-mgr/reference/post-edgehostnames
+		// See: https://techdocs.akamai.com/property-mgr/reference/get-edgehostname
+		CreateAndGetEdgeHostname(context.Context, CreateEdgeHostnameRequest) (*GetEdgeHostnamesResponse, error)
+
+		// DeleteEdgeHostname deletes edge hostname with given ID
+		DeleteEdgeHostname(context.Context, DeleteEdgeHostnameRequest) (*DeleteEdgeHostnameResponse, error)
+
+		// PatchEdgeHostname updates ttl or use cases of edge hostname with given ID using JSON Patch operations.
+		// The update is processed asynchronously, the returned link can be followed to check its completion.
+		PatchEdgeHostname(context.Context, PatchEdgeHostnameRequest) (*PatchEdgeHostnameResponse, error)
 	}
 
 	// GetEdgeHostnamesRequest contains query params used for listing edge hostnames
@@ -45,6 +67,16 @@ type (
 		Options        []string
 	}
 
+	// GetEdgeHostnamesByProductAndPrefixRequest contains query params used for listing edge hostnames
+	// and the values the returned edge hostnames are filtered by
+	GetEdgeHostnamesByProductAndPrefixRequest struct {
+		ContractID   string
+		GroupID      string
+		Options      []string
+		ProductID    string
+		DomainPrefix string
+	}
+
 	// GetEdgeHostnamesResponse contains data received by calling GetEdgeHostnames or GetEdgeHostname
 	GetEdgeHostnamesResponse struct {
 		AccountID     string            `json:"accountId"`
@@ -105,6 +137,39 @@ type (
 		EdgeHostnameLink string `json:"edgeHostnameLink"`
 		EdgeHostnameID   string `json:"-"`
 	}
+
+	// DeleteEdgeHostnameRequest contains path and query params used to delete edge hostname
+	DeleteEdgeHostnameRequest struct {
+		EdgeHostnameID string
+		ContractID     string
+		GroupID        string
+	}
+
+	// DeleteEdgeHostnameResponse contains data received by calling DeleteEdgeHostname
+	DeleteEdgeHostnameResponse struct {
+		Message string `json:"message"`
+	}
+
+	// PatchEdgeHostnameRequest contains path and query params and JSON Patch operations used to update edge hostname
+	PatchEdgeHostnameRequest struct {
+		EdgeHostnameID string
+		ContractID     string
+		GroupID        string
+		Body           []EdgeHostnamePatchOperation
+	}
+
+	// EdgeHostnamePatchOperation is a single JSON Patch operation applied to edge hostname
+	EdgeHostnamePatchOperation struct {
+		Op    string      `json:"op"`
+		Path  string      `json:"path"`
+		Value interface{} `json:"value,omitempty"`
+	}
+
+	// PatchEdgeHostnameResponse contains a link returned after updating edge hostname and ID of this hostname
+	PatchEdgeHostnameResponse struct {
+		EdgeHostnameLink string `json:"edgeHostnameLink"`
+		EdgeHostnameID   string `json:"-"`
+	}
 )
 
 const (
@@ -124,6 +189,18 @@ const (
 
 	// UseCaseGlobal constant
 	UseCaseGlobal = "GLOBAL"
+
+	// EHPatchOpAdd constant
+	EHPatchOpAdd = "add"
+	// EHPatchOpReplace constant
+	EHPatchOpReplace = "replace"
+	// EHPatchOpRemove constant
+	EHPatchOpRemove = "remove"
+
+	// EHPatchPathTTL constant
+	EHPatchPathTTL = "/ttl"
+	// EHPatchPathUseCases constant
+	EHPatchPathUseCases = "/useCases"
 )
 
 // Validate validates CreateEdgeHostnameRequest
@@ -179,13 +256,66 @@ func (eh GetEdgeHostnameRequest) Validate() error {
 	}.Filter()
 }
 
+// Validate validates GetEdgeHostnamesByProductAndPrefixRequest
+func (eh GetEdgeHostnamesByProductAndPrefixRequest) Validate() error {
+	return validation.Errors{
+		"ContractID":   validation.Validate(eh.ContractID, validation.Required),
+		"GroupID":      validation.Validate(eh.GroupID, validation.Required),
+		"ProductID":    validation.Validate(eh.ProductID, validation.Required),
+		"DomainPrefix": validation.Validate(eh.DomainPrefix, validation.Required),
+	}.Filter()
+}
+
+// Validate validates DeleteEdgeHostnameRequest
+func (eh DeleteEdgeHostnameRequest) Validate() error {
+	return validation.Errors{
+		"EdgeHostnameID": validation.Validate(eh.EdgeHostnameID, validation.Required),
+		"ContractID":     validation.Validate(eh.ContractID, validation.Required),
+		"GroupID":        validation.Validate(eh.GroupID, validation.Required),
+	}.Filter()
+}
+
+// Validate validates PatchEdgeHostnameRequest
+func (eh PatchEdgeHostnameRequest) Validate() error {
+	return validation.Errors{
+		"EdgeHostnameID": validation.Validate(eh.EdgeHostnameID, validation.Required),
+		"ContractID":     validation.Validate(eh.ContractID, validation.Required),
+		"GroupID":        validation.Validate(eh.GroupID, validation.Required),
+		"Body":           validation.Validate(eh.Body, validation.Required),
+	}.Filter()
+}
+
+// Validate validates EdgeHostnamePatchOperation
+func (op EdgeHostnamePatchOperation) Validate() error {
+	return validation.Errors{
+		"Op":    validation.Validate(op.Op, validation.Required, validation.In(EHPatchOpAdd, EHPatchOpReplace, EHPatchOpRemove)),
+		"Path":  validation.Validate(op.Path, validation.Required, validation.In(EHPatchPathTTL, EHPatchPathUseCases)),
+		"Value": validation.Validate(op.Value, validation.Required.When(op.Op != EHPatchOpRemove)),
+	}.Filter()
+}
+
 var (
 	// ErrGetEdgeHostnames represents error when fetching edge hostnames fails
 	ErrGetEdgeHostnames = errors.New("fetching edge hostnames")
 	// ErrGetEdgeHostname represents error when fetching edge hostname fails
 	ErrGetEdgeHostname = errors.New("fetching edge hostname")
+	// ErrGetEdgeHostnamesByProductAndPrefix represents error when fetching edge hostnames by product and domain prefix fails
+	ErrGetEdgeHostnamesByProductAndPrefix = errors.New("fetching edge hostnames by product and domain prefix")
 	// ErrCreateEdgeHostname represents error when creating edge hostname fails
 	ErrCreateEdgeHostname = errors.New("creating edge hostname")
+	// ErrCreateAndGetEdgeHostname represents error when creating edge hostname or fetching the created edge hostname fails
+	ErrCreateAndGetEdgeHostname = errors.New("creating and fetching edge hostname")
+	// ErrDeleteEdgeHostname represents error when deleting edge hostname fails
+	ErrDeleteEdgeHostname = errors.New("deleting edge hostname")
+	// ErrPatchEdgeHostname represents error when updating edge hostname fails
+	ErrPatchEdgeHostname = errors.New("updating edge hostname")
+)
+
+var (
+	// followLinkAttempts is the number of attempts to fetch the created edge hostname
+	followLinkAttempts = 5
+	// followLinkDelay is the time to wait between attempts to fetch the created edge hostname
+	followLinkDelay = 2 * time.Second
 )
 
 // GetEdgeHostnames id used to list edge hostnames for provided group and contract IDs
@@ -263,6 +393,39 @@ func (p *papi) GetEdgeHostname(ctx context.Context, params GetEdgeHostnameReques
 	return &edgeHostname, nil
 }
 
+// GetEdgeHostnamesByProductAndPrefix is used to list edge hostnames for provided group and contract IDs
+// which match given product ID and domain prefix
+func (p *papi) GetEdgeHostnamesByProductAndPrefix(ctx context.Context, params GetEdgeHostnamesByProductAndPrefixRequest) (*GetEdgeHostnamesResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrGetEdgeHostnamesByProductAndPrefix, ErrStructValidation, err)
+	}
+
+	logger := p.Log(ctx)
+	logger.Debug("GetEdgeHostnamesByProductAndPrefix")
+
+	edgeHostnames, err := p.GetEdgeHostnames(ctx, GetEdgeHostnamesRequest{
+		ContractID: params.ContractID,
+		GroupID:    params.GroupID,
+		Options:    params.Options,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrGetEdgeHostnamesByProductAndPrefix, err)
+	}
+
+	items := make([]EdgeHostnameGetItem, 0)
+	for _, item := range edgeHostnames.EdgeHostnames.Items {
+		if item.ProductID == params.ProductID && item.DomainPrefix == params.DomainPrefix {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%s: %w: ProductID: %s, DomainPrefix: %s", ErrGetEdgeHostnamesByProductAndPrefix, ErrNotFound, params.ProductID, params.DomainPrefix)
+	}
+	edgeHostnames.EdgeHostnames.Items = items
+
+	return edgeHostnames, nil
+}
+
 // CreateEdgeHostname id used to create new edge hostname for provided group and contract IDs
 func (p *papi) CreateEdgeHostname(ctx context.Context, r CreateEdgeHostnameRequest) (*CreateEdgeHostnameResponse, error) {
 	if err := r.Validate(); err != nil {
@@ -300,3 +463,114 @@ func (p *papi) CreateEdgeHostname(ctx context.Context, r CreateEdgeHostnameReque
 	createResponse.EdgeHostnameID = id
 	return &createResponse, nil
 }
+
+// CreateAndGetEdgeHostname is used to create new edge hostname for provided group and contract IDs and fetch it afterwards
+func (p *papi) CreateAndGetEdgeHostname(ctx context.Context, r CreateEdgeHostnameRequest) (*GetEdgeHostnamesResponse, error) {
+	logger := p.Log(ctx)
+	logger.Debug("CreateAndGetEdgeHostname")
+
+	created, err := p.CreateEdgeHostname(ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrCreateAndGetEdgeHostname, err)
+	}
+
+	getRequest := GetEdgeHostnameRequest{
+		EdgeHostnameID: created.EdgeHostnameID,
+		ContractID:     r.ContractID,
+		GroupID:        r.GroupID,
+		Options:        r.Options,
+	}
+	for attempt := 1; ; attempt++ {
+		edgeHostname, err := p.GetEdgeHostname(ctx, getRequest)
+		if err == nil {
+			return edgeHostname, nil
+		}
+		if !isEdgeHostnameNotFound(err) || attempt >= followLinkAttempts {
+			return nil, fmt.Errorf("%s: %w", ErrCreateAndGetEdgeHostname, err)
+		}
+		logger.Debugf("edge hostname %q not available yet, retrying (attempt %d of %d)", created.EdgeHostnameID, attempt, followLinkAttempts)
+
+		if err := session.Wait(ctx, followLinkDelay); err != nil {
+			return nil, fmt.Errorf("%s: %w", ErrCreateAndGetEdgeHostname, err)
+		}
+	}
+}
+
+// DeleteEdgeHostname is used to delete edge hostname with given ID for provided group and contract IDs
+func (p *papi) DeleteEdgeHostname(ctx context.Context, params DeleteEdgeHostnameRequest) (*DeleteEdgeHostnameResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrDeleteEdgeHostname, ErrStructValidation, err)
+	}
+
+	logger := p.Log(ctx)
+	logger.Debug("DeleteEdgeHostname")
+
+	deleteURL := fmt.Sprintf(
+		"/papi/v1/edgehostnames/%s?contractId=%s&groupId=%s",
+		params.EdgeHostnameID,
+		params.ContractID,
+		params.GroupID,
+	)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, deleteURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrDeleteEdgeHostname, err)
+	}
+
+	var rval DeleteEdgeHostnameResponse
+	resp, err := p.Exec(req, &rval)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrDeleteEdgeHostname, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrDeleteEdgeHostname, p.Error(resp))
+	}
+
+	return &rval, nil
+}
+
+// PatchEdgeHostname is used to update edge hostname with given ID for provided group and contract IDs
+func (p *papi) PatchEdgeHostname(ctx context.Context, params PatchEdgeHostnameRequest) (*PatchEdgeHostnameResponse, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrPatchEdgeHostname, ErrStructValidation, err)
+	}
+
+	logger := p.Log(ctx)
+	logger.Debug("PatchEdgeHostname")
+
+	patchURL := fmt.Sprintf(
+		"/papi/v1/edgehostnames/%s?contractId=%s&groupId=%s",
+		params.EdgeHostnameID,
+		params.ContractID,
+		params.GroupID,
+	)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, patchURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrPatchEdgeHostname, err)
+	}
+	req.Header.Set("Content-Type", "application/json-patch+json")
+
+	var patchResponse PatchEdgeHostnameResponse
+	resp, err := p.Exec(req, &patchResponse, params.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrPatchEdgeHostname, err)
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		return nil, fmt.Errorf("%s: %w", ErrPatchEdgeHostname, p.Error(resp))
+	}
+	id, err := ResponseLinkParse(patchResponse.EdgeHostnameLink)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrPatchEdgeHostname, ErrInvalidResponseLink, err)
+	}
+	patchResponse.EdgeHostnameID = id
+	return &patchResponse, nil
+}
+
+// isEdgeHostnameNotFound reports whether the error returned by GetEdgeHostname indicates the edge hostname does not exist (yet)
+func isEdgeHostnameNotFound(err error) bool {
+	if errors.Is(err, ErrNotFound) {
+		return true
+	}
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
@@ -2,11 +2,15 @@ package papi
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -311,6 +315,191 @@ func TestPapi_GetEdgeHostname(t *testing.T) {
 	}
 }
 
+func TestPapi_GetEdgeHostnamesByProductAndPrefix(t *testing.T) {
+	responseBody := `
+{
+    "accountId": "acc",
+    "contractId": "contract",
+    "groupId": "group",
+    "edgeHostnames": {
+        "items": [
+            {
+                "edgeHostnameId": "ehID1",
+                "edgeHostnameDomain": "example.com.edgekey.net",
+                "productId": "prdID",
+                "domainPrefix": "example.com",
+                "domainSuffix": "edgekey.net",
+                "secure": true,
+                "ipVersionBehavior": "IPV4"
+            },
+            {
+                "edgeHostnameId": "ehID2",
+                "edgeHostnameDomain": "example.com.edgesuite.net",
+                "productId": "prdID",
+                "domainPrefix": "example.com",
+                "domainSuffix": "edgesuite.net",
+                "secure": false,
+                "ipVersionBehavior": "IPV4"
+            },
+            {
+                "edgeHostnameId": "ehID3",
+                "edgeHostnameDomain": "other.com.edgesuite.net",
+                "productId": "otherPrdID",
+                "domainPrefix": "other.com",
+                "domainSuffix": "edgesuite.net",
+                "secure": false,
+                "ipVersionBehavior": "IPV4"
+            }
+        ]
+    }
+}`
+
+	tests := map[string]struct {
+		params           GetEdgeHostnamesByProductAndPrefixRequest
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedResponse *GetEdgeHostnamesResponse
+		withError        func(*testing.T, error)
+	}{
+		"200 OK - single match": {
+			params: GetEdgeHostnamesByProductAndPrefixRequest{
+				ContractID:   "contract",
+				GroupID:      "group",
+				ProductID:    "otherPrdID",
+				DomainPrefix: "other.com",
+			},
+			responseStatus: http.StatusOK,
+			responseBody:   responseBody,
+			expectedPath:   "/papi/v1/edgehostnames?contractId=contract&groupId=group",
+			expectedResponse: &GetEdgeHostnamesResponse{
+				AccountID:  "acc",
+				ContractID: "contract",
+				GroupID:    "group",
+				EdgeHostnames: EdgeHostnameItems{Items: []EdgeHostnameGetItem{
+					{
+						ID:                "ehID3",
+						Domain:            "other.com.edgesuite.net",
+						ProductID:         "otherPrdID",
+						DomainPrefix:      "other.com",
+						DomainSuffix:      "edgesuite.net",
+						IPVersionBehavior: "IPV4",
+					},
+				}},
+			},
+		},
+		"200 OK - multiple matches": {
+			params: GetEdgeHostnamesByProductAndPrefixRequest{
+				ContractID:   "contract",
+				GroupID:      "group",
+				Options:      []string{"opt1"},
+				ProductID:    "prdID",
+				DomainPrefix: "example.com",
+			},
+			responseStatus: http.StatusOK,
+			responseBody:   responseBody,
+			expectedPath:   "/papi/v1/edgehostnames?contractId=contract&groupId=group&options=opt1",
+			expectedResponse: &GetEdgeHostnamesResponse{
+				AccountID:  "acc",
+				ContractID: "contract",
+				GroupID:    "group",
+				EdgeHostnames: EdgeHostnameItems{Items: []EdgeHostnameGetItem{
+					{
+						ID:                "ehID1",
+						Domain:            "example.com.edgekey.net",
+						ProductID:         "prdID",
+						DomainPrefix:      "example.com",
+						DomainSuffix:      "edgekey.net",
+						Secure:            true,
+						IPVersionBehavior: "IPV4",
+					},
+					{
+						ID:                "ehID2",
+						Domain:            "example.com.edgesuite.net",
+						ProductID:         "prdID",
+						DomainPrefix:      "example.com",
+						DomainSuffix:      "edgesuite.net",
+						IPVersionBehavior: "IPV4",
+					},
+				}},
+			},
+		},
+		"no match": {
+			params: GetEdgeHostnamesByProductAndPrefixRequest{
+				ContractID:   "contract",
+				GroupID:      "group",
+				ProductID:    "prdID",
+				DomainPrefix: "other.com",
+			},
+			responseStatus: http.StatusOK,
+			responseBody:   responseBody,
+			expectedPath:   "/papi/v1/edgehostnames?contractId=contract&groupId=group",
+			withError: func(t *testing.T, err error) {
+				want := ErrNotFound
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+		"500 internal server error": {
+			params: GetEdgeHostnamesByProductAndPrefixRequest{
+				ContractID:   "contract",
+				GroupID:      "group",
+				ProductID:    "prdID",
+				DomainPrefix: "example.com",
+			},
+			responseStatus: http.StatusInternalServerError,
+			responseBody: `
+{
+	"type": "internal_error",
+    "title": "Internal Server Error",
+    "detail": "Error fetching edge hostnames",
+    "status": 500
+}`,
+			expectedPath: "/papi/v1/edgehostnames?contractId=contract&groupId=group",
+			withError: func(t *testing.T, err error) {
+				want := &Error{
+					Type:       "internal_error",
+					Title:      "Internal Server Error",
+					Detail:     "Error fetching edge hostnames",
+					StatusCode: http.StatusInternalServerError,
+				}
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+		"empty product ID": {
+			params: GetEdgeHostnamesByProductAndPrefixRequest{
+				ContractID:   "contract",
+				GroupID:      "group",
+				DomainPrefix: "example.com",
+			},
+			withError: func(t *testing.T, err error) {
+				want := ErrStructValidation
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+				assert.Contains(t, err.Error(), "ProductID")
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.GetEdgeHostnamesByProductAndPrefix(context.Background(), test.params)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
 func TestPapi_CreateEdgeHostname(t *testing.T) {
 	tests := map[string]struct {
 		params           CreateEdgeHostnameRequest
@@ -795,3 +984,545 @@ func TestPapi_CreateEdgeHostname(t *testing.T) {
 		})
 	}
 }
+
+func TestPapi_CreateAndGetEdgeHostname(t *testing.T) {
+	defaultDelay := followLinkDelay
+	followLinkDelay = time.Millisecond
+	defer func() { followLinkDelay = defaultDelay }()
+
+	params := CreateEdgeHostnameRequest{
+		ContractID: "contract",
+		GroupID:    "group",
+		EdgeHostname: EdgeHostnameCreate{
+			ProductID:         "product",
+			DomainPrefix:      "example.com",
+			DomainSuffix:      "edgesuite.net",
+			IPVersionBehavior: "IPV4",
+		},
+	}
+	createResponse := `
+{
+    "edgeHostnameLink": "/papi/v1/edgehostnames/ehID?contractId=contract&group=group"
+}`
+	getResponse := `
+{
+	"accountId": "acc",
+	"contractId": "contract",
+	"groupId": "group",
+	"edgeHostnames": {
+		"items": [
+			{
+				"edgeHostnameId": "ehID",
+				"edgeHostnameDomain": "example.com.edgesuite.net",
+				"productId": "product",
+				"domainPrefix": "example.com",
+				"domainSuffix": "edgesuite.net",
+				"status": "PENDING",
+				"secure": false,
+				"ipVersionBehavior": "IPV4"
+			}
+		]
+	}
+}`
+	notFoundResponse := `
+{
+	"type": "not_found",
+	"title": "Not Found",
+	"detail": "The system was unable to locate the requested resource",
+	"status": 404
+}`
+	expectedResponse := &GetEdgeHostnamesResponse{
+		AccountID:  "acc",
+		ContractID: "contract",
+		GroupID:    "group",
+		EdgeHostnames: EdgeHostnameItems{Items: []EdgeHostnameGetItem{
+			{
+				ID:                "ehID",
+				Domain:            "example.com.edgesuite.net",
+				ProductID:         "product",
+				DomainPrefix:      "example.com",
+				DomainSuffix:      "edgesuite.net",
+				Status:            "PENDING",
+				IPVersionBehavior: "IPV4",
+			},
+		}},
+		EdgeHostname: EdgeHostnameGetItem{
+			ID:                "ehID",
+			Domain:            "example.com.edgesuite.net",
+			ProductID:         "product",
+			DomainPrefix:      "example.com",
+			DomainSuffix:      "edgesuite.net",
+			Status:            "PENDING",
+			IPVersionBehavior: "IPV4",
+		},
+	}
+
+	tests := map[string]struct {
+		params           CreateEdgeHostnameRequest
+		createStatus     int
+		createBody       string
+		notFoundCount    int
+		expectedGetCalls int
+		expectedResponse *GetEdgeHostnamesResponse
+		withError        func(*testing.T, error)
+	}{
+		"201 Created, available immediately": {
+			params:           params,
+			createStatus:     http.StatusCreated,
+			createBody:       createResponse,
+			expectedGetCalls: 1,
+			expectedResponse: expectedResponse,
+		},
+		"201 Created, 404 Not Found then available": {
+			params:           params,
+			createStatus:     http.StatusCreated,
+			createBody:       createResponse,
+			notFoundCount:    2,
+			expectedGetCalls: 3,
+			expectedResponse: expectedResponse,
+		},
+		"201 Created, 404 Not Found on every attempt": {
+			params:           params,
+			createStatus:     http.StatusCreated,
+			createBody:       createResponse,
+			notFoundCount:    followLinkAttempts,
+			expectedGetCalls: followLinkAttempts,
+			withError: func(t *testing.T, err error) {
+				want := &Error{
+					Type:       "not_found",
+					Title:      "Not Found",
+					Detail:     "The system was unable to locate the requested resource",
+					StatusCode: http.StatusNotFound,
+				}
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+		"500 on create": {
+			params:       params,
+			createStatus: http.StatusInternalServerError,
+			createBody: `
+{
+	"type": "internal_error",
+	"title": "Internal Server Error",
+	"detail": "Error creating edge hostname",
+	"status": 500
+}`,
+			withError: func(t *testing.T, err error) {
+				want := &Error{
+					Type:       "internal_error",
+					Title:      "Internal Server Error",
+					Detail:     "Error creating edge hostname",
+					StatusCode: http.StatusInternalServerError,
+				}
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+		"validation error": {
+			params: CreateEdgeHostnameRequest{},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var getCalls int
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					assert.Equal(t, "/papi/v1/edgehostnames?contractId=contract&groupId=group", r.URL.String())
+					w.WriteHeader(test.createStatus)
+					_, err := w.Write([]byte(test.createBody))
+					assert.NoError(t, err)
+				case http.MethodGet:
+					assert.Equal(t, "/papi/v1/edgehostnames/ehID?contractId=contract&groupId=group", r.URL.String())
+					getCalls++
+					if getCalls <= test.notFoundCount {
+						w.WriteHeader(http.StatusNotFound)
+						_, err := w.Write([]byte(notFoundResponse))
+						assert.NoError(t, err)
+						return
+					}
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(getResponse))
+					assert.NoError(t, err)
+				default:
+					t.Fatalf("unexpected method: %s", r.Method)
+				}
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.CreateAndGetEdgeHostname(context.Background(), test.params)
+			assert.Equal(t, test.expectedGetCalls, getCalls)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestPapi_CreateEdgeHostnameIdempotencyKey(t *testing.T) {
+	tests := map[string]struct {
+		ctx         context.Context
+		expectedKey string
+	}{
+		"idempotency key set": {
+			ctx:         session.ContextWithIdempotencyKey(context.Background(), "5f2d1a4c"),
+			expectedKey: "5f2d1a4c",
+		},
+		"idempotency key not set": {
+			ctx: context.Background(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, test.expectedKey, r.Header.Get(session.IdempotencyKeyHeader))
+				w.WriteHeader(http.StatusCreated)
+				_, err := w.Write([]byte(`{"edgeHostnameLink": "/papi/v1/edgehostnames/ehID?contractId=contract&group=group"}`))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.CreateEdgeHostname(test.ctx, CreateEdgeHostnameRequest{
+				ContractID: "contract",
+				GroupID:    "group",
+				EdgeHostname: EdgeHostnameCreate{
+					ProductID:         "product",
+					DomainPrefix:      "example.com",
+					DomainSuffix:      "edgesuite.net",
+					IPVersionBehavior: "IPV4",
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "ehID", result.EdgeHostnameID)
+		})
+	}
+}
+
+func TestPapi_CreateEdgeHostnameIPVersionBehavior(t *testing.T) {
+	tests := map[string]struct {
+		ipVersionBehavior string
+		withError         error
+	}{
+		"IPv4": {
+			ipVersionBehavior: EHIPVersionV4,
+		},
+		"IPv6 performance": {
+			ipVersionBehavior: EHIPVersionV6Performance,
+		},
+		"IPv6 compliance": {
+			ipVersionBehavior: EHIPVersionV6Compliance,
+		},
+		"invalid behavior": {
+			ipVersionBehavior: "IPV6",
+			withError:         ErrStructValidation,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]interface{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, test.ipVersionBehavior, body["ipVersionBehavior"])
+				w.WriteHeader(http.StatusCreated)
+				_, err := w.Write([]byte(`{"edgeHostnameLink": "/papi/v1/edgehostnames/ehID?contractId=contract&group=group"}`))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			_, err := client.CreateEdgeHostname(context.Background(), CreateEdgeHostnameRequest{
+				ContractID: "contract",
+				GroupID:    "group",
+				EdgeHostname: EdgeHostnameCreate{
+					ProductID:         "product",
+					DomainPrefix:      "example.com",
+					DomainSuffix:      "edgesuite.net",
+					IPVersionBehavior: test.ipVersionBehavior,
+				},
+			})
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPapi_DeleteEdgeHostname(t *testing.T) {
+	tests := map[string]struct {
+		params           DeleteEdgeHostnameRequest
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedResponse *DeleteEdgeHostnameResponse
+		withError        func(*testing.T, error)
+	}{
+		"200 OK": {
+			params: DeleteEdgeHostnameRequest{
+				EdgeHostnameID: "ehID",
+				ContractID:     "contract",
+				GroupID:        "group",
+			},
+			responseStatus: http.StatusOK,
+			responseBody: `
+{
+    "message": "Deletion Successful."
+}`,
+			expectedPath:     "/papi/v1/edgehostnames/ehID?contractId=contract&groupId=group",
+			expectedResponse: &DeleteEdgeHostnameResponse{Message: "Deletion Successful."},
+		},
+		"500 internal server error": {
+			params: DeleteEdgeHostnameRequest{
+				EdgeHostnameID: "ehID",
+				ContractID:     "contract",
+				GroupID:        "group",
+			},
+			responseStatus: http.StatusInternalServerError,
+			responseBody: `
+{
+    "type": "internal_error",
+    "title": "Internal Server Error",
+    "detail": "Error deleting edge hostname",
+    "status": 500
+}`,
+			expectedPath: "/papi/v1/edgehostnames/ehID?contractId=contract&groupId=group",
+			withError: func(t *testing.T, err error) {
+				want := &Error{
+					Type:       "internal_error",
+					Title:      "Internal Server Error",
+					Detail:     "Error deleting edge hostname",
+					StatusCode: http.StatusInternalServerError,
+				}
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+		"empty edge hostname ID": {
+			params: DeleteEdgeHostnameRequest{
+				ContractID: "contract",
+				GroupID:    "group",
+			},
+			withError: func(t *testing.T, err error) {
+				want := ErrStructValidation
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+				assert.Contains(t, err.Error(), "EdgeHostnameID")
+			},
+		},
+		"empty contract ID": {
+			params: DeleteEdgeHostnameRequest{
+				EdgeHostnameID: "ehID",
+				GroupID:        "group",
+			},
+			withError: func(t *testing.T, err error) {
+				want := ErrStructValidation
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+				assert.Contains(t, err.Error(), "ContractID")
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, "true", r.Header.Get("PAPI-Use-Prefixes"))
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.DeleteEdgeHostname(context.Background(), test.params)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
+
+func TestPapi_PatchEdgeHostname(t *testing.T) {
+	tests := map[string]struct {
+		params           PatchEdgeHostnameRequest
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedBody     string
+		expectedResponse *PatchEdgeHostnameResponse
+		withError        func(*testing.T, error)
+	}{
+		"202 Accepted": {
+			params: PatchEdgeHostnameRequest{
+				EdgeHostnameID: "ehID",
+				ContractID:     "contract",
+				GroupID:        "group",
+				Body: []EdgeHostnamePatchOperation{
+					{Op: EHPatchOpReplace, Path: EHPatchPathTTL, Value: 600},
+					{Op: EHPatchOpReplace, Path: EHPatchPathUseCases, Value: []UseCase{{
+						Option:  "BACKGROUND",
+						Type:    "GLOBAL",
+						UseCase: "Download_Mode",
+					}}},
+				},
+			},
+			responseStatus: http.StatusAccepted,
+			responseBody: `
+{
+    "edgeHostnameLink": "/papi/v1/edgehostnames/ehID?contractId=contract&groupId=group"
+}`,
+			expectedPath: "/papi/v1/edgehostnames/ehID?contractId=contract&groupId=group",
+			expectedBody: `[{"op":"replace","path":"/ttl","value":600},{"op":"replace","path":"/useCases","value":[{"option":"BACKGROUND","type":"GLOBAL","useCase":"Download_Mode"}]}]`,
+			expectedResponse: &PatchEdgeHostnameResponse{
+				EdgeHostnameLink: "/papi/v1/edgehostnames/ehID?contractId=contract&groupId=group",
+				EdgeHostnameID:   "ehID",
+			},
+		},
+		"202 Accepted - remove use cases": {
+			params: PatchEdgeHostnameRequest{
+				EdgeHostnameID: "ehID",
+				ContractID:     "contract",
+				GroupID:        "group",
+				Body:           []EdgeHostnamePatchOperation{{Op: EHPatchOpRemove, Path: EHPatchPathUseCases}},
+			},
+			responseStatus: http.StatusAccepted,
+			responseBody: `
+{
+    "edgeHostnameLink": "/papi/v1/edgehostnames/ehID?contractId=contract&groupId=group"
+}`,
+			expectedPath: "/papi/v1/edgehostnames/ehID?contractId=contract&groupId=group",
+			expectedBody: `[{"op":"remove","path":"/useCases"}]`,
+			expectedResponse: &PatchEdgeHostnameResponse{
+				EdgeHostnameLink: "/papi/v1/edgehostnames/ehID?contractId=contract&groupId=group",
+				EdgeHostnameID:   "ehID",
+			},
+		},
+		"500 internal server error": {
+			params: PatchEdgeHostnameRequest{
+				EdgeHostnameID: "ehID",
+				ContractID:     "contract",
+				GroupID:        "group",
+				Body:           []EdgeHostnamePatchOperation{{Op: EHPatchOpReplace, Path: EHPatchPathTTL, Value: 600}},
+			},
+			responseStatus: http.StatusInternalServerError,
+			responseBody: `
+{
+    "type": "internal_error",
+    "title": "Internal Server Error",
+    "detail": "Error updating edge hostname",
+    "status": 500
+}`,
+			expectedPath: "/papi/v1/edgehostnames/ehID?contractId=contract&groupId=group",
+			expectedBody: `[{"op":"replace","path":"/ttl","value":600}]`,
+			withError: func(t *testing.T, err error) {
+				want := &Error{
+					Type:       "internal_error",
+					Title:      "Internal Server Error",
+					Detail:     "Error updating edge hostname",
+					StatusCode: http.StatusInternalServerError,
+				}
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+		"invalid response link": {
+			params: PatchEdgeHostnameRequest{
+				EdgeHostnameID: "ehID",
+				ContractID:     "contract",
+				GroupID:        "group",
+				Body:           []EdgeHostnamePatchOperation{{Op: EHPatchOpReplace, Path: EHPatchPathTTL, Value: 600}},
+			},
+			responseStatus: http.StatusAccepted,
+			responseBody: `
+{
+    "edgeHostnameLink": ":"
+}`,
+			expectedPath: "/papi/v1/edgehostnames/ehID?contractId=contract&groupId=group",
+			expectedBody: `[{"op":"replace","path":"/ttl","value":600}]`,
+			withError: func(t *testing.T, err error) {
+				want := ErrInvalidResponseLink
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+		"empty edge hostname ID": {
+			params: PatchEdgeHostnameRequest{
+				ContractID: "contract",
+				GroupID:    "group",
+				Body:       []EdgeHostnamePatchOperation{{Op: EHPatchOpReplace, Path: EHPatchPathTTL, Value: 600}},
+			},
+			withError: func(t *testing.T, err error) {
+				want := ErrStructValidation
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+				assert.Contains(t, err.Error(), "EdgeHostnameID")
+			},
+		},
+		"empty body": {
+			params: PatchEdgeHostnameRequest{
+				EdgeHostnameID: "ehID",
+				ContractID:     "contract",
+				GroupID:        "group",
+			},
+			withError: func(t *testing.T, err error) {
+				want := ErrStructValidation
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+				assert.Contains(t, err.Error(), "Body")
+			},
+		},
+		"invalid path": {
+			params: PatchEdgeHostnameRequest{
+				EdgeHostnameID: "ehID",
+				ContractID:     "contract",
+				GroupID:        "group",
+				Body:           []EdgeHostnamePatchOperation{{Op: EHPatchOpReplace, Path: "/ipVersionBehavior", Value: "IPV4"}},
+			},
+			withError: func(t *testing.T, err error) {
+				want := ErrStructValidation
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+				assert.Contains(t, err.Error(), "Path")
+			},
+		},
+		"missing value": {
+			params: PatchEdgeHostnameRequest{
+				EdgeHostnameID: "ehID",
+				ContractID:     "contract",
+				GroupID:        "group",
+				Body:           []EdgeHostnamePatchOperation{{Op: EHPatchOpReplace, Path: EHPatchPathTTL}},
+			},
+			withError: func(t *testing.T, err error) {
+				want := ErrStructValidation
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+				assert.Contains(t, err.Error(), "Value")
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodPatch, r.Method)
+				assert.Equal(t, "application/json-patch+json", r.Header.Get("Content-Type"))
+				assert.Equal(t, "true", r.Header.Get("PAPI-Use-Prefixes"))
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.JSONEq(t, test.expectedBody, string(body))
+				w.WriteHeader(test.responseStatus)
+				_, err = w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.PatchEdgeHostname(context.Background(), test.params)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
@@ -53,6 +53,12 @@ func (p *Mock) GetGroups(ctx context.Context) (*GetGroupsResponse, error) {
 	return args.Get(0).(*GetGroupsResponse), args.Error(1)
 }
 
+func (p *Mock) CheckAPIVersion(ctx context.Context) error {
+	args := p.Called(ctx)
+
+	return args.Error(0)
+}
+
 func (p *Mock) GetContracts(ctx context.Context) (*GetContractsResponse, error) {
 	args := p.Called(ctx)
 
@@ -269,6 +275,16 @@ func (p *Mock) GetEdgeHostname(ctx context.Context, r GetEdgeHostnameRequest) (*
 	return args.Get(0).(*GetEdgeHostnamesResponse), args.Error(1)
 }
 
+func (p *Mock) GetEdgeHostnamesByProductAndPrefix(ctx context.Context, r GetEdgeHostnamesByProductAndPrefixRequest) (*GetEdgeHostnamesResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*GetEdgeHostnamesResponse), args.Error(1)
+}
+
 func (p *Mock) CreateEdgeHostname(ctx context.Context, r CreateEdgeHostnameRequest) (*CreateEdgeHostnameResponse, error) {
 	args := p.Called(ctx, r)
 
@@ -279,6 +295,36 @@ func (p *Mock) CreateEdgeHostname(ctx context.Context, r CreateEdgeHostnameReque
 	return args.Get(0).(*CreateEdgeHostnameResponse), args.Error(1)
 }
 
+func (p *Mock) CreateAndGetEdgeHostname(ctx context.Context, r CreateEdgeHostnameRequest) (*GetEdgeHostnamesResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*GetEdgeHostnamesResponse), args.Error(1)
+}
+
+func (p *Mock) DeleteEdgeHostname(ctx context.Context, r DeleteEdgeHostnameRequest) (*DeleteEdgeHostnameResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*DeleteEdgeHostnameResponse), args.Error(1)
+}
+
+func (p *Mock) PatchEdgeHostname(ctx context.Context, r PatchEdgeHostnameRequest) (*PatchEdgeHostnameResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*PatchEdgeHostnameResponse), args.Error(1)
+}
+
 func (p *Mock) GetProducts(ctx context.Context, r GetProductsRequest) (*GetProductsResponse, error) {
 	args := p.Called(ctx, r)
 
@@ -299,6 +345,16 @@ func (p *Mock) SearchProperties(ctx context.Context, r SearchRequest) (*SearchRe
 	return args.Get(0).(*SearchResponse), args.Error(1)
 }
 
+func (p *Mock) SearchEdgeHostnames(ctx context.Context, r SearchEdgeHostnamesRequest) (*SearchEdgeHostnamesResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*SearchEdgeHostnamesResponse), args.Error(1)
+}
+
 func (p *Mock) GetPropertyVersionHostnames(ctx context.Context, r GetPropertyVersionHostnamesRequest) (*GetPropertyVersionHostnamesResponse, error) {
 	args := p.Called(ctx, r)
 
@@ -355,6 +411,16 @@ func (p *Mock) UpdateRuleTree(ctx context.Context, r UpdateRulesRequest) (*Updat
 	return args.Get(0).(*UpdateRulesResponse), args.Error(1)
 }
 
+func (p *Mock) PatchPropertyRules(ctx context.Context, r PatchPropertyRulesRequest) (*UpdateRulesResponse, error) {
+	args := p.Called(ctx, r)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*UpdateRulesResponse), args.Error(1)
+}
+
 func (p *Mock) GetRuleFormats(ctx context.Context) (*GetRuleFormatsResponse, error) {
 	args := p.Called(ctx)
 
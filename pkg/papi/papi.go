@@ -2,7 +2,9 @@
 package papi
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/session"
@@ -24,8 +26,14 @@ var (
 
 	// ErrMissingComplianceRecord is returned when compliance record is required and is not provided
 	ErrMissingComplianceRecord = errors.New("compliance record must be specified")
+
+	// ErrCheckAPIVersion represents error when checking API version fails
+	ErrCheckAPIVersion = errors.New("checking API version")
 )
 
+// APIVersion is the version of the PAPI API used by the client, as in /papi/v1 paths
+const APIVersion = "v1"
+
 type (
 	// PAPI is the papi api interface
 	PAPI interface {
@@ -46,6 +54,12 @@ type (
 		PropertyVersions
 		RuleFormats
 		Search
+
+		// CheckAPIVersion verifies that APIVersion of the PAPI API is available for the account.
+		// If it is not, the returned error wraps *session.APIVersionError.
+		//
+		// See: https://techdocs.akamai.com/property-mgr/reference/get-contracts
+		CheckAPIVersion(context.Context) error
 	}
 
 	papi struct {
@@ -83,6 +97,14 @@ func Client(sess session.Session, opts ...Option) PAPI {
 	return p
 }
 
+// WithDefaultHeaders adds the headers to every request made by the client, e.g. a static team identifier.
+// Headers set by the client methods and with session.WithContextHeaders take precedence, see session.WithDefaultHeaders.
+func WithDefaultHeaders(h http.Header) Option {
+	return func(c *papi) {
+		c.Session = session.WithDefaultHeaders(c.Session, h)
+	}
+}
+
 // WithUsePrefixes sets the `PAPI-Use-Prefixes` header on requests
 // See: https://techdocs.akamai.com/property-mgr/reference/id-prefixes
 func WithUsePrefixes(usePrefixes bool) Option {
@@ -91,6 +113,18 @@ func WithUsePrefixes(usePrefixes bool) Option {
 	}
 }
 
+func (p *papi) CheckAPIVersion(ctx context.Context) error {
+	logger := p.Log(ctx)
+	logger.Debug("CheckAPIVersion")
+
+	checkURL := fmt.Sprintf("/papi/%s/contracts", APIVersion)
+	if err := session.CheckAPIVersion(ctx, p, "papi", APIVersion, checkURL); err != nil {
+		return fmt.Errorf("%s: %w", ErrCheckAPIVersion, err)
+	}
+
+	return nil
+}
+
 // Exec overrides the session.Exec to add papi options
 func (p *papi) Exec(r *http.Request, out interface{}, in ...interface{}) (*http.Response, error) {
 	// explicitly add the PAPI-Use-Prefixes header
@@ -1,8 +1,10 @@
 package papi
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -60,3 +62,43 @@ func TestClient(t *testing.T) {
 		})
 	}
 }
+
+func TestPapi_CheckAPIVersion(t *testing.T) {
+	tests := map[string]struct {
+		responseStatus int
+		responseBody   string
+		withError      error
+	}{
+		"200 OK - compatible": {
+			responseStatus: http.StatusOK,
+			responseBody:   `{"accountId": "act_1-1TJZFB", "contracts": {"items": []}}`,
+		},
+		"404 not found - incompatible": {
+			responseStatus: http.StatusNotFound,
+			responseBody:   `{"type": "not_found", "title": "Not Found", "status": 404}`,
+			withError:      session.ErrUnsupportedAPIVersion,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/papi/v1/contracts", r.URL.String())
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			err := client.CheckAPIVersion(context.Background())
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				var versionErr *session.APIVersionError
+				require.True(t, errors.As(err, &versionErr))
+				assert.Equal(t, session.APIVersionError{API: "papi", Version: APIVersion, StatusCode: http.StatusNotFound}, *versionErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
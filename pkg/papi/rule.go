@@ -23,6 +23,12 @@ type (
 		//
 		// See: https://techdocs.akamai.com/property-mgr/reference/put-property-version-rules
 		UpdateRuleTree(context.Context, UpdateRulesRequest) (*UpdateRulesResponse, error)
+
+		// PatchPropertyRules applies JSON Patch operations to the rule tree for a property version,
+		// e.g. to change options of a single behavior without sending the entire rule tree
+		//
+		// See: https://techdocs.akamai.com/property-mgr/reference/patch-property-version-rules
+		PatchPropertyRules(context.Context, PatchPropertyRulesRequest) (*UpdateRulesResponse, error)
 	}
 
 	// GetRuleTreeRequest contains path and query params necessary to perform GET /rules request
@@ -106,6 +112,25 @@ type (
 		Rules           RulesUpdate
 	}
 
+	// PatchPropertyRulesRequest contains path and query params, and the patch operations necessary to perform PATCH /rules request
+	PatchPropertyRulesRequest struct {
+		PropertyID      string
+		PropertyVersion int
+		ContractID      string
+		GroupID         string
+		DryRun          bool
+		ValidateRules   bool
+		Body            []RulePatchOperation
+	}
+
+	// RulePatchOperation represents a single JSON Patch operation on the rule tree, e.g. replace of "/rules/behaviors/0/options/enabled"
+	RulePatchOperation struct {
+		Op    string      `json:"op"`
+		Path  string      `json:"path"`
+		From  string      `json:"from,omitempty"`
+		Value interface{} `json:"value,omitempty"`
+	}
+
 	// RulesUpdate is a wrapper for the request body of PUT /rules request
 	RulesUpdate struct {
 		Comments string `json:"comments,omitempty"`
@@ -164,6 +189,19 @@ const (
 	RuleCriteriaMustSatisfyAll RuleCriteriaMustSatisfy = "all"
 	//RuleCriteriaMustSatisfyAny const
 	RuleCriteriaMustSatisfyAny RuleCriteriaMustSatisfy = "any"
+
+	// RulePatchOpAdd const
+	RulePatchOpAdd = "add"
+	// RulePatchOpRemove const
+	RulePatchOpRemove = "remove"
+	// RulePatchOpReplace const
+	RulePatchOpReplace = "replace"
+	// RulePatchOpMove const
+	RulePatchOpMove = "move"
+	// RulePatchOpCopy const
+	RulePatchOpCopy = "copy"
+	// RulePatchOpTest const
+	RulePatchOpTest = "test"
 )
 
 var validRuleFormat = regexp.MustCompile("^(latest|v\\d{4}-\\d{2}-\\d{2})$")
@@ -189,6 +227,29 @@ func (r UpdateRulesRequest) Validate() error {
 	return edgegriderr.ParseValidationErrors(errs)
 }
 
+// Validate validates PatchPropertyRulesRequest struct
+func (r PatchPropertyRulesRequest) Validate() error {
+	return edgegriderr.ParseValidationErrors(validation.Errors{
+		"PropertyID":      validation.Validate(r.PropertyID, validation.Required),
+		"PropertyVersion": validation.Validate(r.PropertyVersion, validation.Required),
+		"Body":            validation.Validate(r.Body, validation.Required),
+	})
+}
+
+// Validate validates RulePatchOperation struct
+func (op RulePatchOperation) Validate() error {
+	fromRequired := op.Op == RulePatchOpMove || op.Op == RulePatchOpCopy
+	return validation.Errors{
+		"Op": validation.Validate(op.Op, validation.Required, validation.In(RulePatchOpAdd, RulePatchOpRemove,
+			RulePatchOpReplace, RulePatchOpMove, RulePatchOpCopy, RulePatchOpTest)),
+		"Path": validation.Validate(op.Path, validation.Required),
+		"From": validation.Validate(op.From, validation.Required.When(fromRequired), validation.Empty.When(!fromRequired)),
+		// value may be false or 0, so only its presence is checked
+		"Value": validation.Validate(op.Value, validation.When(op.Op == RulePatchOpAdd || op.Op == RulePatchOpReplace || op.Op == RulePatchOpTest,
+			validation.NotNil)),
+	}.Filter()
+}
+
 // Validate validates RulesUpdate struct
 func (r RulesUpdate) Validate() error {
 	return validation.Errors{
@@ -239,6 +300,8 @@ var (
 	ErrGetRuleTree = errors.New("fetching rule tree")
 	// ErrUpdateRuleTree represents error when updating rule tree fails
 	ErrUpdateRuleTree = errors.New("updating rule tree")
+	// ErrPatchPropertyRules represents error when patching rule tree fails
+	ErrPatchPropertyRules = errors.New("patching rule tree")
 )
 
 func (p *papi) GetRuleTree(ctx context.Context, params GetRuleTreeRequest) (*GetRuleTreeResponse, error) {
@@ -324,3 +387,42 @@ func (p *papi) UpdateRuleTree(ctx context.Context, request UpdateRulesRequest) (
 
 	return &versions, nil
 }
+
+func (p *papi) PatchPropertyRules(ctx context.Context, request PatchPropertyRulesRequest) (*UpdateRulesResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w:\n%s", ErrPatchPropertyRules, ErrStructValidation, err)
+	}
+
+	logger := p.Log(ctx)
+	logger.Debug("PatchPropertyRules")
+
+	patchURL := fmt.Sprintf(
+		"/papi/v1/properties/%s/versions/%d/rules?contractId=%s&groupId=%s",
+		request.PropertyID,
+		request.PropertyVersion,
+		request.ContractID,
+		request.GroupID,
+	)
+	if !request.ValidateRules {
+		patchURL += fmt.Sprintf("&validateRules=%t", request.ValidateRules)
+	}
+	if request.DryRun {
+		patchURL += fmt.Sprintf("&dryRun=%t", request.DryRun)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, patchURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrPatchPropertyRules, err)
+	}
+	req.Header.Set("Content-Type", "application/json-patch+json")
+
+	var rules UpdateRulesResponse
+	resp, err := p.Exec(req, &rules, request.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrPatchPropertyRules, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrPatchPropertyRules, p.Error(resp))
+	}
+
+	return &rules, nil
+}
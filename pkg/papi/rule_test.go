@@ -2018,3 +2018,172 @@ func TestPapi_UpdateRuleTree(t *testing.T) {
 		})
 	}
 }
+
+func TestPapi_PatchPropertyRules(t *testing.T) {
+	tests := map[string]struct {
+		params           PatchPropertyRulesRequest
+		requestBody      string
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedResponse *UpdateRulesResponse
+		withError        func(*testing.T, error)
+	}{
+		"200 OK": {
+			params: PatchPropertyRulesRequest{
+				PropertyID:      "propertyID",
+				PropertyVersion: 2,
+				ContractID:      "contract",
+				GroupID:         "group",
+				DryRun:          true,
+				ValidateRules:   true,
+				Body: []RulePatchOperation{
+					{Op: RulePatchOpReplace, Path: "/rules/behaviors/0/options/compress", Value: false},
+					{Op: RulePatchOpRemove, Path: "/rules/children/0"},
+				},
+			},
+			requestBody:    `[{"op":"replace","path":"/rules/behaviors/0/options/compress","value":false},{"op":"remove","path":"/rules/children/0"}]`,
+			responseStatus: http.StatusOK,
+			responseBody: `
+{
+    "accountId": "accountID",
+    "contractId": "contract",
+    "groupId": "group",
+    "propertyId": "propertyID",
+    "propertyVersion": 2,
+    "etag": "etag",
+    "ruleFormat": "v2020-09-16",
+    "rules": {
+        "name": "default",
+        "behaviors": [
+            {
+                "name": "origin",
+                "options": {
+                    "compress": false
+                }
+            }
+        ]
+    },
+    "warnings": [
+        {
+            "title": "Unstable rule format",
+            "type": "https://problems.luna.akamaiapis.net/papi/v0/unstable_rule_format",
+            "currentRuleFormat": "latest",
+            "suggestedRuleFormat": "v2020-09-16"
+        }
+    ]
+}`,
+			expectedPath: "/papi/v1/properties/propertyID/versions/2/rules?contractId=contract&dryRun=true&groupId=group",
+			expectedResponse: &UpdateRulesResponse{
+				AccountID:       "accountID",
+				ContractID:      "contract",
+				GroupID:         "group",
+				PropertyID:      "propertyID",
+				PropertyVersion: 2,
+				Etag:            "etag",
+				RuleFormat:      "v2020-09-16",
+				Rules: Rules{
+					Name: "default",
+					Behaviors: []RuleBehavior{
+						{
+							Name:    "origin",
+							Options: RuleOptionsMap{"compress": false},
+						},
+					},
+				},
+				Warnings: []RuleWarnings{
+					{
+						Title:               "Unstable rule format",
+						Type:                "https://problems.luna.akamaiapis.net/papi/v0/unstable_rule_format",
+						CurrentRuleFormat:   "latest",
+						SuggestedRuleFormat: "v2020-09-16",
+					},
+				},
+			},
+		},
+		"500 internal server error": {
+			params: PatchPropertyRulesRequest{
+				PropertyID:      "propertyID",
+				PropertyVersion: 2,
+				ContractID:      "contract",
+				GroupID:         "group",
+				Body:            []RulePatchOperation{{Op: RulePatchOpAdd, Path: "/rules/comments", Value: "comment"}},
+			},
+			responseStatus: http.StatusInternalServerError,
+			responseBody: `
+{
+	"type": "internal_error",
+    "title": "Internal Server Error",
+    "detail": "Error patching rule tree",
+    "status": 500
+}`,
+			expectedPath: "/papi/v1/properties/propertyID/versions/2/rules?contractId=contract&groupId=group&validateRules=false",
+			withError: func(t *testing.T, err error) {
+				want := &Error{
+					Type:       "internal_error",
+					Title:      "Internal Server Error",
+					Detail:     "Error patching rule tree",
+					StatusCode: http.StatusInternalServerError,
+				}
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+		"empty body": {
+			params: PatchPropertyRulesRequest{
+				PropertyID:      "propertyID",
+				PropertyVersion: 2,
+			},
+			withError: func(t *testing.T, err error) {
+				want := ErrStructValidation
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+				assert.Contains(t, err.Error(), "Body")
+			},
+		},
+		"invalid operation": {
+			params: PatchPropertyRulesRequest{
+				PropertyID:      "propertyID",
+				PropertyVersion: 2,
+				Body: []RulePatchOperation{
+					{Op: "update", Path: "/rules/comments", Value: "comment"},
+					{Op: RulePatchOpMove, Path: "/rules/children/1"},
+					{Op: RulePatchOpReplace, Path: "/rules/comments"},
+				},
+			},
+			withError: func(t *testing.T, err error) {
+				want := ErrStructValidation
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+				assert.Contains(t, err.Error(), "Op")
+				assert.Contains(t, err.Error(), "From")
+				assert.Contains(t, err.Error(), "Value")
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodPatch, r.Method)
+				assert.Equal(t, "application/json-patch+json", r.Header.Get("Content-Type"))
+				assert.Equal(t, "true", r.Header.Get("PAPI-Use-Prefixes"))
+				if test.requestBody != "" {
+					buf := new(bytes.Buffer)
+					_, err := buf.ReadFrom(r.Body)
+					assert.NoError(t, err)
+					assert.JSONEq(t, test.requestBody, buf.String())
+				}
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.PatchPropertyRules(context.Background(), test.params)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
 )
 
 type (
@@ -16,6 +17,12 @@ type (
 		//
 		// See: https://techdocs.akamai.com/property-mgr/reference/post-search-find-by-value
 		SearchProperties(context.Context, SearchRequest) (*SearchResponse, error)
+
+		// SearchEdgeHostnames searches for an edge hostname across all contracts and groups and returns
+		// the contracts and groups in which it is used by active properties
+		//
+		// See: https://techdocs.akamai.com/property-mgr/reference/post-search-find-by-value
+		SearchEdgeHostnames(context.Context, SearchEdgeHostnamesRequest) (*SearchEdgeHostnamesResponse, error)
 	}
 
 	// SearchResponse contains response body of POST /search request
@@ -45,6 +52,26 @@ type (
 		UpdatedDate      string `json:"updatedDate"`
 	}
 
+	// SearchEdgeHostnamesRequest contains the edge hostname to search for, e.g. www.example.com.edgesuite.net
+	SearchEdgeHostnamesRequest struct {
+		EdgeHostname string
+	}
+
+	// SearchEdgeHostnamesResponse contains results of SearchEdgeHostnames
+	SearchEdgeHostnamesResponse struct {
+		Items []EdgeHostnameSearchItem
+	}
+
+	// EdgeHostnameSearchItem describes usage of an edge hostname within a single contract and group
+	EdgeHostnameSearchItem struct {
+		EdgeHostname string
+		AccountID    string
+		ContractID   string
+		GroupID      string
+		PropertyIDs  []string
+		Hostnames    []string
+	}
+
 	// SearchRequest contains key-value pair for search request
 	// Key must have one of three values: "edgeHostname", "hostname" or "propertyName"
 	SearchRequest struct {
@@ -72,9 +99,18 @@ func (s SearchRequest) Validate() error {
 	}.Filter()
 }
 
+// Validate validates SearchEdgeHostnamesRequest struct
+func (s SearchEdgeHostnamesRequest) Validate() error {
+	return validation.Errors{
+		"EdgeHostname": validation.Validate(s.EdgeHostname, validation.Required, is.DNSName),
+	}.Filter()
+}
+
 var (
 	// ErrSearchProperties represents error when searching for properties fails
 	ErrSearchProperties = errors.New("searching for properties")
+	// ErrSearchEdgeHostnames represents error when searching for edge hostnames fails
+	ErrSearchEdgeHostnames = errors.New("searching for edge hostnames")
 )
 
 func (p *papi) SearchProperties(ctx context.Context, request SearchRequest) (*SearchResponse, error) {
@@ -103,3 +139,52 @@ func (p *papi) SearchProperties(ctx context.Context, request SearchRequest) (*Se
 
 	return &search, nil
 }
+
+func (p *papi) SearchEdgeHostnames(ctx context.Context, request SearchEdgeHostnamesRequest) (*SearchEdgeHostnamesResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrSearchEdgeHostnames, ErrStructValidation, err)
+	}
+
+	logger := p.Log(ctx)
+	logger.Debug("SearchEdgeHostnames")
+
+	search, err := p.SearchProperties(ctx, SearchRequest{Key: SearchKeyEdgeHostname, Value: request.EdgeHostname})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrSearchEdgeHostnames, err)
+	}
+
+	result := SearchEdgeHostnamesResponse{Items: make([]EdgeHostnameSearchItem, 0)}
+	// search returns a single item for every matching property version, group them by contract and group
+	itemIndexes := make(map[[3]string]int)
+	for _, version := range search.Versions.Items {
+		key := [3]string{version.EdgeHostname, version.ContractID, version.GroupID}
+		i, ok := itemIndexes[key]
+		if !ok {
+			i = len(result.Items)
+			itemIndexes[key] = i
+			result.Items = append(result.Items, EdgeHostnameSearchItem{
+				EdgeHostname: version.EdgeHostname,
+				AccountID:    version.AccountID,
+				ContractID:   version.ContractID,
+				GroupID:      version.GroupID,
+			})
+		}
+		item := &result.Items[i]
+		item.PropertyIDs = appendUnique(item.PropertyIDs, version.PropertyID)
+		item.Hostnames = appendUnique(item.Hostnames, version.Hostname)
+	}
+
+	return &result, nil
+}
+
+func appendUnique(values []string, value string) []string {
+	if value == "" {
+		return values
+	}
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -190,3 +190,177 @@ func TestPapi_SearchProperties(t *testing.T) {
 		})
 	}
 }
+
+func TestPapi_SearchEdgeHostnames(t *testing.T) {
+	tests := map[string]struct {
+		params           SearchEdgeHostnamesRequest
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedRequest  string
+		expectedResponse *SearchEdgeHostnamesResponse
+		withError        func(*testing.T, error)
+	}{
+		"200 OK - match": {
+			params: SearchEdgeHostnamesRequest{
+				EdgeHostname: "www.example.com.edgesuite.net",
+			},
+			responseStatus: http.StatusOK,
+			responseBody: `
+{
+    "versions": {
+        "items": [
+            {
+                "accountId": "act_1-1TJZFB",
+                "contractId": "ctr_1-1TJZH5",
+                "edgeHostname": "www.example.com.edgesuite.net",
+                "groupId": "grp_15225",
+                "hostname": "www.example.com",
+                "productionStatus": "ACTIVE",
+                "propertyId": "prp_173136",
+                "propertyName": "example.com",
+                "propertyVersion": 3,
+                "stagingStatus": "INACTIVE"
+            },
+            {
+                "accountId": "act_1-1TJZFB",
+                "contractId": "ctr_1-1TJZH5",
+                "edgeHostname": "www.example.com.edgesuite.net",
+                "groupId": "grp_15225",
+                "hostname": "www.example.com",
+                "productionStatus": "INACTIVE",
+                "propertyId": "prp_173136",
+                "propertyName": "example.com",
+                "propertyVersion": 4,
+                "stagingStatus": "ACTIVE"
+            },
+            {
+                "accountId": "act_1-1TJZFB",
+                "contractId": "ctr_1-2ABCD",
+                "edgeHostname": "www.example.com.edgesuite.net",
+                "groupId": "grp_16000",
+                "hostname": "m.example.com",
+                "productionStatus": "ACTIVE",
+                "propertyId": "prp_200000",
+                "propertyName": "m.example.com",
+                "propertyVersion": 1,
+                "stagingStatus": "ACTIVE"
+            }
+        ]
+    }
+}`,
+			expectedRequest: `
+{
+	"edgeHostname": "www.example.com.edgesuite.net"
+}`,
+			expectedPath: "/papi/v1/search/find-by-value",
+			expectedResponse: &SearchEdgeHostnamesResponse{
+				Items: []EdgeHostnameSearchItem{
+					{
+						EdgeHostname: "www.example.com.edgesuite.net",
+						AccountID:    "act_1-1TJZFB",
+						ContractID:   "ctr_1-1TJZH5",
+						GroupID:      "grp_15225",
+						PropertyIDs:  []string{"prp_173136"},
+						Hostnames:    []string{"www.example.com"},
+					},
+					{
+						EdgeHostname: "www.example.com.edgesuite.net",
+						AccountID:    "act_1-1TJZFB",
+						ContractID:   "ctr_1-2ABCD",
+						GroupID:      "grp_16000",
+						PropertyIDs:  []string{"prp_200000"},
+						Hostnames:    []string{"m.example.com"},
+					},
+				},
+			},
+		},
+		"200 OK - empty search": {
+			params: SearchEdgeHostnamesRequest{
+				EdgeHostname: "unused.example.com.edgesuite.net",
+			},
+			responseStatus: http.StatusOK,
+			responseBody: `
+{
+    "versions": {
+        "items": []
+    }
+}`,
+			expectedRequest: `
+{
+	"edgeHostname": "unused.example.com.edgesuite.net"
+}`,
+			expectedPath:     "/papi/v1/search/find-by-value",
+			expectedResponse: &SearchEdgeHostnamesResponse{Items: []EdgeHostnameSearchItem{}},
+		},
+		"500 Internal Server Error": {
+			params: SearchEdgeHostnamesRequest{
+				EdgeHostname: "www.example.com.edgesuite.net",
+			},
+			responseStatus: http.StatusInternalServerError,
+			responseBody: `
+{
+	"type": "internal_error",
+    "title": "Internal Server Error",
+    "detail": "Error searching for property",
+    "status": 500
+}`,
+			expectedRequest: `
+{
+	"edgeHostname": "www.example.com.edgesuite.net"
+}`,
+			expectedPath: "/papi/v1/search/find-by-value",
+			withError: func(t *testing.T, err error) {
+				want := &Error{
+					Type:       "internal_error",
+					Title:      "Internal Server Error",
+					Detail:     "Error searching for property",
+					StatusCode: http.StatusInternalServerError,
+				}
+				assert.True(t, errors.Is(err, want), "want: %s; got: %s", want, err)
+			},
+		},
+		"empty edge hostname": {
+			params: SearchEdgeHostnamesRequest{},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				assert.Contains(t, err.Error(), "EdgeHostname")
+			},
+		},
+		"invalid edge hostname": {
+			params: SearchEdgeHostnamesRequest{
+				EdgeHostname: "www example com",
+			},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation), "want: %s; got: %s", ErrStructValidation, err)
+				assert.Contains(t, err.Error(), "EdgeHostname")
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodPost, r.Method)
+				body, err := ioutil.ReadAll(r.Body)
+				require.NoError(t, err)
+				var compact bytes.Buffer
+				err = json.Compact(&compact, []byte(test.expectedRequest))
+				require.NoError(t, err)
+				assert.Equal(t, compact.String(), string(body))
+				w.WriteHeader(test.responseStatus)
+				_, err = w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			client := mockAPIClient(t, mockServer)
+			result, err := client.SearchEdgeHostnames(context.Background(), test.params)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
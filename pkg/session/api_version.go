@@ -0,0 +1,62 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrUnsupportedAPIVersion is returned when the API version used by a client is not supported for the account
+var ErrUnsupportedAPIVersion = errors.New("unsupported API version")
+
+// APIVersionError is returned by CheckAPIVersion when the API version used by a client is not supported for the account
+type APIVersionError struct {
+	// API is the name of the API, e.g. "config-gtm"
+	API string
+	// Version is the API version used by the client, e.g. "v1"
+	Version string
+	// StatusCode is the status of the response to the version check
+	StatusCode int
+}
+
+// Error returns the error message
+func (e *APIVersionError) Error() string {
+	return fmt.Sprintf("%s: %s %s (status %d)", ErrUnsupportedAPIVersion, e.API, e.Version, e.StatusCode)
+}
+
+// Is allows the error to be matched with ErrUnsupportedAPIVersion
+func (e *APIVersionError) Is(target error) bool {
+	return target == ErrUnsupportedAPIVersion
+}
+
+// CheckAPIVersion verifies that the given version of the API is available for the account by sending
+// a GET request to url, which should be a lightweight endpoint of that version.
+//
+// A successful response means the version is supported. Statuses 404 and 410 indicate that the versioned path
+// is not provisioned for the account and result in *APIVersionError; other errors are parsed by client.Error.
+func CheckAPIVersion(ctx context.Context, client APIClient, api, version, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %s", err)
+	}
+
+	resp, err := client.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer func() {
+		if resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+	}()
+
+	switch {
+	case resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices:
+		return nil
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return &APIVersionError{API: api, Version: version, StatusCode: resp.StatusCode}
+	default:
+		return client.Error(resp)
+	}
+}
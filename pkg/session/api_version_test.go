@@ -0,0 +1,62 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tj/assert"
+)
+
+func TestCheckAPIVersion(t *testing.T) {
+	tests := map[string]struct {
+		responseStatus int
+		withError      func(*testing.T, error)
+	}{
+		"200 OK - supported": {
+			responseStatus: http.StatusOK,
+		},
+		"404 not found - unsupported": {
+			responseStatus: http.StatusNotFound,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrUnsupportedAPIVersion), "want: %s; got: %s", ErrUnsupportedAPIVersion, err)
+				var versionErr *APIVersionError
+				require.True(t, errors.As(err, &versionErr))
+				assert.Equal(t, APIVersionError{API: "test", Version: "v2", StatusCode: http.StatusNotFound}, *versionErr)
+				assert.Equal(t, "unsupported API version: test v2 (status 404)", err.Error())
+			},
+		},
+		"410 gone - unsupported": {
+			responseStatus: http.StatusGone,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrUnsupportedAPIVersion), "want: %s; got: %s", ErrUnsupportedAPIVersion, err)
+			},
+		},
+		"500 internal server error": {
+			responseStatus: http.StatusInternalServerError,
+			withError: func(t *testing.T, err error) {
+				assert.False(t, errors.Is(err, ErrUnsupportedAPIVersion))
+				assert.Equal(t, &testAPIError{StatusCode: http.StatusInternalServerError}, err)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := WithExecer(Must(New()), ExecerFunc(func(r *http.Request, out interface{}, in ...interface{}) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/test/v2/ping", r.URL.String())
+				return &http.Response{StatusCode: test.responseStatus, Body: http.NoBody, Request: r}, nil
+			}))
+
+			err := CheckAPIVersion(context.Background(), &testAPIClient{Session: s}, "test", "v2", "/test/v2/ping")
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,12 +2,20 @@ package session
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httputil"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/internal/redact"
 )
 
 var (
@@ -17,10 +25,133 @@ var (
 	ErrMarshaling = errors.New("marshaling input")
 	// ErrUnmarshaling represents unmarshaling error
 	ErrUnmarshaling = errors.New("unmarshaling output")
+	// ErrAttemptTimeout is returned when a single attempt to execute a request exceeded the timeout
+	// set with WithAttemptTimeout, while the request context is still valid. Such request can be retried.
+	ErrAttemptTimeout = errors.New("attempt timeout exceeded")
 )
 
+// APIClient is a Session which is able to parse error responses of its API.
+// It is implemented by clients of all API packages.
+type APIClient interface {
+	Session
+
+	// Error parses an error from the response
+	Error(*http.Response) error
+}
+
+// DoRequest creates a request with the given method and url, executes it using the client and unmarshals
+// the response body into out. If body is not nil, it is marshaled as the request body.
+//
+// The context is checked before the request is created and after it failed, so a cancelled or expired context
+// results in a prompt error wrapping context.Canceled or context.DeadlineExceeded. If the response status is not
+// one of acceptedStatuses (http.StatusOK if none are given), the error parsed by client.Error is returned.
+func DoRequest(ctx context.Context, client APIClient, method, url string, body, out interface{}, acceptedStatuses ...int) (*http.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("request not sent: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	var in []interface{}
+	if body != nil {
+		in = append(in, body)
+	}
+	resp, err := client.Exec(req, out, in...)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, fmt.Errorf("request failed: %w", ctxErr)
+		}
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	if len(acceptedStatuses) == 0 {
+		acceptedStatuses = []int{http.StatusOK}
+	}
+	for _, status := range acceptedStatuses {
+		if resp.StatusCode == status {
+			return resp, nil
+		}
+	}
+
+	return nil, client.Error(resp)
+}
+
+// PollLocation repeatedly sends GET requests to link, e.g. the Location returned with 202 Accepted by an asynchronous
+// operation, and passes each response body to decode until it reports that the operation is done or returns an error.
+//
+// Consecutive requests are sent interval apart using Wait, so polling stops with an error wrapping
+// context.DeadlineExceeded as soon as the next request would be past the context deadline. If the response status
+// is not 200, the error parsed by client.Error is returned.
+func PollLocation(ctx context.Context, client APIClient, link string, decode func([]byte) (bool, error), interval time.Duration) error {
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+
+		resp, err := client.Exec(req, nil)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return fmt.Errorf("request failed: %w", ctxErr)
+			}
+			return fmt.Errorf("request failed: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			return client.Error(resp)
+		}
+
+		body, err := ioutil.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("reading response body: %w", err)
+		}
+
+		done, err := decode(body)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		if err := Wait(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
+
 // Exec will sign and execute the request using the client edgegrid.Config
 func (s *session) Exec(r *http.Request, out interface{}, in ...interface{}) (*http.Response, error) {
+	if s.requestTimeout <= 0 {
+		return s.exec(r, out, in...)
+	}
+	if _, ok := r.Context().Deadline(); ok {
+		return s.exec(r, out, in...)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.requestTimeout)
+	defer cancel()
+
+	resp, err := s.exec(r.WithContext(ctx), out, in...)
+	if err != nil {
+		return nil, err
+	}
+	// body has to be read before the request context is cancelled
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewBuffer(data))
+
+	return resp, nil
+}
+
+// exec signs and executes the request, see Exec
+func (s *session) exec(r *http.Request, out interface{}, in ...interface{}) (*http.Response, error) {
 	if len(in) > 1 {
 		return nil, fmt.Errorf("%w: %s", ErrInvalidArgument, "'in' argument must have 0 or 1 value")
 	}
@@ -33,7 +164,15 @@ func (s *session) Exec(r *http.Request, out interface{}, in ...interface{}) (*ht
 		}
 	}
 
-	r.URL.RawQuery = r.URL.Query().Encode()
+	if key, ok := IdempotencyKey(r.Context()); ok && r.Method == http.MethodPost && r.Header.Get(IdempotencyKeyHeader) == "" {
+		r.Header.Set(IdempotencyKeyHeader, key)
+	}
+
+	values := r.URL.Query()
+	if key := s.accountSwitchKey(r.Context()); key != "" {
+		values.Set(AccountSwitchKeyParam, key)
+	}
+	r.URL.RawQuery = values.Encode()
 	if r.UserAgent() == "" {
 		r.Header.Set("User-Agent", s.userAgent)
 	}
@@ -53,13 +192,24 @@ func (s *session) Exec(r *http.Request, out interface{}, in ...interface{}) (*ht
 		}
 
 		r.Body = ioutil.NopCloser(bytes.NewBuffer(data))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(data)), nil
+		}
 		r.ContentLength = int64(len(data))
 	}
 
-	s.client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
-		return s.Sign(req)
+	if s.retry != nil && r.Body != nil && r.Body != http.NoBody && r.GetBody == nil {
+		if err := bufferBody(r); err != nil {
+			return nil, err
+		}
 	}
 
+	if err := s.waitLimiter(r.Context()); err != nil {
+		return nil, err
+	}
+
+	// query is kept unsigned, as signing adds the account switch key to it
+	query := r.URL.RawQuery
 	if err := s.Sign(r); err != nil {
 		return nil, err
 	}
@@ -69,21 +219,35 @@ func (s *session) Exec(r *http.Request, out interface{}, in ...interface{}) (*ht
 		if err != nil {
 			log.WithError(err).Error("Failed to dump request")
 		} else {
-			log.Debug(string(data))
+			log.Debug(string(redact.HTTPDump(data)))
 		}
 	}
 
-	resp, err := s.client.Do(r)
+	resp, err := s.do(r)
+	if err != nil && s.retryReset && isConnectionReset(err) && isIdempotent(r) && r.Context().Err() == nil {
+		log.Debugf("retrying %s %s after connection error: %s", r.Method, r.URL.Path, err)
+		retry, retryErr := s.retryRequest(r, query)
+		if retryErr != nil {
+			return nil, err
+		}
+		resp, err = s.do(retry)
+	}
+	if s.retry != nil && isIdempotent(r) {
+		resp, err = s.retryWithBackoff(r, query, resp, err)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if o, ok := r.Context().Value(contextOptionKey).(*contextOptions); ok && o.recorder != nil {
+		o.recorder.record(resp)
+	}
 
 	if s.trace {
 		data, err := httputil.DumpResponse(resp, true)
 		if err != nil {
 			log.WithError(err).Error("Failed to dump response")
 		} else {
-			log.Debug(string(data))
+			log.Debug(string(redact.HTTPDump(data)))
 		}
 	}
 
@@ -96,6 +260,11 @@ func (s *session) Exec(r *http.Request, out interface{}, in ...interface{}) (*ht
 		}
 		resp.Body = ioutil.NopCloser(bytes.NewBuffer(data))
 
+		// some endpoints return success status without a body, in such case out is left untouched
+		if len(bytes.TrimSpace(data)) == 0 {
+			return resp, nil
+		}
+
 		if err := json.Unmarshal(data, out); err != nil {
 			return nil, fmt.Errorf("%w: %s", ErrUnmarshaling, err)
 		}
@@ -104,6 +273,200 @@ func (s *session) Exec(r *http.Request, out interface{}, in ...interface{}) (*ht
 	return resp, nil
 }
 
+// retryRequest returns a copy of the request with the body rewound and a new signature
+func (s *session) retryRequest(r *http.Request, query string) (*http.Request, error) {
+	retry := r.Clone(r.Context())
+	retry.URL.RawQuery = query
+	if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	} else if r.Body != nil && r.Body != http.NoBody {
+		return nil, errors.New("request body cannot be replayed")
+	}
+	if err := s.waitLimiter(r.Context()); err != nil {
+		return nil, err
+	}
+	if err := s.Sign(retry); err != nil {
+		return nil, err
+	}
+	return retry, nil
+}
+
+// retryWithBackoff retries the request while the retry config reports the result of the last attempt as retryable
+func (s *session) retryWithBackoff(r *http.Request, query string, resp *http.Response, err error) (*http.Response, error) {
+	log := s.Log(r.Context())
+
+	for attempt := 1; attempt < s.retry.MaxAttempts && s.retry.ShouldRetry(resp, err); attempt++ {
+		delay := s.retry.backoff(attempt)
+		if retryAfter, ok := parseRetryAfter(resp); ok {
+			delay = retryAfter
+		}
+
+		if waitErr := Wait(r.Context(), delay); waitErr != nil {
+			if ctxErr := r.Context().Err(); ctxErr != nil {
+				closeBody(resp)
+				return nil, ctxErr
+			}
+			// the next attempt could not start before the deadline, so the last result is returned
+			return resp, err
+		}
+
+		retry, retryErr := s.retryRequest(r, query)
+		if retryErr != nil {
+			return resp, err
+		}
+		closeBody(resp)
+		log.Debugf("retrying %s %s, attempt %d of %d", r.Method, r.URL.Path, attempt+1, s.retry.MaxAttempts)
+		resp, err = s.do(retry)
+	}
+
+	return resp, err
+}
+
+// backoff returns the delay before the given retry, starting from 1
+func (c *RetryConfig) backoff(retry int) time.Duration {
+	delay := c.BaseDelay
+	for i := 1; i < retry; i++ {
+		delay *= 2
+		if delay >= c.MaxDelay {
+			return c.MaxDelay
+		}
+	}
+	if delay > c.MaxDelay {
+		return c.MaxDelay
+	}
+	return delay
+}
+
+// DefaultShouldRetry reports whether the attempt failed with a transient error, i.e. a response with status 429, 502,
+// 503 or 504, a network timeout or ErrAttemptTimeout. It is used by WithRetry unless RetryConfig.ShouldRetry is set.
+func DefaultShouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		var netErr net.Error
+		return errors.Is(err, ErrAttemptTimeout) || (errors.As(err, &netErr) && netErr.Timeout())
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter returns the delay requested with the Retry-After header, given either in seconds or as a date
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// bufferBody reads the request body into memory, so that it can be sent again with GetBody
+func bufferBody(r *http.Request) error {
+	data, err := ioutil.ReadAll(r.Body)
+	_ = r.Body.Close()
+	if err != nil {
+		return fmt.Errorf("reading request body: %w", err)
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(data))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}
+	r.ContentLength = int64(len(data))
+	return nil
+}
+
+// closeBody drains and closes the body of a response which is discarded, so that the connection can be reused
+func closeBody(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
+
+// accountSwitchKey returns the account switch key set on the context, or on the session if the context has none
+func (s *session) accountSwitchKey(ctx context.Context) string {
+	if o, ok := ctx.Value(contextOptionKey).(*contextOptions); ok && o.accountKey != "" {
+		return o.accountKey
+	}
+	return s.accountKey
+}
+
+// waitLimiter waits until the rate limiter set with WithRateLimiter allows a request to be sent
+func (s *session) waitLimiter(ctx context.Context) error {
+	if s.limiter == nil {
+		return nil
+	}
+	if err := s.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("waiting for rate limiter: %w", err)
+	}
+	return nil
+}
+
+// isConnectionReset reports whether the request failed because the connection was closed or reset by the server
+func isConnectionReset(err error) bool {
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET)
+}
+
+// isIdempotent reports whether the request can be safely sent again, following the rules of http.Transport
+func isIdempotent(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return r.Header.Get(IdempotencyKeyHeader) != ""
+}
+
+// do sends the request using the session http client, bounding the attempt with attemptTimeout if it is set
+func (s *session) do(r *http.Request) (*http.Response, error) {
+	// redirected requests are signed using a copy of the client, as the session may be used concurrently
+	client := *s.client
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return s.Sign(req)
+	}
+
+	if s.attemptTimeout <= 0 {
+		return client.Do(r)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.attemptTimeout)
+	defer cancel()
+
+	resp, err := client.Do(r.WithContext(ctx))
+	if err == nil {
+		// body has to be read before the attempt context is cancelled
+		var data []byte
+		data, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewBuffer(data))
+	}
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) && r.Context().Err() == nil {
+			return nil, fmt.Errorf("%w: %s", ErrAttemptTimeout, err)
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 // Sign will only sign a request
 func (s *session) Sign(r *http.Request) error {
 	s.signer.SignRequest(r)
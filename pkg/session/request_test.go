@@ -1,15 +1,28 @@
 package session
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/edgegrid"
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/memory"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -146,6 +159,43 @@ func TestSession_Exec(t *testing.T) {
 			expectedUserAgent:   "other user agent",
 			withError:           ErrUnmarshaling,
 		},
+		"GET request, empty 200 body": {
+			request: func() *http.Request {
+				req, err := http.NewRequest(http.MethodGet, "/test/path", nil)
+				require.NoError(t, err)
+				return req
+			}(),
+			out:            testStruct{},
+			responseStatus: http.StatusOK,
+			expectedMethod: http.MethodGet,
+			expectedPath:   "/test/path",
+			expected:       testStruct{},
+		},
+		"GET request, whitespace only 200 body": {
+			request: func() *http.Request {
+				req, err := http.NewRequest(http.MethodGet, "/test/path", nil)
+				require.NoError(t, err)
+				return req
+			}(),
+			out:            testStruct{},
+			responseBody:   " \n",
+			responseStatus: http.StatusOK,
+			expectedMethod: http.MethodGet,
+			expectedPath:   "/test/path",
+			expected:       testStruct{},
+		},
+		"DELETE request, empty 204 body": {
+			request: func() *http.Request {
+				req, err := http.NewRequest(http.MethodDelete, "/test/path", nil)
+				require.NoError(t, err)
+				return req
+			}(),
+			out:            testStruct{},
+			responseStatus: http.StatusNoContent,
+			expectedMethod: http.MethodDelete,
+			expectedPath:   "/test/path",
+			expected:       testStruct{},
+		},
 		"invalid number of input parameters": {
 			in:        []interface{}{testStruct{}, testStruct{}},
 			withError: ErrInvalidArgument,
@@ -199,3 +249,926 @@ func TestSession_Exec(t *testing.T) {
 		})
 	}
 }
+
+type testAPIClient struct {
+	Session
+}
+
+type testAPIError struct {
+	StatusCode int
+}
+
+func (e *testAPIError) Error() string {
+	return fmt.Sprintf("API error: %d", e.StatusCode)
+}
+
+func (c *testAPIClient) Error(r *http.Response) error {
+	return &testAPIError{StatusCode: r.StatusCode}
+}
+
+func TestDoRequest(t *testing.T) {
+	tests := map[string]struct {
+		ctx              func() (context.Context, context.CancelFunc)
+		method           string
+		body             interface{}
+		acceptedStatuses []int
+		responseStatus   int
+		responseBody     string
+		responseDelay    time.Duration
+		maxDuration      time.Duration
+		expectedBody     string
+		expectedCalls    int32
+		expected         testStruct
+		withError        func(*testing.T, error)
+	}{
+		"GET request, default accepted status": {
+			method:         http.MethodGet,
+			responseStatus: http.StatusOK,
+			responseBody:   `{"a":"text","b":1}`,
+			expectedCalls:  1,
+			expected:       testStruct{A: "text", B: 1},
+		},
+		"POST request with body": {
+			method:           http.MethodPost,
+			body:             testStruct{A: "in", B: 2},
+			acceptedStatuses: []int{http.StatusOK, http.StatusCreated},
+			responseStatus:   http.StatusCreated,
+			responseBody:     `{"a":"text","b":1}`,
+			expectedBody:     `{"a":"in","b":2}`,
+			expectedCalls:    1,
+			expected:         testStruct{A: "text", B: 1},
+		},
+		"unexpected status": {
+			method:         http.MethodGet,
+			responseStatus: http.StatusCreated,
+			responseBody:   `{"a":"text","b":1}`,
+			expectedCalls:  1,
+			withError: func(t *testing.T, err error) {
+				var e *testAPIError
+				require.True(t, errors.As(err, &e), "want: %T; got: %s", e, err)
+				assert.Equal(t, http.StatusCreated, e.StatusCode)
+			},
+		},
+		"cancelled context": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			method:        http.MethodGet,
+			maxDuration:   100 * time.Millisecond,
+			expectedCalls: 0,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, context.Canceled), "want: %s; got: %s", context.Canceled, err)
+			},
+		},
+		"deadline exceeded while waiting for response": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 50*time.Millisecond)
+			},
+			method:         http.MethodGet,
+			responseStatus: http.StatusOK,
+			responseDelay:  time.Second,
+			maxDuration:    500 * time.Millisecond,
+			expectedCalls:  1,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, context.DeadlineExceeded), "want: %s; got: %s", context.DeadlineExceeded, err)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls int32
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				assert.Equal(t, "/test/path", r.URL.String())
+				assert.Equal(t, test.method, r.Method)
+				body, err := ioutil.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedBody, string(body))
+				select {
+				case <-time.After(test.responseDelay):
+				case <-r.Context().Done():
+					return
+				}
+				w.WriteHeader(test.responseStatus)
+				_, err = w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			defer mockServer.Close()
+
+			certPool := x509.NewCertPool()
+			certPool.AddCert(mockServer.Certificate())
+			httpClient := &http.Client{
+				Transport: &http.Transport{
+					TLSClientConfig: &tls.Config{
+						RootCAs: certPool,
+					},
+				},
+			}
+			serverURL, err := url.Parse(mockServer.URL)
+			require.NoError(t, err)
+			s, err := New(WithSigner(&edgegrid.Config{Host: serverURL.Host}), WithClient(httpClient))
+			require.NoError(t, err)
+
+			ctx, cancel := context.Background(), context.CancelFunc(func() {})
+			if test.ctx != nil {
+				ctx, cancel = test.ctx()
+			}
+			defer cancel()
+
+			var out testStruct
+			start := time.Now()
+			_, err = DoRequest(ctx, &testAPIClient{Session: s}, test.method, "/test/path", test.body, &out, test.acceptedStatuses...)
+			if test.maxDuration != 0 {
+				assert.Less(t, int64(time.Since(start)), int64(test.maxDuration))
+			}
+			assert.Equal(t, test.expectedCalls, atomic.LoadInt32(&calls))
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, out)
+		})
+	}
+}
+
+func TestSession_ExecAttemptTimeout(t *testing.T) {
+	var calls int32
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"a":"text","b":1}`))
+		assert.NoError(t, err)
+	}))
+	defer mockServer.Close()
+
+	certPool := x509.NewCertPool()
+	certPool.AddCert(mockServer.Certificate())
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+	s, err := New(WithSigner(&edgegrid.Config{Host: serverURL.Host}), WithClient(httpClient),
+		WithAttemptTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	var out testStruct
+	var attempts int
+	for attempts = 1; attempts <= 3; attempts++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/test/path", nil)
+		require.NoError(t, err)
+		_, err = s.Exec(req, &out)
+		if errors.Is(err, ErrAttemptTimeout) {
+			continue
+		}
+		require.NoError(t, err)
+		break
+	}
+
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, testStruct{A: "text", B: 1}, out)
+
+	t.Run("expired request context is not an attempt timeout", func(t *testing.T) {
+		atomic.StoreInt32(&calls, 0)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		s, err := New(WithSigner(&edgegrid.Config{Host: serverURL.Host}), WithClient(httpClient),
+			WithAttemptTimeout(time.Second))
+		require.NoError(t, err)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/test/path", nil)
+		require.NoError(t, err)
+		_, err = s.Exec(req, &out)
+		assert.False(t, errors.Is(err, ErrAttemptTimeout), "got: %s", err)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded), "got: %s", err)
+	})
+}
+
+func TestExec_HTTPTracingRedactsSecrets(t *testing.T) {
+	handler := memory.New()
+	s, err := New(
+		WithSigner(&edgegrid.Config{Host: "akab-test.luna.akamaiapis.net", ClientToken: "ct", AccessToken: "at", ClientSecret: "cs", AccountKey: "1-ABC:1-DEF"}),
+		WithLog(&log.Logger{Handler: handler, Level: log.DebugLevel}),
+		WithHTTPTracing(true),
+		WithRoundTripFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				ProtoMajor: 1,
+				ProtoMinor: 1,
+				Header:     http.Header{"Set-Cookie": []string{"session=s3cr3t-cookie"}},
+				Body:       ioutil.NopCloser(strings.NewReader(`{"a":"text","b":1,"token":"s3cr3t-token"}`)),
+				Request:    r,
+			}, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, "/test/path", nil)
+	require.NoError(t, err)
+	var out testStruct
+	_, err = s.Exec(req, &out, map[string]string{"password": "s3cr3t-password"})
+	require.NoError(t, err)
+
+	require.Len(t, handler.Entries, 2)
+	for _, entry := range handler.Entries {
+		for _, secret := range []string{"access_token=at", "1-ABC", "s3cr3t-cookie", "s3cr3t-token", "s3cr3t-password"} {
+			assert.NotContains(t, entry.Message, secret)
+		}
+	}
+	assert.Contains(t, handler.Entries[0].Message, "Authorization: [REDACTED]")
+	assert.Contains(t, handler.Entries[0].Message, `{"password":"[REDACTED]"}`)
+	assert.Contains(t, handler.Entries[1].Message, `"token":"[REDACTED]"`)
+}
+
+func TestSession_ExecResponseRecorder(t *testing.T) {
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"b9a3"`)
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"a":"text","b":1}`))
+		assert.NoError(t, err)
+	}))
+	defer mockServer.Close()
+
+	certPool := x509.NewCertPool()
+	certPool.AddCert(mockServer.Certificate())
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+	s, err := New(WithSigner(&edgegrid.Config{Host: serverURL.Host}), WithClient(httpClient))
+	require.NoError(t, err)
+
+	ctx, recorder := ContextWithResponseRecorder(ContextWithOptions(context.Background(), WithContextAccountSwitchKey("1-ABCD")))
+	assert.Nil(t, recorder.Response())
+	o, ok := ctx.Value(contextOptionKey).(*contextOptions)
+	require.True(t, ok)
+	assert.Equal(t, "1-ABCD", o.accountKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/test/path", nil)
+	require.NoError(t, err)
+	var out testStruct
+	_, err = s.Exec(req, &out)
+	require.NoError(t, err)
+	assert.Equal(t, testStruct{A: "text", B: 1}, out)
+
+	resp := recorder.Response()
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `"b9a3"`, resp.Header.Get("ETag"))
+	assert.Equal(t, "99", resp.Header.Get("X-RateLimit-Remaining"))
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":"text","b":1}`, string(body))
+}
+
+func TestSession_ExecRetryOnConnectionReset(t *testing.T) {
+	resetErr := &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+	tests := map[string]struct {
+		method        string
+		body          interface{}
+		header        http.Header
+		disabled      bool
+		firstErr      error
+		expectedCalls int
+		withError     bool
+	}{
+		"GET retried after EOF": {
+			method:        http.MethodGet,
+			firstErr:      io.EOF,
+			expectedCalls: 2,
+		},
+		"PUT retried after connection reset with the same body": {
+			method:        http.MethodPut,
+			body:          testStruct{A: "text", B: 1},
+			firstErr:      resetErr,
+			expectedCalls: 2,
+		},
+		"POST with idempotency key retried": {
+			method:        http.MethodPost,
+			body:          testStruct{A: "text", B: 1},
+			header:        http.Header{IdempotencyKeyHeader: []string{"5f2d1a4c"}},
+			firstErr:      io.EOF,
+			expectedCalls: 2,
+		},
+		"POST not retried": {
+			method:        http.MethodPost,
+			body:          testStruct{A: "text", B: 1},
+			firstErr:      io.EOF,
+			expectedCalls: 1,
+			withError:     true,
+		},
+		"other errors not retried": {
+			method:        http.MethodGet,
+			firstErr:      errors.New("tls: handshake failure"),
+			expectedCalls: 1,
+			withError:     true,
+		},
+		"retry disabled": {
+			method:        http.MethodGet,
+			disabled:      true,
+			firstErr:      io.EOF,
+			expectedCalls: 1,
+			withError:     true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls int
+			var authorizations, queries, bodies []string
+			s, err := New(
+				WithSigner(&edgegrid.Config{Host: "akab-test.luna.akamaiapis.net", AccountKey: "1-5C0YLB"}),
+				WithRetryOnConnectionReset(!test.disabled),
+				WithRoundTripFunc(func(r *http.Request) (*http.Response, error) {
+					calls++
+					authorizations = append(authorizations, r.Header.Get("Authorization"))
+					queries = append(queries, r.URL.RawQuery)
+					if r.Body != nil {
+						body, err := ioutil.ReadAll(r.Body)
+						assert.NoError(t, err)
+						bodies = append(bodies, string(body))
+					}
+					if calls == 1 {
+						return nil, test.firstErr
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(strings.NewReader(`{"a":"text","b":1}`)),
+						Request:    r,
+					}, nil
+				}),
+			)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(test.method, "/test/path?q=1", nil)
+			require.NoError(t, err)
+			for k, v := range test.header {
+				req.Header[k] = v
+			}
+			var in []interface{}
+			if test.body != nil {
+				in = append(in, test.body)
+			}
+			var out testStruct
+			resp, err := s.Exec(req, &out, in...)
+			assert.Equal(t, test.expectedCalls, calls)
+			if test.withError {
+				assert.True(t, errors.Is(err, test.firstErr), "want: %s; got: %s", test.firstErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, testStruct{A: "text", B: 1}, out)
+
+			assert.NotEqual(t, authorizations[0], authorizations[1], "retried request should be signed again")
+			assert.Equal(t, queries[0], queries[1])
+			assert.Equal(t, "accountSwitchKey=1-5C0YLB&q=1", queries[1])
+			if test.body != nil {
+				assert.Equal(t, []string{`{"a":"text","b":1}`, `{"a":"text","b":1}`}, bodies)
+			}
+		})
+	}
+}
+
+func TestPollLocation(t *testing.T) {
+	type status struct {
+		Status string `json:"status"`
+	}
+
+	tests := map[string]struct {
+		responses     []string
+		responseCode  int
+		timeout       time.Duration
+		interval      time.Duration
+		decodeErr     error
+		expectedCalls int32
+		withError     func(*testing.T, error)
+	}{
+		"not done, then done": {
+			responses:     []string{`{"status":"PENDING"}`, `{"status":"PENDING"}`, `{"status":"DONE"}`},
+			responseCode:  http.StatusOK,
+			timeout:       time.Second,
+			expectedCalls: 3,
+		},
+		"context timeout": {
+			responses:     []string{`{"status":"PENDING"}`, `{"status":"PENDING"}`, `{"status":"PENDING"}`, `{"status":"PENDING"}`},
+			responseCode:  http.StatusOK,
+			timeout:       150 * time.Millisecond,
+			interval:      100 * time.Millisecond,
+			expectedCalls: 2,
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, context.DeadlineExceeded), "want: %s; got: %s", context.DeadlineExceeded, err)
+			},
+		},
+		"decode error": {
+			responses:     []string{`{"status":"PENDING"}`, `{"status":"FAILED"}`},
+			responseCode:  http.StatusOK,
+			timeout:       time.Second,
+			decodeErr:     errors.New("operation failed"),
+			expectedCalls: 2,
+			withError: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "operation failed")
+			},
+		},
+		"error response": {
+			responses:     []string{`{"title":"Internal Server Error"}`},
+			responseCode:  http.StatusInternalServerError,
+			timeout:       time.Second,
+			expectedCalls: 1,
+			withError: func(t *testing.T, err error) {
+				assert.Equal(t, &testAPIError{StatusCode: http.StatusInternalServerError}, err)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls int32
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/test/operations/1", r.URL.Path)
+				call := atomic.AddInt32(&calls, 1)
+				w.WriteHeader(test.responseCode)
+				_, err := w.Write([]byte(test.responses[call-1]))
+				assert.NoError(t, err)
+			}))
+			defer mockServer.Close()
+
+			certPool := x509.NewCertPool()
+			certPool.AddCert(mockServer.Certificate())
+			httpClient := &http.Client{
+				Transport: &http.Transport{
+					TLSClientConfig: &tls.Config{
+						RootCAs: certPool,
+					},
+				},
+			}
+			serverURL, err := url.Parse(mockServer.URL)
+			require.NoError(t, err)
+			s, err := New(WithSigner(&edgegrid.Config{Host: serverURL.Host}), WithClient(httpClient))
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), test.timeout)
+			defer cancel()
+
+			decode := func(body []byte) (bool, error) {
+				var st status
+				if err := json.Unmarshal(body, &st); err != nil {
+					return false, err
+				}
+				if st.Status == "FAILED" {
+					return false, test.decodeErr
+				}
+				return st.Status == "DONE", nil
+			}
+			interval := test.interval
+			if interval == 0 {
+				interval = 10 * time.Millisecond
+			}
+			err = PollLocation(ctx, &testAPIClient{Session: s}, "/test/operations/1", decode, interval)
+			assert.Equal(t, test.expectedCalls, atomic.LoadInt32(&calls))
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSession_ExecRetry(t *testing.T) {
+	type attempt struct {
+		status int
+		header http.Header
+		err    error
+	}
+	timeoutErr := &net.DNSError{Err: "i/o timeout", IsTimeout: true}
+
+	tests := map[string]struct {
+		method         string
+		header         http.Header
+		body           io.Reader
+		config         RetryConfig
+		attempts       []attempt
+		cancelAfter    int
+		timeout        time.Duration
+		expectedCalls  int
+		expectedStatus int
+		withError      error
+	}{
+		"GET retried after 503": {
+			method:         http.MethodGet,
+			config:         RetryConfig{BaseDelay: time.Millisecond},
+			attempts:       []attempt{{status: http.StatusServiceUnavailable}, {status: http.StatusOK}},
+			expectedCalls:  2,
+			expectedStatus: http.StatusOK,
+		},
+		"GET retried after network timeout": {
+			method:         http.MethodGet,
+			config:         RetryConfig{BaseDelay: time.Millisecond},
+			attempts:       []attempt{{err: timeoutErr}, {status: http.StatusOK}},
+			expectedCalls:  2,
+			expectedStatus: http.StatusOK,
+		},
+		"Retry-After takes precedence over backoff": {
+			method: http.MethodGet,
+			config: RetryConfig{BaseDelay: time.Minute, MaxDelay: time.Minute},
+			attempts: []attempt{
+				{status: http.StatusTooManyRequests, header: http.Header{"Retry-After": []string{"0"}}},
+				{status: http.StatusOK},
+			},
+			timeout:        time.Second,
+			expectedCalls:  2,
+			expectedStatus: http.StatusOK,
+		},
+		"max attempts exhausted": {
+			method: http.MethodDelete,
+			config: RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond},
+			attempts: []attempt{
+				{status: http.StatusBadGateway}, {status: http.StatusGatewayTimeout}, {status: http.StatusBadGateway}, {status: http.StatusOK},
+			},
+			expectedCalls:  3,
+			expectedStatus: http.StatusBadGateway,
+		},
+		"non-retryable status": {
+			method:         http.MethodGet,
+			config:         RetryConfig{BaseDelay: time.Millisecond},
+			attempts:       []attempt{{status: http.StatusInternalServerError}, {status: http.StatusOK}},
+			expectedCalls:  1,
+			expectedStatus: http.StatusInternalServerError,
+		},
+		"custom predicate": {
+			method: http.MethodGet,
+			config: RetryConfig{BaseDelay: time.Millisecond, ShouldRetry: func(resp *http.Response, err error) bool {
+				return err == nil && resp.StatusCode == http.StatusInternalServerError
+			}},
+			attempts:       []attempt{{status: http.StatusInternalServerError}, {status: http.StatusOK}},
+			expectedCalls:  2,
+			expectedStatus: http.StatusOK,
+		},
+		"POST not retried": {
+			method:         http.MethodPost,
+			config:         RetryConfig{BaseDelay: time.Millisecond},
+			attempts:       []attempt{{status: http.StatusServiceUnavailable}, {status: http.StatusOK}},
+			expectedCalls:  1,
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		"POST with idempotency key and non-replayable body retried": {
+			method:         http.MethodPost,
+			header:         http.Header{IdempotencyKeyHeader: []string{"5f2d1a4c"}},
+			body:           io.MultiReader(strings.NewReader(`{"a":"text",`), strings.NewReader(`"b":1}`)),
+			config:         RetryConfig{BaseDelay: time.Millisecond},
+			attempts:       []attempt{{status: http.StatusServiceUnavailable}, {status: http.StatusOK}},
+			expectedCalls:  2,
+			expectedStatus: http.StatusOK,
+		},
+		"context cancelled between attempts": {
+			method:        http.MethodGet,
+			config:        RetryConfig{BaseDelay: time.Second},
+			attempts:      []attempt{{status: http.StatusServiceUnavailable}, {status: http.StatusOK}},
+			cancelAfter:   1,
+			expectedCalls: 1,
+			withError:     context.Canceled,
+		},
+		"next attempt past context deadline": {
+			method:         http.MethodGet,
+			config:         RetryConfig{BaseDelay: time.Second},
+			attempts:       []attempt{{status: http.StatusServiceUnavailable}, {status: http.StatusOK}},
+			timeout:        100 * time.Millisecond,
+			expectedCalls:  1,
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			if test.timeout != 0 {
+				ctx, cancel = context.WithTimeout(context.Background(), test.timeout)
+			}
+			defer cancel()
+
+			var calls int
+			var authorizations, bodies []string
+			s, err := New(
+				WithSigner(&edgegrid.Config{Host: "akab-test.luna.akamaiapis.net"}),
+				WithRetry(test.config),
+				WithRoundTripFunc(func(r *http.Request) (*http.Response, error) {
+					calls++
+					if calls == test.cancelAfter {
+						cancel()
+					}
+					authorizations = append(authorizations, r.Header.Get("Authorization"))
+					if r.Body != nil {
+						body, err := ioutil.ReadAll(r.Body)
+						assert.NoError(t, err)
+						bodies = append(bodies, string(body))
+					}
+					a := test.attempts[calls-1]
+					if a.err != nil {
+						return nil, a.err
+					}
+					header := a.header
+					if header == nil {
+						header = http.Header{}
+					}
+					return &http.Response{
+						StatusCode: a.status,
+						Header:     header,
+						Body:       ioutil.NopCloser(strings.NewReader(`{"a":"text","b":1}`)),
+						Request:    r,
+					}, nil
+				}),
+			)
+			require.NoError(t, err)
+
+			req, err := http.NewRequestWithContext(ctx, test.method, "/test/path", test.body)
+			require.NoError(t, err)
+			for k, v := range test.header {
+				req.Header[k] = v
+			}
+			resp, err := s.Exec(req, nil)
+			assert.Equal(t, test.expectedCalls, calls)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedStatus, resp.StatusCode)
+			if calls > 1 {
+				assert.NotEqual(t, authorizations[0], authorizations[1], "retried request should be signed again")
+			}
+			if test.body != nil {
+				assert.Equal(t, []string{`{"a":"text","b":1}`, `{"a":"text","b":1}`}, bodies)
+			}
+		})
+	}
+}
+
+func TestRetryConfig_backoff(t *testing.T) {
+	config := RetryConfig{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	assert.Equal(t, time.Second, config.backoff(1))
+	assert.Equal(t, 2*time.Second, config.backoff(2))
+	assert.Equal(t, 4*time.Second, config.backoff(3))
+	assert.Equal(t, 5*time.Second, config.backoff(4))
+	assert.Equal(t, 5*time.Second, config.backoff(40))
+}
+
+func TestSession_ExecRateLimiter(t *testing.T) {
+	var calls int32
+	s, err := New(
+		WithSigner(&edgegrid.Config{Host: "akab-test.luna.akamaiapis.net"}),
+		WithRateLimiter(NewRateLimiter(20, 1)),
+		WithRoundTripFunc(func(r *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&calls, 1)
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: r}, nil
+		}),
+	)
+	require.NoError(t, err)
+
+	exec := func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/test/path", nil)
+		require.NoError(t, err)
+		_, err = s.Exec(req, nil)
+		return err
+	}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, exec(context.Background()))
+	}
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(90*time.Millisecond), "requests should be spaced by the limiter")
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	t.Run("context cancelled while waiting", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := exec(ctx)
+		assert.True(t, errors.Is(err, context.Canceled), "want: %s; got: %s", context.Canceled, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("wait would exceed context deadline", func(t *testing.T) {
+		s, err := New(
+			WithSigner(&edgegrid.Config{Host: "akab-test.luna.akamaiapis.net"}),
+			WithRateLimiter(NewRateLimiter(1, 1)),
+			WithRoundTripFunc(func(r *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: r}, nil
+			}),
+		)
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodGet, "/test/path", nil)
+		require.NoError(t, err)
+		_, err = s.Exec(req, nil)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, "/test/path", nil)
+		require.NoError(t, err)
+		_, err = s.Exec(req, nil)
+		assert.Error(t, err)
+		assert.Less(t, int64(time.Since(start)), int64(50*time.Millisecond), "should not block until the deadline")
+	})
+}
+
+func TestSession_ExecRequestTimeout(t *testing.T) {
+	newSession := func(t *testing.T, timeout time.Duration, deadlines chan<- time.Time) Session {
+		opts := []Option{
+			WithSigner(&edgegrid.Config{Host: "akab-test.luna.akamaiapis.net"}),
+			WithRoundTripFunc(func(r *http.Request) (*http.Response, error) {
+				deadline, _ := r.Context().Deadline()
+				deadlines <- deadline
+				if r.URL.Path == "/hang" {
+					<-r.Context().Done()
+					return nil, r.Context().Err()
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"a":"text","b":1}`)),
+					Request:    r,
+				}, nil
+			}),
+		}
+		if timeout != 0 {
+			opts = append(opts, WithRequestTimeout(timeout))
+		}
+		s, err := New(opts...)
+		require.NoError(t, err)
+		return s
+	}
+
+	t.Run("request without deadline times out", func(t *testing.T) {
+		deadlines := make(chan time.Time, 1)
+		s := newSession(t, 50*time.Millisecond, deadlines)
+		req, err := http.NewRequest(http.MethodGet, "/hang", nil)
+		require.NoError(t, err)
+
+		start := time.Now()
+		_, err = s.Exec(req, nil)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded), "want: %s; got: %s", context.DeadlineExceeded, err)
+		assert.False(t, (<-deadlines).IsZero())
+		assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	})
+
+	t.Run("response body is readable after the call", func(t *testing.T) {
+		deadlines := make(chan time.Time, 1)
+		s := newSession(t, time.Second, deadlines)
+		req, err := http.NewRequest(http.MethodGet, "/test/path", nil)
+		require.NoError(t, err)
+
+		resp, err := s.Exec(req, nil)
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"a":"text","b":1}`, string(body))
+		assert.False(t, (<-deadlines).IsZero())
+	})
+
+	t.Run("caller deadline is kept", func(t *testing.T) {
+		for name, callerTimeout := range map[string]time.Duration{"tighter": 20 * time.Millisecond, "looser": 5 * time.Second} {
+			t.Run(name, func(t *testing.T) {
+				deadlines := make(chan time.Time, 1)
+				s := newSession(t, time.Second, deadlines)
+				ctx, cancel := context.WithTimeout(context.Background(), callerTimeout)
+				defer cancel()
+				req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/test/path", nil)
+				require.NoError(t, err)
+
+				_, err = s.Exec(req, nil)
+				require.NoError(t, err)
+				expected, _ := ctx.Deadline()
+				assert.Equal(t, expected, <-deadlines)
+			})
+		}
+	})
+
+	t.Run("no timeout by default", func(t *testing.T) {
+		deadlines := make(chan time.Time, 1)
+		s := newSession(t, 0, deadlines)
+		req, err := http.NewRequest(http.MethodGet, "/test/path", nil)
+		require.NoError(t, err)
+
+		_, err = s.Exec(req, nil)
+		require.NoError(t, err)
+		assert.True(t, (<-deadlines).IsZero())
+	})
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	limiter := NewRateLimiter(0, 0)
+	assert.True(t, limiter.Allow())
+	assert.True(t, limiter.Allow())
+
+	limiter = NewRateLimiter(5, 0)
+	assert.Equal(t, 1, limiter.Burst())
+	assert.True(t, limiter.Allow())
+	assert.False(t, limiter.Allow())
+}
+
+func TestSession_ExecAccountSwitchKey(t *testing.T) {
+	tests := map[string]struct {
+		method        string
+		sessionKey    string
+		contextKey    string
+		configKey     string
+		expectedQuery string
+	}{
+		"GET": {
+			method:        http.MethodGet,
+			sessionKey:    "1-5C0YLB:1-8BYUX",
+			expectedQuery: "accountSwitchKey=1-5C0YLB%3A1-8BYUX&contractId=ctr_1&groupId=grp_2",
+		},
+		"POST": {
+			method:        http.MethodPost,
+			sessionKey:    "1-5C0YLB:1-8BYUX",
+			expectedQuery: "accountSwitchKey=1-5C0YLB%3A1-8BYUX&contractId=ctr_1&groupId=grp_2",
+		},
+		"PUT": {
+			method:        http.MethodPut,
+			sessionKey:    "1-5C0YLB:1-8BYUX",
+			expectedQuery: "accountSwitchKey=1-5C0YLB%3A1-8BYUX&contractId=ctr_1&groupId=grp_2",
+		},
+		"DELETE": {
+			method:        http.MethodDelete,
+			sessionKey:    "1-5C0YLB:1-8BYUX",
+			expectedQuery: "accountSwitchKey=1-5C0YLB%3A1-8BYUX&contractId=ctr_1&groupId=grp_2",
+		},
+		"context key overrides session key": {
+			method:        http.MethodGet,
+			sessionKey:    "1-5C0YLB:1-8BYUX",
+			contextKey:    "1-6JHGX",
+			expectedQuery: "accountSwitchKey=1-6JHGX&contractId=ctr_1&groupId=grp_2",
+		},
+		"context key without session key": {
+			method:        http.MethodGet,
+			contextKey:    "1-6JHGX",
+			expectedQuery: "accountSwitchKey=1-6JHGX&contractId=ctr_1&groupId=grp_2",
+		},
+		"session key takes precedence over config account key": {
+			method:        http.MethodGet,
+			sessionKey:    "1-5C0YLB:1-8BYUX",
+			configKey:     "1-ABCDE",
+			expectedQuery: "accountSwitchKey=1-5C0YLB%3A1-8BYUX&contractId=ctr_1&groupId=grp_2",
+		},
+		"config account key used without session key": {
+			method:        http.MethodGet,
+			configKey:     "1-ABCDE",
+			expectedQuery: "accountSwitchKey=1-ABCDE&contractId=ctr_1&groupId=grp_2",
+		},
+		"no key": {
+			method:        http.MethodGet,
+			expectedQuery: "contractId=ctr_1&groupId=grp_2",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var query string
+			s, err := New(
+				WithSigner(&edgegrid.Config{Host: "akab-test.luna.akamaiapis.net", AccountKey: test.configKey}),
+				WithAccountSwitchKey(test.sessionKey),
+				WithRoundTripFunc(func(r *http.Request) (*http.Response, error) {
+					assert.Equal(t, test.method, r.Method)
+					query = r.URL.RawQuery
+					return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: r}, nil
+				}),
+			)
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			if test.contextKey != "" {
+				ctx = ContextWithOptions(ctx, WithContextAccountSwitchKey(test.contextKey))
+			}
+			req, err := http.NewRequestWithContext(ctx, test.method, "/papi/v1/edgehostnames?contractId=ctr_1&groupId=grp_2", nil)
+			require.NoError(t, err)
+			_, err = s.Exec(req, nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedQuery, query)
+		})
+	}
+}
@@ -3,23 +3,36 @@ package session
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"runtime"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/edgegrid"
 	"github.com/apex/log"
 	"github.com/apex/log/handlers/discard"
+	"golang.org/x/time/rate"
 )
 
 type (
-	// Session is the interface that is used by the pa
-	// This allows the client itself to be more extensible and readily testable, ets.
-	Session interface {
+	// Execer executes requests. Session implements it by signing and sending requests to the API,
+	// a fake implementation can be injected with WithExecer, e.g. to test code using API clients without HTTP.
+	Execer interface {
 		// Exec will sign and execute a request returning the response
 		// The response body will be unmarshaled in to out
 		// Optionally the in value will be marshaled into the body
 		Exec(r *http.Request, out interface{}, in ...interface{}) (*http.Response, error)
+	}
+
+	// ExecerFunc is an adapter to use a function as an Execer
+	ExecerFunc func(r *http.Request, out interface{}, in ...interface{}) (*http.Response, error)
+
+	// Session is the interface that is used by the pa
+	// This allows the client itself to be more extensible and readily testable, ets.
+	Session interface {
+		Execer
 
 		// Sign will only sign a request, this is useful for circumstances
 		// when the caller wishes to manage the http client
@@ -35,17 +48,46 @@ type (
 
 	// session is the base akamai http client
 	session struct {
-		client       *http.Client
-		signer       edgegrid.Signer
-		log          log.Interface
-		trace        bool
-		userAgent    string
-		requestLimit int
+		client         *http.Client
+		signer         edgegrid.Signer
+		log            log.Interface
+		trace          bool
+		userAgent      string
+		requestLimit   int
+		attemptTimeout time.Duration
+		requestTimeout time.Duration
+		retryReset     bool
+		retry          *RetryConfig
+		limiter        *rate.Limiter
+		accountKey     string
+	}
+
+	// RetryConfig configures retries of failed requests set with WithRetry
+	RetryConfig struct {
+		// MaxAttempts is the maximum number of attempts to send a request, including the first one. Defaults to 3.
+		MaxAttempts int
+		// BaseDelay is the delay before the first retry, doubled before every following one. Defaults to 1 second.
+		BaseDelay time.Duration
+		// MaxDelay caps the delay between attempts computed from BaseDelay. Defaults to 30 seconds.
+		MaxDelay time.Duration
+		// ShouldRetry decides whether an attempt which resulted in the response or error is retried.
+		// DefaultShouldRetry is used if it is nil.
+		ShouldRetry func(*http.Response, error) bool
 	}
 
 	contextOptions struct {
-		log    log.Interface
-		header http.Header
+		log            log.Interface
+		header         http.Header
+		idempotencyKey string
+		recorder       *ResponseRecorder
+		accountKey     string
+	}
+
+	// ResponseRecorder holds the last response received for the requests made with the context returned by
+	// ContextWithResponseRecorder. It is safe for concurrent use.
+	ResponseRecorder struct {
+		mu   sync.Mutex
+		resp *http.Response
 	}
 
 	// Option defines a client option
@@ -55,6 +97,21 @@ type (
 
 	// ContextOption are options on the context
 	ContextOption func(*contextOptions)
+
+	// roundTripFunc is an http.RoundTripper calling the function to send requests
+	roundTripFunc func(*http.Request) (*http.Response, error)
+
+	// defaultHeadersSession is a Session adding default headers to every request
+	defaultHeadersSession struct {
+		Session
+		header http.Header
+	}
+
+	// execerSession is a Session executing requests with an Execer
+	execerSession struct {
+		Session
+		execer Execer
+	}
 )
 
 var (
@@ -64,8 +121,28 @@ var (
 const (
 	// Version is the client version
 	Version = "7.0.0"
+
+	// IdempotencyKeyHeader is the header carrying the idempotency key set with ContextWithIdempotencyKey
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// AccountSwitchKeyParam is the query parameter carrying the account switch key set with WithAccountSwitchKey
+	AccountSwitchKeyParam = "accountSwitchKey"
 )
 
+// protectedHeaders are the headers which take part in signing or framing of the request,
+// so they cannot be set with WithDefaultHeaders
+var protectedHeaders = map[string]struct{}{
+	"Authorization":     {},
+	"Host":              {},
+	"Content-Length":    {},
+	"Transfer-Encoding": {},
+}
+
+// RoundTrip implements http.RoundTripper
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
 // New returns a new session
 func New(opts ...Option) (Session, error) {
 	var (
@@ -111,6 +188,18 @@ func WithClient(client *http.Client) Option {
 	}
 }
 
+// WithRoundTripFunc makes the session send requests using the given function instead of the network.
+// Requests are still prepared and signed as usual, so it is useful to stub API responses in unit tests
+// without starting a server. It replaces the transport of a copy of the current http client, so it has to
+// be applied after WithClient if both are used.
+func WithRoundTripFunc(f func(*http.Request) (*http.Response, error)) Option {
+	return func(s *session) {
+		client := *s.client
+		client.Transport = roundTripFunc(f)
+		s.client = &client
+	}
+}
+
 // WithLog sets the log interface for the client
 func WithLog(l log.Interface) Option {
 	return func(s *session) {
@@ -139,6 +228,91 @@ func WithRequestLimit(requestLimit int) Option {
 	}
 }
 
+// WithRateLimiter makes every request, including retried ones, wait for the limiter before it is signed and sent,
+// e.g. to stay within the rate limits of an API when requests are made concurrently. If the request context is done
+// or its deadline would be exceeded while waiting, the request is not sent and an error is returned.
+// Requests are not limited by default.
+func WithRateLimiter(limiter *rate.Limiter) Option {
+	return func(s *session) {
+		s.limiter = limiter
+	}
+}
+
+// NewRateLimiter returns a limiter for WithRateLimiter allowing rps requests per second on average,
+// with bursts of up to burst requests. If rps is not positive, requests are not limited.
+func NewRateLimiter(rps, burst int) *rate.Limiter {
+	if rps <= 0 {
+		return rate.NewLimiter(rate.Inf, burst)
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return rate.NewLimiter(rate.Limit(rps), burst)
+}
+
+// WithAttemptTimeout sets the deadline of a single attempt to execute a request, including reading the response body.
+// The deadline is derived from the request context, which still bounds the total time of the call.
+// An attempt which does not complete in time is cancelled and ErrAttemptTimeout is returned, so that it can be
+// retried by the caller. Responses are read into memory as a part of the attempt. It is disabled by default.
+func WithAttemptTimeout(d time.Duration) Option {
+	return func(s *session) {
+		s.attemptTimeout = d
+	}
+}
+
+// WithRequestTimeout sets the timeout of requests executed without a deadline, so that a hung endpoint cannot block
+// the caller indefinitely. It bounds the whole call, including retries and reading the response body. Requests whose
+// context already has a deadline are not affected, so a caller deadline is never shortened. A request which does not
+// complete in time fails with an error matching context.DeadlineExceeded. By default, there is no timeout.
+func WithRequestTimeout(d time.Duration) Option {
+	return func(s *session) {
+		s.requestTimeout = d
+	}
+}
+
+// WithRetryOnConnectionReset makes requests with idempotent methods, and POST requests with Idempotency-Key header,
+// retried once when the connection fails with EOF or is reset by peer, e.g. when a load balancer closes a reused
+// idle connection. The retried request is signed again. HTTP error responses are not retried. It is disabled by default.
+func WithRetryOnConnectionReset(retry bool) Option {
+	return func(s *session) {
+		s.retryReset = retry
+	}
+}
+
+// WithRetry makes requests with idempotent methods, and POST requests with Idempotency-Key header, retried with
+// exponential backoff when RetryConfig.ShouldRetry reports that the attempt failed with a transient error.
+// If the response has a Retry-After header, the next attempt is made after the delay it specifies instead.
+// Retried requests are signed again and request bodies are buffered so that they can be sent again.
+// Retries stop when the context is cancelled or the next attempt could not start before its deadline,
+// in which case the result of the last attempt is returned. It is disabled by default.
+func WithRetry(config RetryConfig) Option {
+	return func(s *session) {
+		if config.MaxAttempts <= 0 {
+			config.MaxAttempts = 3
+		}
+		if config.BaseDelay <= 0 {
+			config.BaseDelay = time.Second
+		}
+		if config.MaxDelay <= 0 {
+			config.MaxDelay = 30 * time.Second
+		}
+		if config.ShouldRetry == nil {
+			config.ShouldRetry = DefaultShouldRetry
+		}
+		s.retry = &config
+	}
+}
+
+// WithAccountSwitchKey sets the account switch key added to the query of every request, so that a single API client
+// can manage an account other than the one of its credentials. Other query parameters of the request are preserved.
+// It takes precedence over the account key of the edgegrid config, and can be overridden for a single call
+// with WithContextAccountSwitchKey.
+func WithAccountSwitchKey(key string) Option {
+	return func(s *session) {
+		s.accountKey = key
+	}
+}
+
 // WithHTTPTracing sets the request and response dump for debugging
 func WithHTTPTracing(trace bool) Option {
 	return func(s *session) {
@@ -191,3 +365,138 @@ func WithContextHeaders(h http.Header) ContextOption {
 		o.header = h
 	}
 }
+
+// WithContextAccountSwitchKey sets the account switch key for the calls made with the context,
+// overriding the one set with WithAccountSwitchKey
+func WithContextAccountSwitchKey(key string) ContextOption {
+	return func(o *contextOptions) {
+		o.accountKey = key
+	}
+}
+
+// ContextWithIdempotencyKey returns a copy of the context with the idempotency key. POST requests made
+// with the context carry the key in the Idempotency-Key header, so that a retried request does not
+// create a duplicate resource. Other options previously set on the context are preserved.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	o := new(contextOptions)
+	if existing, ok := ctx.Value(contextOptionKey).(*contextOptions); ok {
+		*o = *existing
+	}
+	o.idempotencyKey = key
+
+	return context.WithValue(ctx, contextOptionKey, o)
+}
+
+// IdempotencyKey returns the idempotency key set on the context with ContextWithIdempotencyKey
+func IdempotencyKey(ctx context.Context) (string, bool) {
+	if o, ok := ctx.Value(contextOptionKey).(*contextOptions); ok && o.idempotencyKey != "" {
+		return o.idempotencyKey, true
+	}
+	return "", false
+}
+
+// ContextWithResponseRecorder returns a copy of the context with a new ResponseRecorder, which records responses
+// of the requests made with the context, so that headers which client methods do not return, e.g. ETag, request ID
+// or rate limits, can be inspected. Responses are decoded by the client methods as usual; the body of a response
+// which was decoded remains readable from the recorded response. Other options previously set on the context are preserved.
+func ContextWithResponseRecorder(ctx context.Context) (context.Context, *ResponseRecorder) {
+	o := new(contextOptions)
+	if existing, ok := ctx.Value(contextOptionKey).(*contextOptions); ok {
+		*o = *existing
+	}
+	o.recorder = &ResponseRecorder{}
+
+	return context.WithValue(ctx, contextOptionKey, o), o.recorder
+}
+
+// Response returns the last recorded response, or nil if no response was received yet. Methods making several
+// requests, e.g. retrying or multi-step helpers, record the response of each of them, so the last one is returned.
+func (r *ResponseRecorder) Response() *http.Response {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.resp
+}
+
+func (r *ResponseRecorder) record(resp *http.Response) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.resp = resp
+}
+
+// RemainingBudget returns the time left until the deadline of the context, or false if the context has no deadline.
+// Helpers composed of several requests, e.g. an update followed by waiting for its activation, share the deadline
+// of the context across all their steps, so the budget is what is left for the steps which did not run yet.
+func RemainingBudget(ctx context.Context) (time.Duration, bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0, false
+	}
+	return time.Until(deadline), true
+}
+
+// Wait blocks for the duration, e.g. between polls of a multi-step helper, or until the context is done.
+// If the remaining budget of the context is shorter than the duration, it returns immediately with an error
+// wrapping context.DeadlineExceeded, as the step following the wait could not start before the deadline anyway.
+func Wait(ctx context.Context, d time.Duration) error {
+	if budget, ok := RemainingBudget(ctx); ok && budget < d {
+		return fmt.Errorf("waiting %s exceeds the remaining budget of %s: %w", d, budget.Round(time.Millisecond), context.DeadlineExceeded)
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// WithDefaultHeaders returns a session adding the headers to every request executed with it. A default header
+// is only added if the request does not already have it, and headers set on the context with WithContextHeaders
+// take precedence. Headers which are a part of request signing or framing, e.g. Authorization or Host, are ignored.
+//
+// API clients expose it as the WithDefaultHeaders option of their constructors.
+func WithDefaultHeaders(sess Session, h http.Header) Session {
+	header := make(http.Header, len(h))
+	for k, v := range h {
+		k = http.CanonicalHeaderKey(k)
+		if _, ok := protectedHeaders[k]; ok {
+			continue
+		}
+		header[k] = append([]string{}, v...)
+	}
+
+	return &defaultHeadersSession{Session: sess, header: header}
+}
+
+// Exec adds the default headers to the request and executes it with the wrapped session
+func (s *defaultHeadersSession) Exec(r *http.Request, out interface{}, in ...interface{}) (*http.Response, error) {
+	if r.Header == nil {
+		r.Header = make(http.Header)
+	}
+	for k, v := range s.header {
+		if _, ok := r.Header[k]; !ok {
+			r.Header[k] = append([]string{}, v...)
+		}
+	}
+
+	return s.Session.Exec(r, out, in...)
+}
+
+// Exec calls f(r, out, in...)
+func (f ExecerFunc) Exec(r *http.Request, out interface{}, in ...interface{}) (*http.Response, error) {
+	return f(r, out, in...)
+}
+
+// WithExecer returns a session executing requests with the Execer instead of sending them, while other methods,
+// e.g. Log, are provided by the wrapped session. API clients created with the returned session call the Execer
+// with requests prepared by their methods, so a fake Execer can serve responses in tests without an HTTP server.
+func WithExecer(sess Session, e Execer) Session {
+	return &execerSession{Session: sess, execer: e}
+}
+
+// Exec executes the request with the Execer
+func (s *execerSession) Exec(r *http.Request, out interface{}, in ...interface{}) (*http.Response, error) {
+	return s.execer.Exec(r, out, in...)
+}
@@ -2,10 +2,13 @@ package session
 
 import (
 	"context"
+	"errors"
+	"io/ioutil"
 	"net/http"
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v7/pkg/edgegrid"
 	"github.com/apex/log"
@@ -121,3 +124,197 @@ func TestSession_Log(t *testing.T) {
 		})
 	}
 }
+
+func TestIdempotencyKey(t *testing.T) {
+	tests := map[string]struct {
+		ctx         context.Context
+		expectedKey string
+		expectedOK  bool
+	}{
+		"no context options": {
+			ctx: context.Background(),
+		},
+		"context options without idempotency key": {
+			ctx: ContextWithOptions(context.Background(), WithContextAccountSwitchKey("1-ABCD")),
+		},
+		"idempotency key set": {
+			ctx:         ContextWithIdempotencyKey(context.Background(), "5f2d1a4c"),
+			expectedKey: "5f2d1a4c",
+			expectedOK:  true,
+		},
+		"idempotency key set on context with options": {
+			ctx:         ContextWithIdempotencyKey(ContextWithOptions(context.Background(), WithContextAccountSwitchKey("1-ABCD")), "5f2d1a4c"),
+			expectedKey: "5f2d1a4c",
+			expectedOK:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			key, ok := IdempotencyKey(test.ctx)
+			assert.Equal(t, test.expectedKey, key)
+			assert.Equal(t, test.expectedOK, ok)
+		})
+	}
+
+	t.Run("other options are preserved", func(t *testing.T) {
+		ctx := ContextWithIdempotencyKey(ContextWithOptions(context.Background(), WithContextAccountSwitchKey("1-ABCD")), "5f2d1a4c")
+		o, ok := ctx.Value(contextOptionKey).(*contextOptions)
+		require.True(t, ok)
+		assert.Equal(t, "1-ABCD", o.accountKey)
+	})
+}
+
+func TestWithRoundTripFunc(t *testing.T) {
+	httpClient := &http.Client{Timeout: 500}
+	s, err := New(
+		WithSigner(&edgegrid.Config{Host: "akab-test.luna.akamaiapis.net"}),
+		WithClient(httpClient),
+		WithRoundTripFunc(func(r *http.Request) (*http.Response, error) {
+			assert.NotEmpty(t, r.Header.Get("Authorization"))
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"a":"text","b":1}`)),
+				Request:    r,
+			}, nil
+		}),
+	)
+	require.NoError(t, err)
+	assert.Nil(t, httpClient.Transport, "client passed with WithClient should not be modified")
+	assert.Equal(t, httpClient.Timeout, s.Client().Timeout)
+
+	req, err := http.NewRequest(http.MethodGet, "/test/path", nil)
+	require.NoError(t, err)
+	var out testStruct
+	resp, err := s.Exec(req, &out)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, testStruct{A: "text", B: 1}, out)
+}
+
+func TestWithDefaultHeaders(t *testing.T) {
+	tests := map[string]struct {
+		defaultHeaders  http.Header
+		requestHeaders  http.Header
+		contextHeaders  http.Header
+		expectedHeaders map[string]string
+	}{
+		"default headers are added": {
+			defaultHeaders:  http.Header{"x-team-id": {"delivery"}},
+			expectedHeaders: map[string]string{"X-Team-Id": "delivery"},
+		},
+		"request headers are not overridden": {
+			defaultHeaders:  http.Header{"Accept": {"application/json"}},
+			requestHeaders:  http.Header{"Accept": {"application/problem+json"}},
+			expectedHeaders: map[string]string{"Accept": "application/problem+json"},
+		},
+		"context headers win": {
+			defaultHeaders:  http.Header{"X-Team-Id": {"delivery"}, "X-Other": {"other"}},
+			contextHeaders:  http.Header{"X-Team-Id": {"security"}},
+			expectedHeaders: map[string]string{"X-Team-Id": "security", "X-Other": "other"},
+		},
+		"signing headers cannot be overridden": {
+			defaultHeaders:  http.Header{"Authorization": {"Basic abc"}, "Host": {"example.com"}},
+			expectedHeaders: map[string]string{"Host": ""},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s, err := New(
+				WithSigner(&edgegrid.Config{Host: "akab-test.luna.akamaiapis.net"}),
+				WithRoundTripFunc(func(r *http.Request) (*http.Response, error) {
+					for k, v := range test.expectedHeaders {
+						assert.Equal(t, v, r.Header.Get(k), k)
+					}
+					assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "EG1-HMAC-SHA256 "), r.Header.Get("Authorization"))
+					assert.Equal(t, "akab-test.luna.akamaiapis.net", r.URL.Host)
+					return &http.Response{
+						StatusCode: http.StatusNoContent,
+						Body:       ioutil.NopCloser(strings.NewReader("")),
+						Request:    r,
+					}, nil
+				}),
+			)
+			require.NoError(t, err)
+			s = WithDefaultHeaders(s, test.defaultHeaders)
+
+			ctx := context.Background()
+			if test.contextHeaders != nil {
+				ctx = ContextWithOptions(ctx, WithContextHeaders(test.contextHeaders))
+			}
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/test/path", nil)
+			require.NoError(t, err)
+			for k, v := range test.requestHeaders {
+				req.Header[k] = v
+			}
+			_, err = s.Exec(req, nil)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRemainingBudget(t *testing.T) {
+	_, ok := RemainingBudget(context.Background())
+	assert.False(t, ok)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	budget, ok := RemainingBudget(ctx)
+	assert.True(t, ok)
+	assert.True(t, budget > 59*time.Second && budget <= time.Minute, "unexpected budget: %s", budget)
+}
+
+func TestWait(t *testing.T) {
+	t.Run("without deadline", func(t *testing.T) {
+		assert.NoError(t, Wait(context.Background(), time.Millisecond))
+	})
+
+	t.Run("within budget", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		assert.NoError(t, Wait(ctx, time.Millisecond))
+	})
+
+	t.Run("exceeding budget", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		start := time.Now()
+		err := Wait(ctx, time.Hour)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded), "want: %s; got: %s", context.DeadlineExceeded, err)
+		assert.Less(t, int64(time.Since(start)), int64(time.Second), "wait should return immediately")
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := Wait(ctx, time.Millisecond*50)
+		assert.True(t, errors.Is(err, context.Canceled), "want: %s; got: %s", context.Canceled, err)
+	})
+}
+
+func TestWithExecer(t *testing.T) {
+	logger := log.Log
+	base, err := New(WithLog(logger))
+	require.NoError(t, err)
+
+	var requests []*http.Request
+	s := WithExecer(base, ExecerFunc(func(r *http.Request, out interface{}, in ...interface{}) (*http.Response, error) {
+		requests = append(requests, r)
+		require.Len(t, in, 1)
+		assert.Equal(t, testStruct{A: "in", B: 1}, in[0])
+		*out.(*testStruct) = testStruct{A: "out", B: 2}
+		return &http.Response{StatusCode: http.StatusCreated, Body: http.NoBody, Request: r}, nil
+	}))
+	assert.Equal(t, logger, s.Log(context.Background()))
+
+	req, err := http.NewRequest(http.MethodPost, "/test/path", nil)
+	require.NoError(t, err)
+	var out testStruct
+	resp, err := s.Exec(req, &out, testStruct{A: "in", B: 1})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, testStruct{A: "out", B: 2}, out)
+	require.Len(t, requests, 1)
+	assert.Empty(t, requests[0].Header.Get("Authorization"), "request should not be signed by the wrapped session")
+}
@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ActivationNetwork is the network on which a configuration is activated. APIs represent the networks
+// in different ways, e.g. "STAGING", "staging", "PRODUCTION", "production" or "prod", all of which are
+// recognized by the helpers below.
+type ActivationNetwork string
+
+const (
+	// ActivationNetworkStaging is the staging network
+	ActivationNetworkStaging ActivationNetwork = "STAGING"
+	// ActivationNetworkProduction is the production network
+	ActivationNetworkProduction ActivationNetwork = "PRODUCTION"
+)
+
+var (
+	// ErrInvalidNetwork is returned when a value does not represent a staging or production network
+	ErrInvalidNetwork = errors.New("invalid activation network")
+
+	// ErrCannotPromoteNetwork is returned when a network cannot be promoted, i.e. it is already production
+	ErrCannotPromoteNetwork = errors.New("cannot promote activation network")
+)
+
+// IsStaging reports whether the network is the staging network
+func IsStaging(n ActivationNetwork) bool {
+	return strings.EqualFold(string(n), "staging")
+}
+
+// IsProduction reports whether the network is the production network
+func IsProduction(n ActivationNetwork) bool {
+	return strings.EqualFold(string(n), "production") || strings.EqualFold(string(n), "prod")
+}
+
+// ParseActivationNetwork converts any representation of staging or production network to
+// ActivationNetworkStaging or ActivationNetworkProduction respectively
+func ParseActivationNetwork(n string) (ActivationNetwork, error) {
+	switch network := ActivationNetwork(strings.TrimSpace(n)); {
+	case IsStaging(network):
+		return ActivationNetworkStaging, nil
+	case IsProduction(network):
+		return ActivationNetworkProduction, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidNetwork, n)
+}
+
+// PromoteNetwork returns the network a configuration activated on the given network is promoted to,
+// i.e. ActivationNetworkProduction for staging. Production network cannot be promoted any further.
+func PromoteNetwork(n ActivationNetwork) (ActivationNetwork, error) {
+	network, err := ParseActivationNetwork(string(n))
+	if err != nil {
+		return "", err
+	}
+	if network == ActivationNetworkProduction {
+		return "", fmt.Errorf("%w: %q is already production network", ErrCannotPromoteNetwork, n)
+	}
+	return ActivationNetworkProduction, nil
+}
@@ -0,0 +1,76 @@
+package tools
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromoteNetwork(t *testing.T) {
+	tests := map[string]struct {
+		network   ActivationNetwork
+		expected  ActivationNetwork
+		withError error
+	}{
+		"STAGING": {
+			network:  "STAGING",
+			expected: ActivationNetworkProduction,
+		},
+		"staging": {
+			network:  "staging",
+			expected: ActivationNetworkProduction,
+		},
+		"PRODUCTION": {
+			network:   "PRODUCTION",
+			withError: ErrCannotPromoteNetwork,
+		},
+		"prod": {
+			network:   "prod",
+			withError: ErrCannotPromoteNetwork,
+		},
+		"empty": {
+			network:   "",
+			withError: ErrInvalidNetwork,
+		},
+		"unknown": {
+			network:   "QA",
+			withError: ErrInvalidNetwork,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := PromoteNetwork(test.network)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestNetworkPredicates(t *testing.T) {
+	tests := map[string]struct {
+		network    ActivationNetwork
+		staging    bool
+		production bool
+	}{
+		"STAGING":    {network: "STAGING", staging: true},
+		"staging":    {network: "staging", staging: true},
+		"PRODUCTION": {network: "PRODUCTION", production: true},
+		"production": {network: "production", production: true},
+		"prod":       {network: "prod", production: true},
+		"empty":      {network: ""},
+		"unknown":    {network: "stage"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.staging, IsStaging(test.network))
+			assert.Equal(t, test.production, IsProduction(test.network))
+		})
+	}
+}